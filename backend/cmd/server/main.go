@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"vyra-backend/internal/config"
 	"vyra-backend/internal/server"
@@ -14,11 +18,36 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	// Create and start server
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(cfg, os.Args[2:])
+		return
+	}
+
+	// Refuse to serve with placeholder secrets outside development, before
+	// touching the database
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Bring the schema up to date before serving
+	if cfg.AutoMigrate {
+		autoMigrate(cfg)
+	}
+
+	// Serve until SIGINT or SIGTERM, then drain requests and stop the
+	// background workers
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		// A second signal exits without waiting for the shutdown
+		<-ctx.Done()
+		stop()
+	}()
+
 	srv := server.New(cfg)
-	
+
 	log.Printf("Starting Vyra backend server on port %s", cfg.Port)
-	if err := srv.Start(); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	if err := srv.Run(ctx); err != nil {
+		log.Fatalf("Server failed: %v", err)
 	}
 }
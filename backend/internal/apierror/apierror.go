@@ -0,0 +1,271 @@
+// Package apierror gives every error the API returns one JSON shape:
+//
+//	{"error": {"code": "INVOICE_EXPIRED", "message": "...", "requestId": "...", "details": {...}}}
+//
+// Codes are stable and meant for programs; messages are meant for people and
+// may change. Services declare their domain errors with Define, giving each
+// its status and code. From maps those, RPC failures and decoded contract
+// reverts to a response, so handlers only name the message for errors that
+// are the server's fault.
+package apierror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"vyra-backend/internal/auth"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Error codes. Clients match on these, so they must not change once
+// published.
+const (
+	CodeInvalidRequest   = "INVALID_REQUEST"
+	CodeInvalidAddress   = "INVALID_ADDRESS"
+	CodeInvalidSignature = "INVALID_SIGNATURE"
+	CodeUnauthorized     = "UNAUTHORIZED"
+	CodeForbidden        = "FORBIDDEN"
+	CodeNotFound         = "NOT_FOUND"
+	CodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+	CodeRateLimited      = "RATE_LIMITED"
+	CodeOriginNotAllowed = "ORIGIN_NOT_ALLOWED"
+	CodeRequestTimeout   = "REQUEST_TIMEOUT"
+	CodeRequestCancelled = "REQUEST_CANCELLED"
+	CodeRPCUnavailable   = "RPC_UNAVAILABLE"
+	CodeInternal         = "INTERNAL_ERROR"
+
+	CodeInvalidLogin        = "INVALID_LOGIN"
+	CodeInvalidRefreshToken = "INVALID_REFRESH_TOKEN"
+
+	CodeInvoiceNotFound          = "INVOICE_NOT_FOUND"
+	CodeInvoiceExpired           = "INVOICE_EXPIRED"
+	CodeInvoiceAlreadyPaid       = "INVOICE_ALREADY_PAID"
+	CodeInvoiceCancelled         = "INVOICE_CANCELLED"
+	CodeInvalidInvoiceTransition = "INVALID_INVOICE_TRANSITION"
+	CodeNotMerchant              = "NOT_MERCHANT"
+	CodeInsufficientBalance      = "INSUFFICIENT_BALANCE"
+	CodeInsufficientAllowance    = "INSUFFICIENT_ALLOWANCE"
+	CodePaymentNotFound          = "PAYMENT_NOT_FOUND"
+	CodePaymentAlreadyRefunded   = "PAYMENT_ALREADY_REFUNDED"
+	CodeIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	CodeRefundInProgress         = "REFUND_IN_PROGRESS"
+	CodeReferenceReused          = "PAYMENT_REFERENCE_REUSED"
+	CodeInvalidPayment           = "INVALID_PAYMENT"
+
+	CodeContractPaused   = "CONTRACT_PAUSED"
+	CodeContractReverted = "CONTRACT_REVERTED"
+)
+
+// StatusClientClosedRequest is nginx's status for a request the client gave
+// up on. The client never sees it, but the access log and metrics do.
+const StatusClientClosedRequest = 499
+
+// Error is an API error response.
+type Error struct {
+	Status  int                    `json:"-"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// New creates an error response.
+func New(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// WithDetail returns a copy of e with key=value added to its details.
+func (e *Error) WithDetail(key string, value interface{}) *Error {
+	copied := *e
+	copied.Details = make(map[string]interface{}, len(e.Details)+1)
+	for k, v := range e.Details {
+		copied.Details[k] = v
+	}
+	copied.Details[key] = value
+	return &copied
+}
+
+// Sentinel is a domain error that carries the status and code it is
+// answered with, so From maps any error wrapping one without knowing the
+// service that declared it.
+type Sentinel struct {
+	status  int
+	code    string
+	message string
+}
+
+// Define declares a domain error answered with status and code. The
+// response's message is the text of the error the service returns, which
+// wraps the sentinel with what the client needs to know.
+func Define(status int, code, message string) *Sentinel {
+	return &Sentinel{status: status, code: code, message: message}
+}
+
+func (e *Sentinel) Error() string {
+	return e.message
+}
+
+// BadRequest is a 400 for a request the client must fix.
+func BadRequest(message string) *Error {
+	return New(http.StatusBadRequest, CodeInvalidRequest, message)
+}
+
+// Unauthorized is a 401 for a request without valid credentials.
+func Unauthorized(message string) *Error {
+	return New(http.StatusUnauthorized, CodeUnauthorized, message)
+}
+
+// Forbidden is a 403 for credentials that do not allow the request.
+func Forbidden(message string) *Error {
+	return New(http.StatusForbidden, CodeForbidden, message)
+}
+
+// NotFound is a 404 for a resource that does not exist or is not the
+// caller's.
+func NotFound(message string) *Error {
+	return New(http.StatusNotFound, CodeNotFound, message)
+}
+
+// Internal is a 500 for a failure that is not the client's.
+func Internal(message string) *Error {
+	return New(http.StatusInternalServerError, CodeInternal, message)
+}
+
+// mapping gives a domain error its status and code. An empty message means
+// the error's own text, which the services write for the client.
+type mapping struct {
+	err     error
+	status  int
+	code    string
+	message string
+}
+
+// mappings give the errors of the packages below the services their
+// responses. They are tried in order, and before the services' sentinels,
+// so a domain error wrapping an invalid address or an unavailable node is
+// reported as that.
+var mappings = []mapping{
+	{context.DeadlineExceeded, http.StatusGatewayTimeout, CodeRequestTimeout, "Request timed out"},
+	{context.Canceled, StatusClientClosedRequest, CodeRequestCancelled, "Request cancelled"},
+	{chain.ErrRPCUnavailable, http.StatusServiceUnavailable, CodeRPCUnavailable, "Blockchain node unavailable, try again later"},
+	{chain.ErrInvalidAddress, http.StatusBadRequest, CodeInvalidAddress, ""},
+	{repository.ErrInvalidTransition, http.StatusConflict, CodeInvalidInvoiceTransition, ""},
+	{auth.ErrInvalidToken, http.StatusUnauthorized, CodeUnauthorized, "Invalid or expired access token"},
+	{auth.ErrInvalidAPIKey, http.StatusUnauthorized, CodeUnauthorized, "Invalid or revoked API key"},
+}
+
+// notFound answers a lookup that found nothing. It comes after the
+// sentinels, which say what was not found.
+var notFound = mapping{repository.ErrNotFound, http.StatusNotFound, CodeNotFound, "Not found"}
+
+// reverts gives the contracts' custom errors the same codes as the domain
+// errors they mean. The services map the reverts they expect; these catch
+// the rest, such as a token revert surfacing from a transfer's gas estimate.
+var reverts = map[string]mapping{
+	"InvoiceNotFound":             {status: http.StatusNotFound, code: CodeInvoiceNotFound},
+	"InvoiceExpired":              {status: http.StatusBadRequest, code: CodeInvoiceExpired},
+	"InvoiceAlreadyPaid":          {status: http.StatusConflict, code: CodeInvoiceAlreadyPaid},
+	"UnauthorizedMerchant":        {status: http.StatusForbidden, code: CodeNotMerchant},
+	"PaymentNotFound":             {status: http.StatusNotFound, code: CodePaymentNotFound},
+	"PaymentAlreadyRefunded":      {status: http.StatusConflict, code: CodePaymentAlreadyRefunded},
+	"InsufficientBalance":         {status: http.StatusBadRequest, code: CodeInsufficientBalance},
+	"ERC20InsufficientBalance":    {status: http.StatusBadRequest, code: CodeInsufficientBalance},
+	"ERC20InsufficientAllowance":  {status: http.StatusBadRequest, code: CodeInsufficientAllowance},
+	"InvalidSignature":            {status: http.StatusBadRequest, code: CodeInvalidSignature},
+	"ECDSAInvalidSignature":       {status: http.StatusBadRequest, code: CodeInvalidSignature},
+	"ECDSAInvalidSignatureLength": {status: http.StatusBadRequest, code: CodeInvalidSignature},
+	"ECDSAInvalidSignatureS":      {status: http.StatusBadRequest, code: CodeInvalidSignature},
+	"RateLimitExceeded":           {status: http.StatusTooManyRequests, code: CodeRateLimited},
+	"EnforcedPause":               {status: http.StatusServiceUnavailable, code: CodeContractPaused, message: "Contract is paused"},
+}
+
+// From maps err to the response it should get, or returns nil if err is not
+// one the client can act on and is to be reported as an internal error. A
+// decoded contract revert is added to the details whatever the error maps
+// to.
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	revert := contracts.DecodeRevert(err)
+	for _, m := range mappings {
+		if errors.Is(err, m.err) {
+			return m.response(err, revert)
+		}
+	}
+	var sentinel *Sentinel
+	if errors.As(err, &sentinel) {
+		return mapping{status: sentinel.status, code: sentinel.code}.response(err, revert)
+	}
+	if errors.Is(err, notFound.err) {
+		return notFound.response(err, revert)
+	}
+	if revert == nil {
+		return nil
+	}
+	if m, ok := reverts[revert.Name]; ok {
+		return m.response(revert, revert)
+	}
+	return mapping{status: http.StatusUnprocessableEntity, code: CodeContractReverted}.response(revert, revert)
+}
+
+func (m mapping) response(err error, revert *contracts.RevertError) *Error {
+	message := m.message
+	if message == "" {
+		message = err.Error()
+	}
+	e := New(m.status, m.code, message)
+	if revert != nil {
+		e = e.WithDetail("revert", revertDetails(revert))
+	}
+	return e
+}
+
+func revertDetails(revert *contracts.RevertError) map[string]interface{} {
+	details := map[string]interface{}{"name": revert.Name}
+	if len(revert.Args) > 0 {
+		details["args"] = revert.Args
+	}
+	if revert.Reason != "" {
+		details["reason"] = revert.Reason
+	}
+	return details
+}
+
+// Respond answers the request with err's response. An error From does not
+// recognise is logged and answered with a 500 carrying message, so its text
+// never reaches the client.
+func Respond(c *gin.Context, err error, message string) {
+	apiErr := From(err)
+	if apiErr == nil {
+		logging.FromContext(c.Request.Context()).WithError(err).Error(message)
+		apiErr = Internal(message)
+	} else if apiErr.Status >= http.StatusInternalServerError {
+		logging.FromContext(c.Request.Context()).WithError(err).Warn(message)
+	}
+	Abort(c, apiErr)
+}
+
+// Abort answers the request with err and stops the handler chain.
+func Abort(c *gin.Context, err *Error) {
+	c.AbortWithStatusJSON(err.Status, envelope(c, err))
+}
+
+// envelope wraps err with the request's ID for the response body.
+func envelope(c *gin.Context, err *Error) gin.H {
+	return gin.H{"error": struct {
+		*Error
+		RequestID string `json:"requestId,omitempty"`
+	}{err, logging.RequestID(c.Request.Context())}}
+}
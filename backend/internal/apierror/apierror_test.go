@@ -0,0 +1,183 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"vyra-backend/internal/auth"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// dataError is a reverted call or gas estimate as a node reports it, with
+// the revert data in the error data.
+type dataError struct {
+	data string
+}
+
+func (e dataError) Error() string          { return "execution reverted" }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+// posRevert is a reverted call to VyraPOS with one of its custom errors.
+func posRevert(t *testing.T, name string) error {
+	t.Helper()
+	parsed, err := contracts.VyraPOSMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, ok := parsed.Errors[name]
+	if !ok {
+		t.Fatalf("no VyraPOS error %s", name)
+	}
+	return dataError{hexutil.Encode(e.ID.Bytes()[:4])}
+}
+
+// builtinRevert is a reverted call with Error(string) or Panic(uint256).
+func builtinRevert(t *testing.T, name, typ string, arg interface{}) error {
+	t.Helper()
+	argType, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := abi.Arguments{{Type: argType}}.Pack(arg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := crypto.Keccak256([]byte(name + "(" + typ + ")"))[:4]
+	return dataError{hexutil.Encode(append(selector, packed...))}
+}
+
+func TestFrom(t *testing.T) {
+	errExpired := Define(http.StatusBadRequest, CodeInvoiceExpired, "invoice expired")
+	errMissing := Define(http.StatusNotFound, CodeInvoiceNotFound, "invoice not found")
+	errInvalid := Define(http.StatusBadRequest, CodeInvalidPayment, "invalid payment")
+	expired := posRevert(t, "InvoiceExpired")
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantMsg    string
+		wantRevert string
+	}{
+		{
+			name:       "API error",
+			err:        fmt.Errorf("handler: %w", Forbidden("Not yours")),
+			wantStatus: http.StatusForbidden, wantCode: CodeForbidden, wantMsg: "Not yours",
+		},
+		{
+			name:       "sentinel",
+			err:        fmt.Errorf("%w: 3f1c expired at noon", errExpired),
+			wantStatus: http.StatusBadRequest, wantCode: CodeInvoiceExpired, wantMsg: "invoice expired: 3f1c expired at noon",
+		},
+		{
+			name:       "sentinel wrapping a lookup",
+			err:        fmt.Errorf("%w: %w", errMissing, repository.ErrNotFound),
+			wantStatus: http.StatusNotFound, wantCode: CodeInvoiceNotFound, wantMsg: "invoice not found: record not found",
+		},
+		{
+			name:       "sentinel wrapping an invalid address",
+			err:        fmt.Errorf("%w: %w", errInvalid, chain.InvalidAddress("customer", "0x12")),
+			wantStatus: http.StatusBadRequest, wantCode: CodeInvalidAddress,
+		},
+		{
+			name:       "sentinel wrapping a revert",
+			err:        fmt.Errorf("%w: rejected by VyraPOS: %w", errInvalid, expired),
+			wantStatus: http.StatusBadRequest, wantCode: CodeInvalidPayment, wantRevert: "InvoiceExpired",
+		},
+		{
+			name:       "timeout",
+			err:        fmt.Errorf("failed to read invoice: %w", context.DeadlineExceeded),
+			wantStatus: http.StatusGatewayTimeout, wantCode: CodeRequestTimeout, wantMsg: "Request timed out",
+		},
+		{
+			name:       "cancelled",
+			err:        context.Canceled,
+			wantStatus: StatusClientClosedRequest, wantCode: CodeRequestCancelled,
+		},
+		{
+			name:       "RPC unavailable",
+			err:        fmt.Errorf("%w: dial tcp: refused", chain.ErrRPCUnavailable),
+			wantStatus: http.StatusServiceUnavailable, wantCode: CodeRPCUnavailable,
+		},
+		{
+			name:       "invalid transition",
+			err:        repository.ErrInvalidTransition,
+			wantStatus: http.StatusConflict, wantCode: CodeInvalidInvoiceTransition,
+		},
+		{
+			name:       "invalid token",
+			err:        auth.ErrInvalidToken,
+			wantStatus: http.StatusUnauthorized, wantCode: CodeUnauthorized, wantMsg: "Invalid or expired access token",
+		},
+		{
+			name:       "not found",
+			err:        fmt.Errorf("webhook: %w", repository.ErrNotFound),
+			wantStatus: http.StatusNotFound, wantCode: CodeNotFound, wantMsg: "Not found",
+		},
+		{
+			name:       "InvoiceExpired revert",
+			err:        fmt.Errorf("failed to estimate gas: %w", expired),
+			wantStatus: http.StatusBadRequest, wantCode: CodeInvoiceExpired, wantMsg: "execution reverted: InvoiceExpired()",
+			wantRevert: "InvoiceExpired",
+		},
+		{
+			name:       "InvalidSignature revert",
+			err:        posRevert(t, "InvalidSignature"),
+			wantStatus: http.StatusBadRequest, wantCode: CodeInvalidSignature, wantRevert: "InvalidSignature",
+		},
+		{
+			name:       "paused",
+			err:        posRevert(t, "EnforcedPause"),
+			wantStatus: http.StatusServiceUnavailable, wantCode: CodeContractPaused, wantMsg: "Contract is paused",
+			wantRevert: "EnforcedPause",
+		},
+		{
+			name:       "require message",
+			err:        builtinRevert(t, "Error", "string", "amount too large"),
+			wantStatus: http.StatusUnprocessableEntity, wantCode: CodeContractReverted, wantMsg: "execution reverted: amount too large",
+			wantRevert: "Error",
+		},
+		{
+			name:       "panic",
+			err:        builtinRevert(t, "Panic", "uint256", big.NewInt(0x12)),
+			wantStatus: http.StatusUnprocessableEntity, wantCode: CodeContractReverted, wantMsg: "execution reverted: division or modulo by zero",
+			wantRevert: "Panic",
+		},
+	}
+	for _, tt := range tests {
+		got := From(tt.err)
+		if got == nil {
+			t.Errorf("%s: From = nil, want %d %s", tt.name, tt.wantStatus, tt.wantCode)
+			continue
+		}
+		if got.Status != tt.wantStatus || got.Code != tt.wantCode {
+			t.Errorf("%s: From = %d %s, want %d %s", tt.name, got.Status, got.Code, tt.wantStatus, tt.wantCode)
+		}
+		if tt.wantMsg != "" && got.Message != tt.wantMsg {
+			t.Errorf("%s: message = %q, want %q", tt.name, got.Message, tt.wantMsg)
+		}
+		revert, _ := got.Details["revert"].(map[string]interface{})
+		if name, _ := revert["name"].(string); name != tt.wantRevert {
+			t.Errorf("%s: revert detail = %v, want %q", tt.name, got.Details["revert"], tt.wantRevert)
+		}
+	}
+
+	// Anything else is the server's fault, and its text is not for the
+	// client
+	for _, err := range []error{errors.New("pq: connection refused"), dataError{"0x12345678"}} {
+		if got := From(err); got != nil {
+			t.Errorf("From(%v) = %+v, want nil", err, got)
+		}
+	}
+}
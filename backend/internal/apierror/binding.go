@@ -0,0 +1,75 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// init makes validation errors name fields as they appear in the JSON body
+// rather than as Go struct fields.
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// Binding maps an error from binding a request body to a 400 naming the
+// fields at fault, rather than passing on the binder's own text, which
+// names Go types.
+func Binding(err error) *Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make(map[string]interface{}, len(validationErrs))
+		messages := make([]string, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			field := fieldPath(fe)
+			fields[field] = fe.Tag()
+			messages = append(messages, fieldMessage(field, fe))
+		}
+		return BadRequest(strings.Join(messages, "; ")).WithDetail("fields", fields)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return BadRequest(typeErr.Field+" must be a "+typeErr.Value).
+			WithDetail("fields", map[string]interface{}{typeErr.Field: "type"})
+	}
+	if errors.Is(err, io.EOF) {
+		return BadRequest("Request body is required")
+	}
+	return BadRequest("Request body is not valid JSON")
+}
+
+// fieldPath is the field's path in the body, such as recipients[0].address.
+// Handlers bind into anonymous structs, so the namespace does not start with
+// a type name.
+func fieldPath(fe validator.FieldError) string {
+	return fe.Namespace()
+}
+
+func fieldMessage(field string, fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return field + " is required"
+	case "min", "gte":
+		return field + " must be at least " + fe.Param()
+	case "max", "lte":
+		return field + " must be at most " + fe.Param()
+	case "oneof":
+		return field + " must be one of " + fe.Param()
+	default:
+		return field + " is invalid"
+	}
+}
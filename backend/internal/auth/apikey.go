@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// API key scopes. A key is limited to the operations of its scopes, for its
+// merchant's address only.
+const (
+	ScopeInvoicesWrite  = "invoices:write"
+	ScopePaymentsRead   = "payments:read"
+	ScopeRefundsWrite   = "refunds:write"
+	ScopeWebhooksManage = "webhooks:manage"
+)
+
+// Scopes lists every API key scope.
+var Scopes = []string{ScopeInvoicesWrite, ScopePaymentsRead, ScopeRefundsWrite, ScopeWebhooksManage}
+
+// APIKeyPrefix starts every API key, so keys are recognisable if leaked and
+// can be told apart from access tokens.
+const APIKeyPrefix = "vyra_sk_"
+
+// ErrInvalidAPIKey is returned for an API key that is malformed, unknown or
+// revoked.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// NewAPIKey generates an API key and the start of it that is shown to
+// identify the key once only its hash is kept.
+func NewAPIKey() (key, prefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	key = APIKeyPrefix + hex.EncodeToString(b)
+	return key, key[:len(APIKeyPrefix)+8], nil
+}
+
+// IsAPIKey reports whether credential looks like an API key rather than an
+// access token.
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
+
+// HashSecret returns the hex SHA-256 of an API key or refresh token, which
+// is all that is stored of it. The secrets are random, so a plain hash is
+// enough to make a leaked table useless.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,213 @@
+// Package auth implements Sign-In with Ethereum (EIP-4361) messages and the
+// JWT access tokens issued once a message is verified. A client fetches a
+// nonce, has the wallet sign a Message carrying it with personal_sign, and
+// exchanges the message and signature for an access token whose subject is
+// the signing address.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vyra-backend/internal/chain"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidMessage is returned for a malformed or unverifiable sign-in
+// message.
+var ErrInvalidMessage = errors.New("invalid sign-in message")
+
+const (
+	preambleSuffix = " wants you to sign in with your Ethereum account:"
+
+	// Version is the only EIP-4361 message version.
+	Version = "1"
+)
+
+// Message is an EIP-4361 sign-in message. ExpirationTime, NotBefore,
+// RequestID and Resources are optional.
+type Message struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// String formats m as the text the wallet signs.
+func (m *Message) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + preambleSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "URI: %s\n", m.URI)
+	fmt.Fprintf(&b, "Version: %s\n", m.Version)
+	fmt.Fprintf(&b, "Chain ID: %d\n", m.ChainID)
+	fmt.Fprintf(&b, "Nonce: %s\n", m.Nonce)
+	fmt.Fprintf(&b, "Issued At: %s", m.IssuedAt.UTC().Format(time.RFC3339))
+	if m.ExpirationTime != nil {
+		fmt.Fprintf(&b, "\nExpiration Time: %s", m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if m.NotBefore != nil {
+		fmt.Fprintf(&b, "\nNot Before: %s", m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		fmt.Fprintf(&b, "\nRequest ID: %s", m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, r := range m.Resources {
+			b.WriteString("\n- " + r)
+		}
+	}
+	return b.String()
+}
+
+// ParseMessage parses the text of an EIP-4361 message. The address must be
+// EIP-55 checksummed, as the standard requires.
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	next := func() (string, bool) {
+		if len(lines) == 0 {
+			return "", false
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, true
+	}
+
+	var m Message
+	preamble, _ := next()
+	domain, ok := strings.CutSuffix(preamble, preambleSuffix)
+	if !ok || domain == "" {
+		return nil, fmt.Errorf("%w: missing preamble", ErrInvalidMessage)
+	}
+	m.Domain = domain
+
+	address, _ := next()
+	if !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+		return nil, fmt.Errorf("%w: address must be an EIP-55 checksummed address", ErrInvalidMessage)
+	}
+	m.Address = common.HexToAddress(address)
+
+	// A blank line, then an optional statement followed by a blank line
+	if line, _ := next(); line != "" {
+		return nil, fmt.Errorf("%w: expected a blank line after the address", ErrInvalidMessage)
+	}
+	if len(lines) > 0 && !strings.HasPrefix(lines[0], "URI: ") {
+		if lines[0] != "" {
+			m.Statement, _ = next()
+		}
+		if line, _ := next(); line != "" {
+			return nil, fmt.Errorf("%w: expected a blank line after the statement", ErrInvalidMessage)
+		}
+	}
+
+	fields := []struct {
+		name     string
+		required bool
+		set      func(string) error
+	}{
+		{"URI", true, func(v string) error { m.URI = v; return nil }},
+		{"Version", true, func(v string) error { m.Version = v; return nil }},
+		{"Chain ID", true, func(v string) (err error) { m.ChainID, err = strconv.ParseInt(v, 10, 64); return }},
+		{"Nonce", true, func(v string) error { m.Nonce = v; return nil }},
+		{"Issued At", true, func(v string) (err error) { m.IssuedAt, err = time.Parse(time.RFC3339, v); return }},
+		{"Expiration Time", false, func(v string) error { return parseOptionalTime(v, &m.ExpirationTime) }},
+		{"Not Before", false, func(v string) error { return parseOptionalTime(v, &m.NotBefore) }},
+		{"Request ID", false, func(v string) error { m.RequestID = v; return nil }},
+	}
+	for _, field := range fields {
+		if len(lines) == 0 || !strings.HasPrefix(lines[0], field.name+": ") {
+			if field.required {
+				return nil, fmt.Errorf("%w: missing %s", ErrInvalidMessage, field.name)
+			}
+			continue
+		}
+		line, _ := next()
+		if err := field.set(strings.TrimPrefix(line, field.name+": ")); err != nil {
+			return nil, fmt.Errorf("%w: invalid %s", ErrInvalidMessage, field.name)
+		}
+	}
+
+	if len(lines) > 0 && lines[0] == "Resources:" {
+		next()
+		for len(lines) > 0 && strings.HasPrefix(lines[0], "- ") {
+			line, _ := next()
+			m.Resources = append(m.Resources, strings.TrimPrefix(line, "- "))
+		}
+	}
+	if len(lines) > 0 {
+		return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, lines[0])
+	}
+
+	if m.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidMessage, m.Version)
+	}
+	if len(m.Nonce) < 8 || !isAlphanumeric(m.Nonce) {
+		return nil, fmt.Errorf("%w: nonce must be at least 8 alphanumeric characters", ErrInvalidMessage)
+	}
+	return &m, nil
+}
+
+// VerifySignature checks that signature is the message's address signing
+// its text with personal_sign (EIP-191). Contract wallets (EIP-1271) are
+// not supported.
+func (m *Message) VerifySignature(text, signature string) error {
+	signer, err := chain.RecoverSigner(accounts.TextHash([]byte(text)), signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+	if signer != m.Address {
+		return fmt.Errorf("%w: signature is by %s, not %s", ErrInvalidMessage, signer.Hex(), m.Address.Hex())
+	}
+	return nil
+}
+
+// CheckTime checks that the message is valid at now: issued no later than
+// skew from now, not yet expired and past its not-before time.
+func (m *Message) CheckTime(now time.Time, skew time.Duration) error {
+	if m.IssuedAt.After(now.Add(skew)) {
+		return fmt.Errorf("%w: issued in the future", ErrInvalidMessage)
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return fmt.Errorf("%w: expired at %s", ErrInvalidMessage, m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if m.NotBefore != nil && now.Add(skew).Before(*m.NotBefore) {
+		return fmt.Errorf("%w: not valid before %s", ErrInvalidMessage, m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func parseOptionalTime(value string, t **time.Time) error {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return err
+	}
+	*t = &parsed
+	return nil
+}
+
+func isAlphanumeric(s string) bool {
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
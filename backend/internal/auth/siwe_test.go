@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// specExample is the example message from EIP-4361.
+const specExample = `service.invalid wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.invalid/tos
+
+URI: https://service.invalid/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestParseMessageSpecExample(t *testing.T) {
+	m, err := ParseMessage(specExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Message{
+		Domain:    "service.invalid",
+		Address:   common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+		Statement: "I accept the ServiceOrg Terms of Service: https://service.invalid/tos",
+		URI:       "https://service.invalid/login",
+		Version:   "1",
+		ChainID:   1,
+		Nonce:     "32891756",
+		IssuedAt:  time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC),
+		Resources: []string{
+			"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+			"https://example.com/my-web2-claim.json",
+		},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("ParseMessage =\n%+v\nwant\n%+v", m, want)
+	}
+	if m.String() != specExample {
+		t.Errorf("String =\n%s\nwant\n%s", m.String(), specExample)
+	}
+	if _, err := ParseMessage(strings.ReplaceAll(specExample, "\n", "\r\n")); err != nil {
+		t.Errorf("ParseMessage with CRLF line endings: %v", err)
+	}
+}
+
+func TestParseMessageRoundTrip(t *testing.T) {
+	issued := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	expires := issued.Add(10 * time.Minute)
+	notBefore := issued.Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		m    Message
+	}{
+		{"required fields only", Message{
+			Domain: "app.vyra.io", Address: common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6"),
+			URI: "https://app.vyra.io", Version: Version, ChainID: 31337, Nonce: "abcdef12", IssuedAt: issued,
+		}},
+		{"every field", Message{
+			Domain: "app.vyra.io", Address: common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6"),
+			Statement: "Sign in to Vyra", URI: "https://app.vyra.io", Version: Version, ChainID: 1,
+			Nonce: "Zx81LmQp0aB", IssuedAt: issued, ExpirationTime: &expires, NotBefore: &notBefore,
+			RequestID: "req-1", Resources: []string{"https://app.vyra.io/tos"},
+		}},
+	}
+	for _, tt := range tests {
+		got, err := ParseMessage(tt.m.String())
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, &tt.m) {
+			t.Errorf("%s: ParseMessage(String()) =\n%+v\nwant\n%+v", tt.name, got, &tt.m)
+		}
+	}
+}
+
+func TestParseMessageInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"empty", ""},
+		{"no preamble", strings.Replace(specExample, " wants you to sign in with your Ethereum account:", "", 1)},
+		{"lowercase address", strings.Replace(specExample, "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", 1)},
+		{"bad checksum", strings.Replace(specExample, "0xC02aaA39", "0xc02aaA39", 1)},
+		{"no blank line after statement", strings.Replace(specExample, "tos\n\nURI", "tos\nURI", 1)},
+		{"missing nonce", strings.Replace(specExample, "Nonce: 32891756\n", "", 1)},
+		{"short nonce", strings.Replace(specExample, "Nonce: 32891756", "Nonce: 1234567", 1)},
+		{"nonce not alphanumeric", strings.Replace(specExample, "Nonce: 32891756", "Nonce: 3289-1756", 1)},
+		{"unsupported version", strings.Replace(specExample, "Version: 1", "Version: 2", 1)},
+		{"bad chain ID", strings.Replace(specExample, "Chain ID: 1", "Chain ID: one", 1)},
+		{"bad timestamp", strings.Replace(specExample, "2021-09-30T16:25:24Z", "yesterday", 1)},
+		{"fields out of order", strings.Replace(specExample, "Version: 1\nChain ID: 1", "Chain ID: 1\nVersion: 1", 1)},
+		{"trailing line", specExample + "\nExtra: field"},
+	}
+	for _, tt := range tests {
+		if _, err := ParseMessage(tt.text); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("%s: error = %v, want ErrInvalidMessage", tt.name, err)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	m := &Message{
+		Domain: "app.vyra.io", Address: crypto.PubkeyToAddress(key.PublicKey), URI: "https://app.vyra.io",
+		Version: Version, ChainID: 31337, Nonce: "abcdef12", IssuedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	text := m.String()
+
+	signature, _ := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	signature[64] += 27
+	otherSignature, _ := crypto.Sign(accounts.TextHash([]byte(text)), other)
+
+	if err := m.VerifySignature(text, hexutil.Encode(signature)); err != nil {
+		t.Errorf("VerifySignature(signer) = %v", err)
+	}
+	tests := []struct {
+		name      string
+		text      string
+		signature string
+	}{
+		{"another signer", text, hexutil.Encode(otherSignature)},
+		{"altered text", text + "\nRequest ID: 1", hexutil.Encode(signature)},
+		{"malformed signature", text, "0x1234"},
+	}
+	for _, tt := range tests {
+		if err := m.VerifySignature(tt.text, tt.signature); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("%s: error = %v, want ErrInvalidMessage", tt.name, err)
+		}
+	}
+}
+
+func TestCheckTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name  string
+		m     Message
+		valid bool
+	}{
+		{"issued now", Message{IssuedAt: now}, true},
+		{"issued within the skew", Message{IssuedAt: now.Add(20 * time.Second)}, true},
+		{"issued in the future", Message{IssuedAt: now.Add(time.Minute)}, false},
+		{"not yet expired", Message{IssuedAt: now, ExpirationTime: at(time.Second)}, true},
+		{"expired", Message{IssuedAt: now, ExpirationTime: at(0)}, false},
+		{"not before within the skew", Message{IssuedAt: now, NotBefore: at(20 * time.Second)}, true},
+		{"not before later", Message{IssuedAt: now, NotBefore: at(time.Minute)}, false},
+	}
+	for _, tt := range tests {
+		err := tt.m.CheckTime(now, 30*time.Second)
+		if tt.valid && err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("%s: error = %v, want ErrInvalidMessage", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Issuer is the iss claim of every access token.
+const Issuer = "vyra-backend"
+
+// ErrInvalidToken is returned for an access token that is malformed,
+// wrongly signed or expired.
+var ErrInvalidToken = errors.New("invalid access token")
+
+// Tokens issues and verifies HS256 access tokens whose subject is the
+// checksummed address of the signed-in wallet.
+type Tokens struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewTokens(secret string, ttl time.Duration) *Tokens {
+	return &Tokens{secret: []byte(secret), ttl: ttl}
+}
+
+// Issue returns an access token for address and when it expires.
+func (t *Tokens) Issue(address common.Address, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(t.ttl)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    Issuer,
+		Subject:   address.Hex(),
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString(t.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// Verify checks an access token and returns the address it was issued to.
+func (t *Tokens) Verify(token string) (common.Address, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if !common.IsHexAddress(claims.Subject) {
+		return common.Address{}, fmt.Errorf("%w: subject is not an address", ErrInvalidToken)
+	}
+	return common.HexToAddress(claims.Subject), nil
+}
@@ -0,0 +1,32 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidAddress marks a malformed Ethereum address in a request.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// InvalidAddress reports that value, given as the address of role (such as
+// "customer"), is not an Ethereum address. role may be empty. The error
+// matches ErrInvalidAddress.
+func InvalidAddress(role, value string) error {
+	if role == "" {
+		return fmt.Errorf("%w %q", ErrInvalidAddress, value)
+	}
+	return &addressError{role: role, value: value}
+}
+
+type addressError struct {
+	role  string
+	value string
+}
+
+func (e *addressError) Error() string {
+	return fmt.Sprintf("invalid %s address %q", e.role, e.value)
+}
+
+func (e *addressError) Is(target error) bool {
+	return target == ErrInvalidAddress
+}
@@ -0,0 +1,32 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrRPCUnavailable is returned by a backend when no RPC node could be
+// reached, as opposed to a node answering with an error.
+var ErrRPCUnavailable = errors.New("RPC node unavailable")
+
+// Backend is the subset of an Ethereum client the services rely on. It is
+// satisfied by *ethclient.Client in production and by go-ethereum's
+// simulated backend in tests.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+}
+
+// ChainIDReader is implemented by backends that can report the ID of the
+// chain they are connected to, such as *ethclient.Client. The simulated
+// backend cannot.
+type ChainIDReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
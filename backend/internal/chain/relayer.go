@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrRelayerNotConfigured is returned when an operation needs the relayer
+// key but RELAYER_PRIVATE_KEY is not set.
+var ErrRelayerNotConfigured = errors.New("relayer key not configured")
+
+// NewTransactor builds signing options for the backend's relayer account
+// from a hex-encoded private key.
+func NewTransactor(hexKey string, chainID int64) (*bind.TransactOpts, error) {
+	if hexKey == "" {
+		return nil, ErrRelayerNotConfigured
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid relayer key: %v", err)
+	}
+
+	return bind.NewKeyedTransactorWithChainID(key, big.NewInt(chainID))
+}
+
+// Relayer sends transactions from the relayer account. It hands out the
+// account's nonces itself, one send at a time, rather than letting each
+// send ask a node for its pending nonce: concurrent sends would be given the
+// same nonce, and one of them dropped or replaced. The next nonce is read
+// from backend when the relayer starts and again after a failed send, so
+// backend should keep to one node.
+type Relayer struct {
+	key     string
+	chainID int64
+	backend Backend
+
+	mu     sync.Mutex
+	nonce  uint64
+	synced bool
+}
+
+// NewRelayer creates the relayer for hexKey, which may be empty if the
+// backend has no relayer; sends then fail with ErrRelayerNotConfigured.
+func NewRelayer(hexKey string, chainID int64, backend Backend) *Relayer {
+	return &Relayer{key: hexKey, chainID: chainID, backend: backend}
+}
+
+// Address returns the relayer account's address.
+func (r *Relayer) Address() (common.Address, error) {
+	opts, err := NewTransactor(r.key, r.chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return opts.From, nil
+}
+
+// Send builds a transaction with build, from signing options carrying the
+// relayer's next nonce, and submits it. build must not send the
+// transaction itself; the options it is given have NoSend set.
+func (r *Relayer) Send(ctx context.Context, build func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	opts, err := NewTransactor(r.key, r.chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+	opts.NoSend = true
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.synced {
+		nonce, err := r.backend.PendingNonceAt(ctx, opts.From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relayer nonce: %w", err)
+		}
+		r.nonce, r.synced = nonce, true
+	}
+	opts.Nonce = new(big.Int).SetUint64(r.nonce)
+
+	tx, err := build(opts)
+	if err != nil {
+		// Nothing was sent, so the nonce is still free
+		return nil, err
+	}
+	if err := r.backend.SendTransaction(ctx, tx); err != nil {
+		// The node may or may not have taken it; ask again next time
+		r.synced = false
+		return nil, err
+	}
+	r.nonce++
+	return tx, nil
+}
@@ -0,0 +1,145 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// The simulated backend's chain ID
+const simulatedChainID = 1337
+
+func newRelayer(t *testing.T) (*Relayer, *backends.SimulatedBackend) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+	}, 30_000_000)
+	t.Cleanup(func() { backend.Close() })
+
+	hexKey := common.Bytes2Hex(crypto.FromECDSA(key))
+	return NewRelayer(hexKey, simulatedChainID, backend), backend
+}
+
+// transferTo builds a plain ETH transfer from the relayer.
+func transferTo(to common.Address) func(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		tx := types.NewTx(&types.LegacyTx{
+			Nonce:    opts.Nonce.Uint64(),
+			To:       &to,
+			Value:    big.NewInt(1),
+			Gas:      21000,
+			GasPrice: big.NewInt(params.GWei * 10),
+		})
+		return opts.Signer(opts.From, tx)
+	}
+}
+
+func TestRelayerConcurrentSendsGetDistinctNonces(t *testing.T) {
+	relayer, backend := newRelayer(t)
+	to := common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6")
+
+	const sends = 8
+	nonces := make([]int, sends)
+	var wg sync.WaitGroup
+	for i := 0; i < sends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx, err := relayer.Send(context.Background(), transferTo(to))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			nonces[i] = int(tx.Nonce())
+		}(i)
+	}
+	wg.Wait()
+	backend.Commit()
+
+	sort.Ints(nonces)
+	for i, nonce := range nonces {
+		if nonce != i {
+			t.Fatalf("nonces = %v, want 0 to %d", nonces, sends-1)
+		}
+	}
+	balance, err := backend.BalanceAt(context.Background(), to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Int64() != sends {
+		t.Errorf("recipient balance = %d, want %d: a transaction was dropped", balance, sends)
+	}
+}
+
+func TestRelayerBuildFailureKeepsNonce(t *testing.T) {
+	relayer, _ := newRelayer(t)
+	to := common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6")
+
+	errBuild := errors.New("build failed")
+	_, err := relayer.Send(context.Background(), func(*bind.TransactOpts) (*types.Transaction, error) {
+		return nil, errBuild
+	})
+	if !errors.Is(err, errBuild) {
+		t.Fatalf("Send() error = %v, want %v", err, errBuild)
+	}
+
+	tx, err := relayer.Send(context.Background(), transferTo(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 0 {
+		t.Errorf("nonce after a failed build = %d, want 0", tx.Nonce())
+	}
+}
+
+func TestRelayerResyncsAfterFailedSend(t *testing.T) {
+	relayer, backend := newRelayer(t)
+	to := common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6")
+
+	if _, err := relayer.Send(context.Background(), transferTo(to)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	// Wind the count back, as if the account had sent from elsewhere; the
+	// node refuses the used nonce and the next send reads it again
+	relayer.mu.Lock()
+	relayer.nonce = 0
+	relayer.mu.Unlock()
+	if _, err := relayer.Send(context.Background(), transferTo(to)); err == nil {
+		t.Fatal("Send() with a used nonce succeeded")
+	}
+
+	tx, err := relayer.Send(context.Background(), transferTo(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 1 {
+		t.Errorf("nonce after resync = %d, want 1", tx.Nonce())
+	}
+}
+
+func TestRelayerNotConfigured(t *testing.T) {
+	relayer := NewRelayer("", simulatedChainID, nil)
+	if _, err := relayer.Address(); !errors.Is(err, ErrRelayerNotConfigured) {
+		t.Errorf("Address() error = %v, want %v", err, ErrRelayerNotConfigured)
+	}
+	if _, err := relayer.Send(context.Background(), nil); !errors.Is(err, ErrRelayerNotConfigured) {
+		t.Errorf("Send() error = %v, want %v", err, ErrRelayerNotConfigured)
+	}
+}
@@ -0,0 +1,56 @@
+package chain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// RecoverSigner returns the address that produced a 65-byte signature over
+// hash, accepting both 0/1 and 27/28 recovery ids.
+func RecoverSigner(hash []byte, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("malformed signature")
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %v", err)
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// DecodeSignedTransaction parses a hex-encoded raw transaction, checks that
+// it is for chainID and returns it with its sender.
+func DecodeSignedTransaction(signedTx string, chainID int64) (*types.Transaction, common.Address, error) {
+	raw, err := hexutil.Decode(signedTx)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("signedTx is not valid hex: %v", err)
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, common.Address{}, fmt.Errorf("signedTx is not a valid transaction: %v", err)
+	}
+
+	id := big.NewInt(chainID)
+	if tx.ChainId().Cmp(id) != 0 {
+		return nil, common.Address{}, fmt.Errorf("transaction is for chain %s, expected %s", tx.ChainId(), id)
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(id), tx)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("cannot recover transaction sender: %v", err)
+	}
+
+	return tx, sender, nil
+}
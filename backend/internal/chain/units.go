@@ -0,0 +1,80 @@
+package chain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// VYRDecimals is VyraToken's decimals, the ERC-20 default. Amounts are
+// stored and accepted by the API in whole VYR with up to this many places.
+const VYRDecimals = 18
+
+// FormatUnits renders an integer token amount as a decimal string using the
+// given number of decimals, e.g. 1500000000000000000 with 18 decimals
+// becomes "1.5". Whole amounts keep a single trailing zero ("1000.0").
+func FormatUnits(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		amount = new(big.Int)
+	}
+
+	negative := amount.Sign() < 0
+	digits := new(big.Int).Abs(amount).String()
+
+	if decimals > 0 {
+		if len(digits) <= int(decimals) {
+			digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+		}
+		point := len(digits) - int(decimals)
+		fraction := strings.TrimRight(digits[point:], "0")
+		if fraction == "" {
+			fraction = "0"
+		}
+		digits = digits[:point] + "." + fraction
+	}
+
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+// ParseUnits converts a decimal string such as "10.5" into an integer token
+// amount with the given number of decimals. It rejects negative values,
+// exponents and more fractional digits than the token supports.
+func ParseUnits(value string, decimals uint8) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("amount is empty")
+	}
+
+	whole, fraction, hasPoint := strings.Cut(value, ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("invalid amount: %q", value)
+	}
+	if hasPoint && fraction == "" {
+		return nil, fmt.Errorf("invalid amount: %q", value)
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return nil, fmt.Errorf("invalid amount: %q", value)
+	}
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("amount %q has more than %d decimal places", value, decimals)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	amount, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", value)
+	}
+	return amount, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
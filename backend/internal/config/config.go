@@ -1,49 +1,341 @@
 package config
 
 import (
+	"fmt"
+	"math/big"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/ratelimit"
 
 	"github.com/joho/godotenv"
 )
 
+// EnvDevelopment is the APP_ENV of a local development setup, the only one
+// allowed to run with the default JWT secret.
+const EnvDevelopment = "development"
+
+// defaultJWTSecrets are the placeholder secrets shipped in the defaults,
+// env.example and docker-compose.yml.
+var defaultJWTSecrets = []string{"your-secret-key", "your-secret-key-change-this-in-production"}
+
+// minJWTSecretLength is the shortest JWT secret accepted outside development;
+// HS256 keys should be at least as long as the hash.
+const minJWTSecretLength = 32
+
 type Config struct {
-	Port         string
-	DatabaseURL  string
-	RedisURL     string
-	RPCURL       string
-	ChainID      int64
-	LogLevel     string
-	JWTSecret    string
-	VyraToken    string
-	Paymaster    string
-	POS          string
-	Bridge       string
-	EntryPoint   string
+	Env         string
+	Port        string
+	DatabaseURL string
+	RedisURL    string
+	RPCURLs     []string
+	RPCTimeout  time.Duration
+	ChainID     int64
+	LogLevel    string
+	JWTSecret   string
+	VyraToken   string
+	Paymaster   string
+	POS         string
+	Bridge      string
+	EntryPoint  string
+	RelayerKey  string
+	AutoMigrate bool
+
+	IndexerEnabled      bool
+	IndexerStartBlock   int64
+	IndexerBatchSize    int64
+	IndexerPollInterval time.Duration
+
+	WebhooksEnabled     bool
+	WebhookPollInterval time.Duration
+	WebhookMaxAttempts  int
+
+	InvoiceSweepInterval time.Duration
+
+	AuthDomain      string
+	AuthURI         string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	RateLimitEnabled bool
+	RateLimitStore   string
+	RateLimitDefault ratelimit.Limit
+	RateLimitStrict  ratelimit.Limit
+	TrustedProxies   []string
+
+	CORS CORSConfig
+
+	MetricsEnabled bool
+
+	ShutdownTimeout time.Duration
+
+	// RequestTimeout is how long a request may run before its context is
+	// cancelled, stopping its RPC and database calls
+	RequestTimeout time.Duration
+
+	// HealthMaxIndexerLag is how many blocks the indexer may fall behind
+	// before readiness reports it; HealthMinRelayerBalance is the relayer
+	// balance, in wei, below which readiness reports it
+	HealthMaxIndexerLag     int64
+	HealthMinRelayerBalance *big.Int
+}
+
+// CORSPolicy is who may call the API from a browser. AllowedOrigins holds
+// exact origins, such as "https://pay.vyra.app", or patterns with one "*"
+// standing for any run of host characters, such as "https://*.vyra.app";
+// "*" alone allows every origin.
+type CORSPolicy struct {
+	AllowedOrigins   []string
+	AllowCredentials bool
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	MaxAge           time.Duration
+}
+
+// CORSConfig holds the default CORS policy and the overrides for the route
+// groups used by the web checkout and the admin console. An override
+// inherits every setting it does not set from Default.
+type CORSConfig struct {
+	Default  CORSPolicy
+	Checkout CORSPolicy
+	Admin    CORSPolicy
 }
 
+// Rate limit stores
+const (
+	RateLimitMemory = "memory"
+	RateLimitRedis  = "redis"
+)
+
 func Load() (*Config, error) {
 	// Load .env file if it exists
 	_ = godotenv.Load()
 
 	chainID, _ := strconv.ParseInt(getEnv("CHAIN_ID", "31337"), 10, 64)
+	rpcTimeout, err := time.ParseDuration(getEnv("RPC_TIMEOUT", "15s"))
+	if err != nil || rpcTimeout <= 0 {
+		rpcTimeout = 15 * time.Second
+	}
+	autoMigrate, _ := strconv.ParseBool(getEnv("AUTO_MIGRATE", "true"))
+	indexerEnabled, _ := strconv.ParseBool(getEnv("INDEXER_ENABLED", "true"))
+	indexerStartBlock, _ := strconv.ParseInt(getEnv("INDEXER_START_BLOCK", "0"), 10, 64)
+	indexerBatchSize, err := strconv.ParseInt(getEnv("INDEXER_BATCH_SIZE", "2000"), 10, 64)
+	if err != nil || indexerBatchSize < 1 {
+		indexerBatchSize = 2000
+	}
+	indexerPollInterval, err := time.ParseDuration(getEnv("INDEXER_POLL_INTERVAL", "5s"))
+	if err != nil || indexerPollInterval <= 0 {
+		indexerPollInterval = 5 * time.Second
+	}
+	webhooksEnabled, _ := strconv.ParseBool(getEnv("WEBHOOKS_ENABLED", "true"))
+	webhookPollInterval, err := time.ParseDuration(getEnv("WEBHOOK_POLL_INTERVAL", "2s"))
+	if err != nil || webhookPollInterval <= 0 {
+		webhookPollInterval = 2 * time.Second
+	}
+	webhookMaxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "10"))
+	if err != nil || webhookMaxAttempts < 1 {
+		webhookMaxAttempts = 10
+	}
+	invoiceSweepInterval, err := time.ParseDuration(getEnv("INVOICE_SWEEP_INTERVAL", "10s"))
+	if err != nil || invoiceSweepInterval <= 0 {
+		invoiceSweepInterval = 10 * time.Second
+	}
+	accessTokenTTL, err := time.ParseDuration(getEnv("ACCESS_TOKEN_TTL", "15m"))
+	if err != nil || accessTokenTTL <= 0 {
+		accessTokenTTL = 15 * time.Minute
+	}
+	refreshTokenTTL, err := time.ParseDuration(getEnv("REFRESH_TOKEN_TTL", "720h"))
+	if err != nil || refreshTokenTTL <= 0 {
+		refreshTokenTTL = 720 * time.Hour
+	}
+	rateLimitEnabled, _ := strconv.ParseBool(getEnv("RATE_LIMIT_ENABLED", "true"))
+	rateLimitStore := getEnv("RATE_LIMIT_STORE", RateLimitMemory)
+	if rateLimitStore != RateLimitRedis {
+		rateLimitStore = RateLimitMemory
+	}
+	rateLimitDefault, err := ratelimit.ParseLimit(getEnv("RATE_LIMIT_DEFAULT", "100/1m"))
+	if err != nil {
+		rateLimitDefault = ratelimit.Limit{Requests: 100, Per: time.Minute, Burst: 100}
+	}
+	rateLimitStrict, err := ratelimit.ParseLimit(getEnv("RATE_LIMIT_STRICT", "10/1m"))
+	if err != nil {
+		rateLimitStrict = ratelimit.Limit{Requests: 10, Per: time.Minute, Burst: 10}
+	}
+	trustedProxies := getList("TRUSTED_PROXIES", "")
+	metricsEnabled, _ := strconv.ParseBool(getEnv("METRICS_ENABLED", "true"))
+	healthMaxIndexerLag, err := strconv.ParseInt(getEnv("HEALTH_MAX_INDEXER_LAG", "100"), 10, 64)
+	if err != nil || healthMaxIndexerLag < 0 {
+		healthMaxIndexerLag = 100
+	}
+	healthMinRelayerBalance, err := chain.ParseUnits(getEnv("HEALTH_MIN_RELAYER_BALANCE", "0.05"), 18)
+	if err != nil {
+		healthMinRelayerBalance, _ = chain.ParseUnits("0.05", 18)
+	}
+	shutdownTimeout, err := time.ParseDuration(getEnv("SHUTDOWN_TIMEOUT", "30s"))
+	if err != nil || shutdownTimeout <= 0 {
+		shutdownTimeout = 30 * time.Second
+	}
+
+	requestTimeout, err := time.ParseDuration(getEnv("REQUEST_TIMEOUT", "30s"))
+	if err != nil || requestTimeout <= 0 {
+		requestTimeout = 30 * time.Second
+	}
+
+	env := getEnv("APP_ENV", "production")
+
+	// Browsers may call from anywhere in development only
+	defaultOrigins := ""
+	if env == EnvDevelopment {
+		defaultOrigins = "*"
+	}
+	corsDefault := loadCORSPolicy("CORS_", CORSPolicy{
+		AllowedOrigins: splitList(defaultOrigins),
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Origin", "Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token",
+			"Authorization", "X-API-Key", "Idempotency-Key", "X-Request-ID"},
+		ExposedHeaders: []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After",
+			"X-Request-ID"},
+		MaxAge: 10 * time.Minute,
+	})
 
 	return &Config{
-		Port:         getEnv("PORT", "8080"),
-		DatabaseURL:  getEnv("DATABASE_URL", "postgres://localhost/vyra?sslmode=disable"),
-		RedisURL:     getEnv("REDIS_URL", "redis://localhost:6379"),
-		RPCURL:       getEnv("RPC_URL", "http://localhost:8545"),
-		ChainID:      chainID,
-		LogLevel:     getEnv("LOG_LEVEL", "info"),
-		JWTSecret:    getEnv("JWT_SECRET", "your-secret-key"),
-		VyraToken:    getEnv("VYRA_TOKEN_ADDRESS", "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
-		Paymaster:    getEnv("PAYMASTER_ADDRESS", "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"),
-		POS:          getEnv("POS_ADDRESS", "0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0"),
-		Bridge:       getEnv("BRIDGE_ADDRESS", "0xCf7Ed3AccA5a467e9e704C703E8D87F634fB0Fc9"),
-		EntryPoint:   getEnv("ENTRY_POINT_ADDRESS", "0x0165878A594ca255338adfa4d48449f69242Eb8F"),
+		Env:         env,
+		Port:        getEnv("PORT", "8080"),
+		DatabaseURL: getEnv("DATABASE_URL", "postgres://localhost/vyra?sslmode=disable"),
+		RedisURL:    getEnv("REDIS_URL", "redis://localhost:6379"),
+		RPCURLs:     getList("RPC_URL", "http://localhost:8545"),
+		RPCTimeout:  rpcTimeout,
+		ChainID:     chainID,
+		LogLevel:    getEnv("LOG_LEVEL", "info"),
+		JWTSecret:   getEnv("JWT_SECRET", "your-secret-key"),
+		VyraToken:   getEnv("VYRA_TOKEN_ADDRESS", "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+		Paymaster:   getEnv("PAYMASTER_ADDRESS", "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"),
+		POS:         getEnv("POS_ADDRESS", "0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0"),
+		Bridge:      getEnv("BRIDGE_ADDRESS", "0xCf7Ed3AccA5a467e9e704C703E8D87F634fB0Fc9"),
+		EntryPoint:  getEnv("ENTRY_POINT_ADDRESS", "0x0165878A594ca255338adfa4d48449f69242Eb8F"),
+		RelayerKey:  getEnv("RELAYER_PRIVATE_KEY", ""),
+		AutoMigrate: autoMigrate,
+
+		IndexerEnabled:      indexerEnabled,
+		IndexerStartBlock:   indexerStartBlock,
+		IndexerBatchSize:    indexerBatchSize,
+		IndexerPollInterval: indexerPollInterval,
+
+		WebhooksEnabled:     webhooksEnabled,
+		WebhookPollInterval: webhookPollInterval,
+		WebhookMaxAttempts:  webhookMaxAttempts,
+
+		InvoiceSweepInterval: invoiceSweepInterval,
+
+		AuthDomain:      getEnv("SIWE_DOMAIN", "localhost:8080"),
+		AuthURI:         getEnv("SIWE_URI", "http://localhost:8080"),
+		AccessTokenTTL:  accessTokenTTL,
+		RefreshTokenTTL: refreshTokenTTL,
+
+		RateLimitEnabled: rateLimitEnabled,
+		RateLimitStore:   rateLimitStore,
+		RateLimitDefault: rateLimitDefault,
+		RateLimitStrict:  rateLimitStrict,
+		TrustedProxies:   trustedProxies,
+
+		CORS: CORSConfig{
+			Default:  corsDefault,
+			Checkout: loadCORSPolicy("CORS_CHECKOUT_", corsDefault),
+			Admin:    loadCORSPolicy("CORS_ADMIN_", corsDefault),
+		},
+
+		MetricsEnabled: metricsEnabled,
+
+		ShutdownTimeout: shutdownTimeout,
+		RequestTimeout:  requestTimeout,
+
+		HealthMaxIndexerLag:     healthMaxIndexerLag,
+		HealthMinRelayerBalance: healthMinRelayerBalance,
 	}, nil
 }
 
+// loadCORSPolicy reads the CORS settings named prefix+"ALLOWED_ORIGINS",
+// "ALLOW_CREDENTIALS", "EXPOSED_HEADERS" and "MAX_AGE", keeping base's
+// value for any that is unset.
+func loadCORSPolicy(prefix string, base CORSPolicy) CORSPolicy {
+	policy := base
+	if origins, ok := os.LookupEnv(prefix + "ALLOWED_ORIGINS"); ok {
+		policy.AllowedOrigins = splitList(origins)
+	}
+	if credentials, err := strconv.ParseBool(os.Getenv(prefix + "ALLOW_CREDENTIALS")); err == nil {
+		policy.AllowCredentials = credentials
+	}
+	if headers, ok := os.LookupEnv(prefix + "EXPOSED_HEADERS"); ok {
+		policy.ExposedHeaders = splitList(headers)
+	}
+	if maxAge, err := time.ParseDuration(os.Getenv(prefix + "MAX_AGE")); err == nil && maxAge >= 0 {
+		policy.MaxAge = maxAge
+	}
+	return policy
+}
+
+// IsDevelopment reports whether APP_ENV is development.
+func (c *Config) IsDevelopment() bool {
+	return c.Env == EnvDevelopment
+}
+
+// Validate checks the configuration is safe to serve with: CORS policies
+// must be well-formed and, outside development, the JWT secret must be set
+// to something other than a shipped placeholder.
+func (c *Config) Validate() error {
+	if err := c.validateCORS(); err != nil {
+		return err
+	}
+	if c.IsDevelopment() {
+		return nil
+	}
+	for _, secret := range defaultJWTSecrets {
+		if c.JWTSecret == secret {
+			return fmt.Errorf("JWT_SECRET is set to the default; set a random secret or APP_ENV=%s", EnvDevelopment)
+		}
+	}
+	if len(c.JWTSecret) < minJWTSecretLength {
+		return fmt.Errorf("JWT_SECRET must be at least %d bytes", minJWTSecretLength)
+	}
+	return nil
+}
+
+func (c *Config) validateCORS() error {
+	policies := map[string]CORSPolicy{
+		"CORS_": c.CORS.Default, "CORS_CHECKOUT_": c.CORS.Checkout, "CORS_ADMIN_": c.CORS.Admin,
+	}
+	for prefix, policy := range policies {
+		for _, origin := range policy.AllowedOrigins {
+			if origin == "*" && policy.AllowCredentials {
+				return fmt.Errorf("%sALLOWED_ORIGINS cannot be * with %sALLOW_CREDENTIALS", prefix, prefix)
+			}
+			if strings.Count(origin, "*") > 1 {
+				return fmt.Errorf("%sALLOWED_ORIGINS: %q has more than one *", prefix, origin)
+			}
+		}
+	}
+	return nil
+}
+
+// getList reads a comma-separated list.
+func getList(key, defaultValue string) []string {
+	return splitList(getEnv(key, defaultValue))
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -0,0 +1,10 @@
+// Package contracts holds the go-ethereum bindings for the Vyra smart
+// contracts. The ABIs under abi/ are taken from the Foundry build output
+// in ../contracts; regenerate the bindings with `go generate` after a
+// contract interface changes.
+package contracts
+
+//go:generate abigen --abi abi/VyraToken.json --pkg contracts --type VyraToken --out vyratoken.go
+//go:generate abigen --abi abi/VyraPOS.json --pkg contracts --type VyraPOS --out vyrapos.go
+//go:generate abigen --abi abi/VyraBridge.json --pkg contracts --type VyraBridge --out vyrabridge.go
+//go:generate abigen --abi abi/VyraPaymaster.json --pkg contracts --type VyraPaymaster --out vyrapaymaster.go
@@ -0,0 +1,119 @@
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertError is a call to a Vyra contract that reverted with one of the
+// contracts' custom errors, or with a require message or panic.
+type RevertError struct {
+	// Name is the custom error's name, or "Error" for a require message
+	// and "Panic" for a panic
+	Name string
+	// Args are the error's arguments, formatted as strings: addresses in
+	// hex, integers in decimal
+	Args []string
+	// Reason is the require message or panic description
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason != "" {
+		return "execution reverted: " + e.Reason
+	}
+	return fmt.Sprintf("execution reverted: %s(%s)", e.Name, strings.Join(e.Args, ", "))
+}
+
+// panicSelector is the selector of Panic(uint256)
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+var (
+	customErrorsOnce sync.Once
+	customErrors     []abi.Error
+)
+
+// loadCustomErrors collects the custom errors of every Vyra contract.
+// Errors the contracts share, such as InvalidSignature, have the same
+// selector, so it does not matter which contract's is found.
+func loadCustomErrors() {
+	for _, meta := range []*bind.MetaData{VyraTokenMetaData, VyraPOSMetaData, VyraBridgeMetaData, VyraPaymasterMetaData} {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, e := range parsed.Errors {
+			customErrors = append(customErrors, e)
+		}
+	}
+}
+
+// DecodeRevert returns the revert in a failed call or gas estimate, or nil
+// if err carries no revert data this package can decode.
+func DecodeRevert(err error) *RevertError {
+	var revert *RevertError
+	if errors.As(err, &revert) {
+		return revert
+	}
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil
+	}
+	raw, decodeErr := hexutil.Decode(data)
+	if decodeErr != nil || len(raw) < 4 {
+		return nil
+	}
+
+	// Error(string) and Panic(uint256)
+	if reason, unpackErr := abi.UnpackRevert(raw); unpackErr == nil {
+		name := "Error"
+		if bytes.Equal(raw[:4], panicSelector) {
+			name = "Panic"
+		}
+		return &RevertError{Name: name, Reason: reason}
+	}
+
+	customErrorsOnce.Do(loadCustomErrors)
+	for _, e := range customErrors {
+		if !bytes.Equal(e.ID[:4], raw[:4]) {
+			continue
+		}
+		revert := &RevertError{Name: e.Name}
+		if values, err := e.Inputs.Unpack(raw[4:]); err == nil {
+			for _, v := range values {
+				revert.Args = append(revert.Args, formatArg(v))
+			}
+		}
+		return revert
+	}
+	return nil
+}
+
+func formatArg(v interface{}) string {
+	switch v := v.(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case []byte:
+		return hexutil.Encode(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
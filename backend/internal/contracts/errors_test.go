@@ -0,0 +1,136 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// dataError is a reverted call or gas estimate as a node reports it, with
+// the revert data in the error data.
+type dataError struct {
+	data interface{}
+}
+
+func (e dataError) Error() string          { return "execution reverted" }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+// revertData encodes a custom error of a contract as it reverts with it.
+func revertData(t *testing.T, meta *bind.MetaData, name string, args ...interface{}) string {
+	t.Helper()
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, ok := parsed.Errors[name]
+	if !ok {
+		t.Fatalf("no error %s", name)
+	}
+	packed, err := e.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(append(e.ID.Bytes()[:4], packed...))
+}
+
+// builtinRevert encodes Error(string) or Panic(uint256).
+func builtinRevert(t *testing.T, name, typ string, arg interface{}) string {
+	t.Helper()
+	argType, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := abi.Arguments{{Type: argType}}.Pack(arg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := crypto.Keccak256([]byte(name + "(" + typ + ")"))[:4]
+	return hexutil.Encode(append(selector, packed...))
+}
+
+func TestDecodeRevert(t *testing.T) {
+	account := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	role := common.HexToHash("0x2222222222222222222222222222222222222222222222222222222222222222")
+	decoded := &RevertError{Name: "InvoiceExpired"}
+
+	tests := []struct {
+		name string
+		err  error
+		want *RevertError
+	}{
+		{
+			name: "VyraPOS error",
+			err:  dataError{revertData(t, VyraPOSMetaData, "InvoiceExpired")},
+			want: &RevertError{Name: "InvoiceExpired"},
+		},
+		{
+			name: "error shared by the contracts",
+			err:  dataError{revertData(t, VyraPOSMetaData, "InvalidSignature")},
+			want: &RevertError{Name: "InvalidSignature"},
+		},
+		{
+			name: "VyraToken error with arguments",
+			err:  dataError{revertData(t, VyraTokenMetaData, "ERC20InsufficientAllowance", account, big.NewInt(1), big.NewInt(2))},
+			want: &RevertError{Name: "ERC20InsufficientAllowance", Args: []string{account.Hex(), "1", "2"}},
+		},
+		{
+			name: "bytes32 argument",
+			err:  dataError{revertData(t, VyraPOSMetaData, "AccessControlUnauthorizedAccount", account, [32]byte(role))},
+			want: &RevertError{Name: "AccessControlUnauthorizedAccount", Args: []string{account.Hex(), role.Hex()}},
+		},
+		{
+			name: "require message",
+			err:  dataError{builtinRevert(t, "Error", "string", "amount too large")},
+			want: &RevertError{Name: "Error", Reason: "amount too large"},
+		},
+		{
+			name: "panic",
+			err:  dataError{builtinRevert(t, "Panic", "uint256", big.NewInt(0x11))},
+			want: &RevertError{Name: "Panic", Reason: "arithmetic underflow or overflow"},
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("failed to estimate gas: %w", dataError{revertData(t, VyraPOSMetaData, "InvoiceExpired")}),
+			want: &RevertError{Name: "InvoiceExpired"},
+		},
+		{
+			name: "already decoded",
+			err:  fmt.Errorf("rejected: %w", decoded),
+			want: decoded,
+		},
+		{name: "unknown selector", err: dataError{"0x12345678"}},
+		{name: "too short", err: dataError{"0x1234"}},
+		{name: "not hex", err: dataError{"reverted"}},
+		{name: "not a string", err: dataError{[]byte{0x12, 0x34, 0x56, 0x78}}},
+		{name: "no data", err: errors.New("execution reverted")},
+	}
+	for _, tt := range tests {
+		if got := DecodeRevert(tt.err); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: DecodeRevert = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRevertErrorMessage(t *testing.T) {
+	tests := []struct {
+		revert *RevertError
+		want   string
+	}{
+		{&RevertError{Name: "InvoiceExpired"}, "execution reverted: InvoiceExpired()"},
+		{&RevertError{Name: "ERC20InsufficientBalance", Args: []string{"0xabc", "1", "2"}}, "execution reverted: ERC20InsufficientBalance(0xabc, 1, 2)"},
+		{&RevertError{Name: "Error", Reason: "amount too large"}, "execution reverted: amount too large"},
+	}
+	for _, tt := range tests {
+		if got := tt.revert.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,3154 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VyraBridgeMetaData contains all meta data concerning the VyraBridge contract.
+var VyraBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_vyraToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l2Bridge\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeTreasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"EMERGENCY_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FEE_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MIN_SIGNATURES\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"RELAYER_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VALIDATOR_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"addValidator\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"bridgeFeeRate\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"depositId\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"feeTreasury\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBridgeStats\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"_totalDeposits\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_totalWithdrawals\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_totalFees\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_validatorCount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getValidators\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initiateWithdrawal\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"l2TxHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes[]\",\"name\":\"signatures\",\"type\":\"bytes[]\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"withdrawalId\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isValidator\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"l2Bridge\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"pendingWithdrawals\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"processDeposit\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"depositId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes[]\",\"name\":\"signatures\",\"type\":\"bytes[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"processedDeposits\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"processedWithdrawals\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"recoverToken\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"removeValidator\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBridgeFeeRate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setFeeTreasury\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"totalDeposits\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalFees\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalWithdrawals\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validators\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vyraToken\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"DepositInitiated\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"depositId\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"WithdrawalInitiated\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"withdrawalId\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"DepositProcessed\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"depositId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"WithdrawalProcessed\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"withdrawalId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorAdded\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorRemoved\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BridgeFeeUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FeeTreasuryUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oldTreasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidValidator\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DepositAlreadyProcessed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"WithdrawalAlreadyProcessed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidAmount\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidDepositId\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidWithdrawalId\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientSignatures\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DuplicateSignature\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}]}]",
+}
+
+// VyraBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use VyraBridgeMetaData.ABI instead.
+var VyraBridgeABI = VyraBridgeMetaData.ABI
+
+// VyraBridge is an auto generated Go binding around an Ethereum contract.
+type VyraBridge struct {
+	VyraBridgeCaller     // Read-only binding to the contract
+	VyraBridgeTransactor // Write-only binding to the contract
+	VyraBridgeFilterer   // Log filterer for contract events
+}
+
+// VyraBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VyraBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VyraBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VyraBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VyraBridgeSession struct {
+	Contract     *VyraBridge       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VyraBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VyraBridgeCallerSession struct {
+	Contract *VyraBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// VyraBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VyraBridgeTransactorSession struct {
+	Contract     *VyraBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// VyraBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VyraBridgeRaw struct {
+	Contract *VyraBridge // Generic contract binding to access the raw methods on
+}
+
+// VyraBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VyraBridgeCallerRaw struct {
+	Contract *VyraBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VyraBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VyraBridgeTransactorRaw struct {
+	Contract *VyraBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVyraBridge creates a new instance of VyraBridge, bound to a specific deployed contract.
+func NewVyraBridge(address common.Address, backend bind.ContractBackend) (*VyraBridge, error) {
+	contract, err := bindVyraBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridge{VyraBridgeCaller: VyraBridgeCaller{contract: contract}, VyraBridgeTransactor: VyraBridgeTransactor{contract: contract}, VyraBridgeFilterer: VyraBridgeFilterer{contract: contract}}, nil
+}
+
+// NewVyraBridgeCaller creates a new read-only instance of VyraBridge, bound to a specific deployed contract.
+func NewVyraBridgeCaller(address common.Address, caller bind.ContractCaller) (*VyraBridgeCaller, error) {
+	contract, err := bindVyraBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeCaller{contract: contract}, nil
+}
+
+// NewVyraBridgeTransactor creates a new write-only instance of VyraBridge, bound to a specific deployed contract.
+func NewVyraBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*VyraBridgeTransactor, error) {
+	contract, err := bindVyraBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeTransactor{contract: contract}, nil
+}
+
+// NewVyraBridgeFilterer creates a new log filterer instance of VyraBridge, bound to a specific deployed contract.
+func NewVyraBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*VyraBridgeFilterer, error) {
+	contract, err := bindVyraBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeFilterer{contract: contract}, nil
+}
+
+// bindVyraBridge binds a generic wrapper to an already deployed contract.
+func bindVyraBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VyraBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraBridge *VyraBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraBridge.Contract.VyraBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraBridge *VyraBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraBridge.Contract.VyraBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraBridge *VyraBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraBridge.Contract.VyraBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraBridge *VyraBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraBridge *VyraBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraBridge *VyraBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.DEFAULTADMINROLE(&_VyraBridge.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.DEFAULTADMINROLE(&_VyraBridge.CallOpts)
+}
+
+// EMERGENCYROLE is a free data retrieval call binding the contract method 0x20df4359.
+//
+// Solidity: function EMERGENCY_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCaller) EMERGENCYROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "EMERGENCY_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// EMERGENCYROLE is a free data retrieval call binding the contract method 0x20df4359.
+//
+// Solidity: function EMERGENCY_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeSession) EMERGENCYROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.EMERGENCYROLE(&_VyraBridge.CallOpts)
+}
+
+// EMERGENCYROLE is a free data retrieval call binding the contract method 0x20df4359.
+//
+// Solidity: function EMERGENCY_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCallerSession) EMERGENCYROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.EMERGENCYROLE(&_VyraBridge.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) FEEDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "FEE_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraBridge.Contract.FEEDENOMINATOR(&_VyraBridge.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraBridge.Contract.FEEDENOMINATOR(&_VyraBridge.CallOpts)
+}
+
+// MINSIGNATURES is a free data retrieval call binding the contract method 0x1a179692.
+//
+// Solidity: function MIN_SIGNATURES() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) MINSIGNATURES(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "MIN_SIGNATURES")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MINSIGNATURES is a free data retrieval call binding the contract method 0x1a179692.
+//
+// Solidity: function MIN_SIGNATURES() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) MINSIGNATURES() (*big.Int, error) {
+	return _VyraBridge.Contract.MINSIGNATURES(&_VyraBridge.CallOpts)
+}
+
+// MINSIGNATURES is a free data retrieval call binding the contract method 0x1a179692.
+//
+// Solidity: function MIN_SIGNATURES() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) MINSIGNATURES() (*big.Int, error) {
+	return _VyraBridge.Contract.MINSIGNATURES(&_VyraBridge.CallOpts)
+}
+
+// RELAYERROLE is a free data retrieval call binding the contract method 0x926d7d7f.
+//
+// Solidity: function RELAYER_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCaller) RELAYERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "RELAYER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// RELAYERROLE is a free data retrieval call binding the contract method 0x926d7d7f.
+//
+// Solidity: function RELAYER_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeSession) RELAYERROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.RELAYERROLE(&_VyraBridge.CallOpts)
+}
+
+// RELAYERROLE is a free data retrieval call binding the contract method 0x926d7d7f.
+//
+// Solidity: function RELAYER_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCallerSession) RELAYERROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.RELAYERROLE(&_VyraBridge.CallOpts)
+}
+
+// VALIDATORROLE is a free data retrieval call binding the contract method 0xc49baebe.
+//
+// Solidity: function VALIDATOR_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCaller) VALIDATORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "VALIDATOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// VALIDATORROLE is a free data retrieval call binding the contract method 0xc49baebe.
+//
+// Solidity: function VALIDATOR_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeSession) VALIDATORROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.VALIDATORROLE(&_VyraBridge.CallOpts)
+}
+
+// VALIDATORROLE is a free data retrieval call binding the contract method 0xc49baebe.
+//
+// Solidity: function VALIDATOR_ROLE() view returns(bytes32)
+func (_VyraBridge *VyraBridgeCallerSession) VALIDATORROLE() ([32]byte, error) {
+	return _VyraBridge.Contract.VALIDATORROLE(&_VyraBridge.CallOpts)
+}
+
+// BridgeFeeRate is a free data retrieval call binding the contract method 0x986d338b.
+//
+// Solidity: function bridgeFeeRate() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) BridgeFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "bridgeFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BridgeFeeRate is a free data retrieval call binding the contract method 0x986d338b.
+//
+// Solidity: function bridgeFeeRate() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) BridgeFeeRate() (*big.Int, error) {
+	return _VyraBridge.Contract.BridgeFeeRate(&_VyraBridge.CallOpts)
+}
+
+// BridgeFeeRate is a free data retrieval call binding the contract method 0x986d338b.
+//
+// Solidity: function bridgeFeeRate() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) BridgeFeeRate() (*big.Int, error) {
+	return _VyraBridge.Contract.BridgeFeeRate(&_VyraBridge.CallOpts)
+}
+
+// FeeTreasury is a free data retrieval call binding the contract method 0x60dc2340.
+//
+// Solidity: function feeTreasury() view returns(address)
+func (_VyraBridge *VyraBridgeCaller) FeeTreasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "feeTreasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeTreasury is a free data retrieval call binding the contract method 0x60dc2340.
+//
+// Solidity: function feeTreasury() view returns(address)
+func (_VyraBridge *VyraBridgeSession) FeeTreasury() (common.Address, error) {
+	return _VyraBridge.Contract.FeeTreasury(&_VyraBridge.CallOpts)
+}
+
+// FeeTreasury is a free data retrieval call binding the contract method 0x60dc2340.
+//
+// Solidity: function feeTreasury() view returns(address)
+func (_VyraBridge *VyraBridgeCallerSession) FeeTreasury() (common.Address, error) {
+	return _VyraBridge.Contract.FeeTreasury(&_VyraBridge.CallOpts)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _totalDeposits, uint256 _totalWithdrawals, uint256 _totalFees, uint256 _validatorCount)
+func (_VyraBridge *VyraBridgeCaller) GetBridgeStats(opts *bind.CallOpts) (struct {
+	TotalDeposits    *big.Int
+	TotalWithdrawals *big.Int
+	TotalFees        *big.Int
+	ValidatorCount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "getBridgeStats")
+
+	outstruct := new(struct {
+		TotalDeposits    *big.Int
+		TotalWithdrawals *big.Int
+		TotalFees        *big.Int
+		ValidatorCount   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalDeposits = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TotalWithdrawals = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TotalFees = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.ValidatorCount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _totalDeposits, uint256 _totalWithdrawals, uint256 _totalFees, uint256 _validatorCount)
+func (_VyraBridge *VyraBridgeSession) GetBridgeStats() (struct {
+	TotalDeposits    *big.Int
+	TotalWithdrawals *big.Int
+	TotalFees        *big.Int
+	ValidatorCount   *big.Int
+}, error) {
+	return _VyraBridge.Contract.GetBridgeStats(&_VyraBridge.CallOpts)
+}
+
+// GetBridgeStats is a free data retrieval call binding the contract method 0x2165cbb7.
+//
+// Solidity: function getBridgeStats() view returns(uint256 _totalDeposits, uint256 _totalWithdrawals, uint256 _totalFees, uint256 _validatorCount)
+func (_VyraBridge *VyraBridgeCallerSession) GetBridgeStats() (struct {
+	TotalDeposits    *big.Int
+	TotalWithdrawals *big.Int
+	TotalFees        *big.Int
+	ValidatorCount   *big.Int
+}, error) {
+	return _VyraBridge.Contract.GetBridgeStats(&_VyraBridge.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraBridge *VyraBridgeCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraBridge *VyraBridgeSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraBridge.Contract.GetRoleAdmin(&_VyraBridge.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraBridge *VyraBridgeCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraBridge.Contract.GetRoleAdmin(&_VyraBridge.CallOpts, role)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_VyraBridge *VyraBridgeCaller) GetValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "getValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_VyraBridge *VyraBridgeSession) GetValidators() ([]common.Address, error) {
+	return _VyraBridge.Contract.GetValidators(&_VyraBridge.CallOpts)
+}
+
+// GetValidators is a free data retrieval call binding the contract method 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[])
+func (_VyraBridge *VyraBridgeCallerSession) GetValidators() ([]common.Address, error) {
+	return _VyraBridge.Contract.GetValidators(&_VyraBridge.CallOpts)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraBridge *VyraBridgeSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraBridge.Contract.HasRole(&_VyraBridge.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraBridge.Contract.HasRole(&_VyraBridge.CallOpts, role, account)
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address ) view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) IsValidator(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "isValidator", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address ) view returns(bool)
+func (_VyraBridge *VyraBridgeSession) IsValidator(arg0 common.Address) (bool, error) {
+	return _VyraBridge.Contract.IsValidator(&_VyraBridge.CallOpts, arg0)
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address ) view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) IsValidator(arg0 common.Address) (bool, error) {
+	return _VyraBridge.Contract.IsValidator(&_VyraBridge.CallOpts, arg0)
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_VyraBridge *VyraBridgeCaller) L2Bridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "l2Bridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_VyraBridge *VyraBridgeSession) L2Bridge() (common.Address, error) {
+	return _VyraBridge.Contract.L2Bridge(&_VyraBridge.CallOpts)
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_VyraBridge *VyraBridgeCallerSession) L2Bridge() (common.Address, error) {
+	return _VyraBridge.Contract.L2Bridge(&_VyraBridge.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraBridge *VyraBridgeSession) Paused() (bool, error) {
+	return _VyraBridge.Contract.Paused(&_VyraBridge.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) Paused() (bool, error) {
+	return _VyraBridge.Contract.Paused(&_VyraBridge.CallOpts)
+}
+
+// PendingWithdrawals is a free data retrieval call binding the contract method 0xf3f43703.
+//
+// Solidity: function pendingWithdrawals(address ) view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) PendingWithdrawals(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "pendingWithdrawals", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingWithdrawals is a free data retrieval call binding the contract method 0xf3f43703.
+//
+// Solidity: function pendingWithdrawals(address ) view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) PendingWithdrawals(arg0 common.Address) (*big.Int, error) {
+	return _VyraBridge.Contract.PendingWithdrawals(&_VyraBridge.CallOpts, arg0)
+}
+
+// PendingWithdrawals is a free data retrieval call binding the contract method 0xf3f43703.
+//
+// Solidity: function pendingWithdrawals(address ) view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) PendingWithdrawals(arg0 common.Address) (*big.Int, error) {
+	return _VyraBridge.Contract.PendingWithdrawals(&_VyraBridge.CallOpts, arg0)
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0x40c5faf0.
+//
+// Solidity: function processedDeposits(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) ProcessedDeposits(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "processedDeposits", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0x40c5faf0.
+//
+// Solidity: function processedDeposits(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeSession) ProcessedDeposits(arg0 [32]byte) (bool, error) {
+	return _VyraBridge.Contract.ProcessedDeposits(&_VyraBridge.CallOpts, arg0)
+}
+
+// ProcessedDeposits is a free data retrieval call binding the contract method 0x40c5faf0.
+//
+// Solidity: function processedDeposits(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) ProcessedDeposits(arg0 [32]byte) (bool, error) {
+	return _VyraBridge.Contract.ProcessedDeposits(&_VyraBridge.CallOpts, arg0)
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) ProcessedWithdrawals(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "processedWithdrawals", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeSession) ProcessedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _VyraBridge.Contract.ProcessedWithdrawals(&_VyraBridge.CallOpts, arg0)
+}
+
+// ProcessedWithdrawals is a free data retrieval call binding the contract method 0xbf49d631.
+//
+// Solidity: function processedWithdrawals(bytes32 ) view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) ProcessedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _VyraBridge.Contract.ProcessedWithdrawals(&_VyraBridge.CallOpts, arg0)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraBridge *VyraBridgeCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraBridge *VyraBridgeSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraBridge.Contract.SupportsInterface(&_VyraBridge.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraBridge *VyraBridgeCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraBridge.Contract.SupportsInterface(&_VyraBridge.CallOpts, interfaceId)
+}
+
+// TotalDeposits is a free data retrieval call binding the contract method 0x7d882097.
+//
+// Solidity: function totalDeposits() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) TotalDeposits(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "totalDeposits")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalDeposits is a free data retrieval call binding the contract method 0x7d882097.
+//
+// Solidity: function totalDeposits() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) TotalDeposits() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalDeposits(&_VyraBridge.CallOpts)
+}
+
+// TotalDeposits is a free data retrieval call binding the contract method 0x7d882097.
+//
+// Solidity: function totalDeposits() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) TotalDeposits() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalDeposits(&_VyraBridge.CallOpts)
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) TotalFees(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "totalFees")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) TotalFees() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalFees(&_VyraBridge.CallOpts)
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) TotalFees() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalFees(&_VyraBridge.CallOpts)
+}
+
+// TotalWithdrawals is a free data retrieval call binding the contract method 0x60464627.
+//
+// Solidity: function totalWithdrawals() view returns(uint256)
+func (_VyraBridge *VyraBridgeCaller) TotalWithdrawals(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "totalWithdrawals")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalWithdrawals is a free data retrieval call binding the contract method 0x60464627.
+//
+// Solidity: function totalWithdrawals() view returns(uint256)
+func (_VyraBridge *VyraBridgeSession) TotalWithdrawals() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalWithdrawals(&_VyraBridge.CallOpts)
+}
+
+// TotalWithdrawals is a free data retrieval call binding the contract method 0x60464627.
+//
+// Solidity: function totalWithdrawals() view returns(uint256)
+func (_VyraBridge *VyraBridgeCallerSession) TotalWithdrawals() (*big.Int, error) {
+	return _VyraBridge.Contract.TotalWithdrawals(&_VyraBridge.CallOpts)
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_VyraBridge *VyraBridgeCaller) Validators(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "validators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_VyraBridge *VyraBridgeSession) Validators(arg0 *big.Int) (common.Address, error) {
+	return _VyraBridge.Contract.Validators(&_VyraBridge.CallOpts, arg0)
+}
+
+// Validators is a free data retrieval call binding the contract method 0x35aa2e44.
+//
+// Solidity: function validators(uint256 ) view returns(address)
+func (_VyraBridge *VyraBridgeCallerSession) Validators(arg0 *big.Int) (common.Address, error) {
+	return _VyraBridge.Contract.Validators(&_VyraBridge.CallOpts, arg0)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraBridge *VyraBridgeCaller) VyraToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraBridge.contract.Call(opts, &out, "vyraToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraBridge *VyraBridgeSession) VyraToken() (common.Address, error) {
+	return _VyraBridge.Contract.VyraToken(&_VyraBridge.CallOpts)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraBridge *VyraBridgeCallerSession) VyraToken() (common.Address, error) {
+	return _VyraBridge.Contract.VyraToken(&_VyraBridge.CallOpts)
+}
+
+// AddValidator is a paid mutator transaction binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeTransactor) AddValidator(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "addValidator", validator)
+}
+
+// AddValidator is a paid mutator transaction binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeSession) AddValidator(validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.AddValidator(&_VyraBridge.TransactOpts, validator)
+}
+
+// AddValidator is a paid mutator transaction binding the contract method 0x4d238c8e.
+//
+// Solidity: function addValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) AddValidator(validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.AddValidator(&_VyraBridge.TransactOpts, validator)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(bytes32 depositId)
+func (_VyraBridge *VyraBridgeTransactor) Deposit(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "deposit", amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(bytes32 depositId)
+func (_VyraBridge *VyraBridgeSession) Deposit(amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.Deposit(&_VyraBridge.TransactOpts, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xb6b55f25.
+//
+// Solidity: function deposit(uint256 amount) returns(bytes32 depositId)
+func (_VyraBridge *VyraBridgeTransactorSession) Deposit(amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.Deposit(&_VyraBridge.TransactOpts, amount)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.GrantRole(&_VyraBridge.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.GrantRole(&_VyraBridge.TransactOpts, role, account)
+}
+
+// InitiateWithdrawal is a paid mutator transaction binding the contract method 0x3c855742.
+//
+// Solidity: function initiateWithdrawal(uint256 amount, bytes32 l2TxHash, bytes[] signatures) returns(bytes32 withdrawalId)
+func (_VyraBridge *VyraBridgeTransactor) InitiateWithdrawal(opts *bind.TransactOpts, amount *big.Int, l2TxHash [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "initiateWithdrawal", amount, l2TxHash, signatures)
+}
+
+// InitiateWithdrawal is a paid mutator transaction binding the contract method 0x3c855742.
+//
+// Solidity: function initiateWithdrawal(uint256 amount, bytes32 l2TxHash, bytes[] signatures) returns(bytes32 withdrawalId)
+func (_VyraBridge *VyraBridgeSession) InitiateWithdrawal(amount *big.Int, l2TxHash [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.Contract.InitiateWithdrawal(&_VyraBridge.TransactOpts, amount, l2TxHash, signatures)
+}
+
+// InitiateWithdrawal is a paid mutator transaction binding the contract method 0x3c855742.
+//
+// Solidity: function initiateWithdrawal(uint256 amount, bytes32 l2TxHash, bytes[] signatures) returns(bytes32 withdrawalId)
+func (_VyraBridge *VyraBridgeTransactorSession) InitiateWithdrawal(amount *big.Int, l2TxHash [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.Contract.InitiateWithdrawal(&_VyraBridge.TransactOpts, amount, l2TxHash, signatures)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraBridge *VyraBridgeTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraBridge *VyraBridgeSession) Pause() (*types.Transaction, error) {
+	return _VyraBridge.Contract.Pause(&_VyraBridge.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraBridge *VyraBridgeTransactorSession) Pause() (*types.Transaction, error) {
+	return _VyraBridge.Contract.Pause(&_VyraBridge.TransactOpts)
+}
+
+// ProcessDeposit is a paid mutator transaction binding the contract method 0x1c66f4bb.
+//
+// Solidity: function processDeposit(bytes32 depositId, bytes[] signatures) returns()
+func (_VyraBridge *VyraBridgeTransactor) ProcessDeposit(opts *bind.TransactOpts, depositId [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "processDeposit", depositId, signatures)
+}
+
+// ProcessDeposit is a paid mutator transaction binding the contract method 0x1c66f4bb.
+//
+// Solidity: function processDeposit(bytes32 depositId, bytes[] signatures) returns()
+func (_VyraBridge *VyraBridgeSession) ProcessDeposit(depositId [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.Contract.ProcessDeposit(&_VyraBridge.TransactOpts, depositId, signatures)
+}
+
+// ProcessDeposit is a paid mutator transaction binding the contract method 0x1c66f4bb.
+//
+// Solidity: function processDeposit(bytes32 depositId, bytes[] signatures) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) ProcessDeposit(depositId [32]byte, signatures [][]byte) (*types.Transaction, error) {
+	return _VyraBridge.Contract.ProcessDeposit(&_VyraBridge.TransactOpts, depositId, signatures)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraBridge *VyraBridgeTransactor) RecoverToken(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "recoverToken", token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraBridge *VyraBridgeSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RecoverToken(&_VyraBridge.TransactOpts, token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RecoverToken(&_VyraBridge.TransactOpts, token, amount)
+}
+
+// RemoveValidator is a paid mutator transaction binding the contract method 0x40a141ff.
+//
+// Solidity: function removeValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeTransactor) RemoveValidator(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "removeValidator", validator)
+}
+
+// RemoveValidator is a paid mutator transaction binding the contract method 0x40a141ff.
+//
+// Solidity: function removeValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeSession) RemoveValidator(validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RemoveValidator(&_VyraBridge.TransactOpts, validator)
+}
+
+// RemoveValidator is a paid mutator transaction binding the contract method 0x40a141ff.
+//
+// Solidity: function removeValidator(address validator) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) RemoveValidator(validator common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RemoveValidator(&_VyraBridge.TransactOpts, validator)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraBridge *VyraBridgeTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraBridge *VyraBridgeSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RenounceRole(&_VyraBridge.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RenounceRole(&_VyraBridge.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RevokeRole(&_VyraBridge.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.RevokeRole(&_VyraBridge.TransactOpts, role, account)
+}
+
+// SetBridgeFeeRate is a paid mutator transaction binding the contract method 0xa62e9706.
+//
+// Solidity: function setBridgeFeeRate(uint256 newRate) returns()
+func (_VyraBridge *VyraBridgeTransactor) SetBridgeFeeRate(opts *bind.TransactOpts, newRate *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "setBridgeFeeRate", newRate)
+}
+
+// SetBridgeFeeRate is a paid mutator transaction binding the contract method 0xa62e9706.
+//
+// Solidity: function setBridgeFeeRate(uint256 newRate) returns()
+func (_VyraBridge *VyraBridgeSession) SetBridgeFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.SetBridgeFeeRate(&_VyraBridge.TransactOpts, newRate)
+}
+
+// SetBridgeFeeRate is a paid mutator transaction binding the contract method 0xa62e9706.
+//
+// Solidity: function setBridgeFeeRate(uint256 newRate) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) SetBridgeFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraBridge.Contract.SetBridgeFeeRate(&_VyraBridge.TransactOpts, newRate)
+}
+
+// SetFeeTreasury is a paid mutator transaction binding the contract method 0xbfa37e37.
+//
+// Solidity: function setFeeTreasury(address newTreasury) returns()
+func (_VyraBridge *VyraBridgeTransactor) SetFeeTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "setFeeTreasury", newTreasury)
+}
+
+// SetFeeTreasury is a paid mutator transaction binding the contract method 0xbfa37e37.
+//
+// Solidity: function setFeeTreasury(address newTreasury) returns()
+func (_VyraBridge *VyraBridgeSession) SetFeeTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.SetFeeTreasury(&_VyraBridge.TransactOpts, newTreasury)
+}
+
+// SetFeeTreasury is a paid mutator transaction binding the contract method 0xbfa37e37.
+//
+// Solidity: function setFeeTreasury(address newTreasury) returns()
+func (_VyraBridge *VyraBridgeTransactorSession) SetFeeTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraBridge.Contract.SetFeeTreasury(&_VyraBridge.TransactOpts, newTreasury)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraBridge *VyraBridgeTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraBridge.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraBridge *VyraBridgeSession) Unpause() (*types.Transaction, error) {
+	return _VyraBridge.Contract.Unpause(&_VyraBridge.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraBridge *VyraBridgeTransactorSession) Unpause() (*types.Transaction, error) {
+	return _VyraBridge.Contract.Unpause(&_VyraBridge.TransactOpts)
+}
+
+// VyraBridgeBridgeFeeUpdatedIterator is returned from FilterBridgeFeeUpdated and is used to iterate over the raw logs and unpacked data for BridgeFeeUpdated events raised by the VyraBridge contract.
+type VyraBridgeBridgeFeeUpdatedIterator struct {
+	Event *VyraBridgeBridgeFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeBridgeFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeBridgeFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeBridgeFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeBridgeFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeBridgeFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeBridgeFeeUpdated represents a BridgeFeeUpdated event raised by the VyraBridge contract.
+type VyraBridgeBridgeFeeUpdated struct {
+	OldRate *big.Int
+	NewRate *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterBridgeFeeUpdated is a free log retrieval operation binding the contract event 0x3e7af433b010df0902f945628a14ef63c63e1e5e8306127bde522445a470588f.
+//
+// Solidity: event BridgeFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraBridge *VyraBridgeFilterer) FilterBridgeFeeUpdated(opts *bind.FilterOpts) (*VyraBridgeBridgeFeeUpdatedIterator, error) {
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "BridgeFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeBridgeFeeUpdatedIterator{contract: _VyraBridge.contract, event: "BridgeFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBridgeFeeUpdated is a free log subscription operation binding the contract event 0x3e7af433b010df0902f945628a14ef63c63e1e5e8306127bde522445a470588f.
+//
+// Solidity: event BridgeFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraBridge *VyraBridgeFilterer) WatchBridgeFeeUpdated(opts *bind.WatchOpts, sink chan<- *VyraBridgeBridgeFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "BridgeFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeBridgeFeeUpdated)
+				if err := _VyraBridge.contract.UnpackLog(event, "BridgeFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBridgeFeeUpdated is a log parse operation binding the contract event 0x3e7af433b010df0902f945628a14ef63c63e1e5e8306127bde522445a470588f.
+//
+// Solidity: event BridgeFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraBridge *VyraBridgeFilterer) ParseBridgeFeeUpdated(log types.Log) (*VyraBridgeBridgeFeeUpdated, error) {
+	event := new(VyraBridgeBridgeFeeUpdated)
+	if err := _VyraBridge.contract.UnpackLog(event, "BridgeFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeDepositInitiatedIterator is returned from FilterDepositInitiated and is used to iterate over the raw logs and unpacked data for DepositInitiated events raised by the VyraBridge contract.
+type VyraBridgeDepositInitiatedIterator struct {
+	Event *VyraBridgeDepositInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeDepositInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeDepositInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeDepositInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeDepositInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeDepositInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeDepositInitiated represents a DepositInitiated event raised by the VyraBridge contract.
+type VyraBridgeDepositInitiated struct {
+	User      common.Address
+	Amount    *big.Int
+	DepositId [32]byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositInitiated is a free log retrieval operation binding the contract event 0x94a1dfce76bbf3c35636e126d6e91811e00b7c541791ffe982d201a063acb8ed.
+//
+// Solidity: event DepositInitiated(address indexed user, uint256 amount, bytes32 indexed depositId)
+func (_VyraBridge *VyraBridgeFilterer) FilterDepositInitiated(opts *bind.FilterOpts, user []common.Address, depositId [][32]byte) (*VyraBridgeDepositInitiatedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var depositIdRule []interface{}
+	for _, depositIdItem := range depositId {
+		depositIdRule = append(depositIdRule, depositIdItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "DepositInitiated", userRule, depositIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeDepositInitiatedIterator{contract: _VyraBridge.contract, event: "DepositInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositInitiated is a free log subscription operation binding the contract event 0x94a1dfce76bbf3c35636e126d6e91811e00b7c541791ffe982d201a063acb8ed.
+//
+// Solidity: event DepositInitiated(address indexed user, uint256 amount, bytes32 indexed depositId)
+func (_VyraBridge *VyraBridgeFilterer) WatchDepositInitiated(opts *bind.WatchOpts, sink chan<- *VyraBridgeDepositInitiated, user []common.Address, depositId [][32]byte) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var depositIdRule []interface{}
+	for _, depositIdItem := range depositId {
+		depositIdRule = append(depositIdRule, depositIdItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "DepositInitiated", userRule, depositIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeDepositInitiated)
+				if err := _VyraBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositInitiated is a log parse operation binding the contract event 0x94a1dfce76bbf3c35636e126d6e91811e00b7c541791ffe982d201a063acb8ed.
+//
+// Solidity: event DepositInitiated(address indexed user, uint256 amount, bytes32 indexed depositId)
+func (_VyraBridge *VyraBridgeFilterer) ParseDepositInitiated(log types.Log) (*VyraBridgeDepositInitiated, error) {
+	event := new(VyraBridgeDepositInitiated)
+	if err := _VyraBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeDepositProcessedIterator is returned from FilterDepositProcessed and is used to iterate over the raw logs and unpacked data for DepositProcessed events raised by the VyraBridge contract.
+type VyraBridgeDepositProcessedIterator struct {
+	Event *VyraBridgeDepositProcessed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeDepositProcessedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeDepositProcessed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeDepositProcessed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeDepositProcessedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeDepositProcessedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeDepositProcessed represents a DepositProcessed event raised by the VyraBridge contract.
+type VyraBridgeDepositProcessed struct {
+	DepositId [32]byte
+	User      common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositProcessed is a free log retrieval operation binding the contract event 0x7d77e3f1866839ef93f5eda23990fa4368bf7b0af583ae0f64ecae4da14a4d61.
+//
+// Solidity: event DepositProcessed(bytes32 indexed depositId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) FilterDepositProcessed(opts *bind.FilterOpts, depositId [][32]byte, user []common.Address) (*VyraBridgeDepositProcessedIterator, error) {
+
+	var depositIdRule []interface{}
+	for _, depositIdItem := range depositId {
+		depositIdRule = append(depositIdRule, depositIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "DepositProcessed", depositIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeDepositProcessedIterator{contract: _VyraBridge.contract, event: "DepositProcessed", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositProcessed is a free log subscription operation binding the contract event 0x7d77e3f1866839ef93f5eda23990fa4368bf7b0af583ae0f64ecae4da14a4d61.
+//
+// Solidity: event DepositProcessed(bytes32 indexed depositId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) WatchDepositProcessed(opts *bind.WatchOpts, sink chan<- *VyraBridgeDepositProcessed, depositId [][32]byte, user []common.Address) (event.Subscription, error) {
+
+	var depositIdRule []interface{}
+	for _, depositIdItem := range depositId {
+		depositIdRule = append(depositIdRule, depositIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "DepositProcessed", depositIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeDepositProcessed)
+				if err := _VyraBridge.contract.UnpackLog(event, "DepositProcessed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositProcessed is a log parse operation binding the contract event 0x7d77e3f1866839ef93f5eda23990fa4368bf7b0af583ae0f64ecae4da14a4d61.
+//
+// Solidity: event DepositProcessed(bytes32 indexed depositId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) ParseDepositProcessed(log types.Log) (*VyraBridgeDepositProcessed, error) {
+	event := new(VyraBridgeDepositProcessed)
+	if err := _VyraBridge.contract.UnpackLog(event, "DepositProcessed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeFeeTreasuryUpdatedIterator is returned from FilterFeeTreasuryUpdated and is used to iterate over the raw logs and unpacked data for FeeTreasuryUpdated events raised by the VyraBridge contract.
+type VyraBridgeFeeTreasuryUpdatedIterator struct {
+	Event *VyraBridgeFeeTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeFeeTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeFeeTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeFeeTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeFeeTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeFeeTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeFeeTreasuryUpdated represents a FeeTreasuryUpdated event raised by the VyraBridge contract.
+type VyraBridgeFeeTreasuryUpdated struct {
+	OldTreasury common.Address
+	NewTreasury common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeTreasuryUpdated is a free log retrieval operation binding the contract event 0xb213ad27ce6db647182439e573968415546e6db3ebfb04b3e84d6b0412025d41.
+//
+// Solidity: event FeeTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraBridge *VyraBridgeFilterer) FilterFeeTreasuryUpdated(opts *bind.FilterOpts) (*VyraBridgeFeeTreasuryUpdatedIterator, error) {
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "FeeTreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeFeeTreasuryUpdatedIterator{contract: _VyraBridge.contract, event: "FeeTreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeTreasuryUpdated is a free log subscription operation binding the contract event 0xb213ad27ce6db647182439e573968415546e6db3ebfb04b3e84d6b0412025d41.
+//
+// Solidity: event FeeTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraBridge *VyraBridgeFilterer) WatchFeeTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *VyraBridgeFeeTreasuryUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "FeeTreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeFeeTreasuryUpdated)
+				if err := _VyraBridge.contract.UnpackLog(event, "FeeTreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeTreasuryUpdated is a log parse operation binding the contract event 0xb213ad27ce6db647182439e573968415546e6db3ebfb04b3e84d6b0412025d41.
+//
+// Solidity: event FeeTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraBridge *VyraBridgeFilterer) ParseFeeTreasuryUpdated(log types.Log) (*VyraBridgeFeeTreasuryUpdated, error) {
+	event := new(VyraBridgeFeeTreasuryUpdated)
+	if err := _VyraBridge.contract.UnpackLog(event, "FeeTreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgePausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the VyraBridge contract.
+type VyraBridgePausedIterator struct {
+	Event *VyraBridgePaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgePausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgePaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgePaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgePausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgePausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgePaused represents a Paused event raised by the VyraBridge contract.
+type VyraBridgePaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraBridge *VyraBridgeFilterer) FilterPaused(opts *bind.FilterOpts) (*VyraBridgePausedIterator, error) {
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgePausedIterator{contract: _VyraBridge.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraBridge *VyraBridgeFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *VyraBridgePaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgePaused)
+				if err := _VyraBridge.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraBridge *VyraBridgeFilterer) ParsePaused(log types.Log) (*VyraBridgePaused, error) {
+	event := new(VyraBridgePaused)
+	if err := _VyraBridge.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the VyraBridge contract.
+type VyraBridgeRoleAdminChangedIterator struct {
+	Event *VyraBridgeRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeRoleAdminChanged represents a RoleAdminChanged event raised by the VyraBridge contract.
+type VyraBridgeRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraBridge *VyraBridgeFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*VyraBridgeRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeRoleAdminChangedIterator{contract: _VyraBridge.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraBridge *VyraBridgeFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *VyraBridgeRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeRoleAdminChanged)
+				if err := _VyraBridge.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraBridge *VyraBridgeFilterer) ParseRoleAdminChanged(log types.Log) (*VyraBridgeRoleAdminChanged, error) {
+	event := new(VyraBridgeRoleAdminChanged)
+	if err := _VyraBridge.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the VyraBridge contract.
+type VyraBridgeRoleGrantedIterator struct {
+	Event *VyraBridgeRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeRoleGranted represents a RoleGranted event raised by the VyraBridge contract.
+type VyraBridgeRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraBridgeRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeRoleGrantedIterator{contract: _VyraBridge.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *VyraBridgeRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeRoleGranted)
+				if err := _VyraBridge.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) ParseRoleGranted(log types.Log) (*VyraBridgeRoleGranted, error) {
+	event := new(VyraBridgeRoleGranted)
+	if err := _VyraBridge.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the VyraBridge contract.
+type VyraBridgeRoleRevokedIterator struct {
+	Event *VyraBridgeRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeRoleRevoked represents a RoleRevoked event raised by the VyraBridge contract.
+type VyraBridgeRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraBridgeRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeRoleRevokedIterator{contract: _VyraBridge.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *VyraBridgeRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeRoleRevoked)
+				if err := _VyraBridge.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraBridge *VyraBridgeFilterer) ParseRoleRevoked(log types.Log) (*VyraBridgeRoleRevoked, error) {
+	event := new(VyraBridgeRoleRevoked)
+	if err := _VyraBridge.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the VyraBridge contract.
+type VyraBridgeUnpausedIterator struct {
+	Event *VyraBridgeUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeUnpaused represents a Unpaused event raised by the VyraBridge contract.
+type VyraBridgeUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraBridge *VyraBridgeFilterer) FilterUnpaused(opts *bind.FilterOpts) (*VyraBridgeUnpausedIterator, error) {
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeUnpausedIterator{contract: _VyraBridge.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraBridge *VyraBridgeFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *VyraBridgeUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeUnpaused)
+				if err := _VyraBridge.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraBridge *VyraBridgeFilterer) ParseUnpaused(log types.Log) (*VyraBridgeUnpaused, error) {
+	event := new(VyraBridgeUnpaused)
+	if err := _VyraBridge.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeValidatorAddedIterator is returned from FilterValidatorAdded and is used to iterate over the raw logs and unpacked data for ValidatorAdded events raised by the VyraBridge contract.
+type VyraBridgeValidatorAddedIterator struct {
+	Event *VyraBridgeValidatorAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeValidatorAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeValidatorAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeValidatorAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeValidatorAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeValidatorAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeValidatorAdded represents a ValidatorAdded event raised by the VyraBridge contract.
+type VyraBridgeValidatorAdded struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorAdded is a free log retrieval operation binding the contract event 0xe366c1c0452ed8eec96861e9e54141ebff23c9ec89fe27b996b45f5ec3884987.
+//
+// Solidity: event ValidatorAdded(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) FilterValidatorAdded(opts *bind.FilterOpts, validator []common.Address) (*VyraBridgeValidatorAddedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "ValidatorAdded", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeValidatorAddedIterator{contract: _VyraBridge.contract, event: "ValidatorAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorAdded is a free log subscription operation binding the contract event 0xe366c1c0452ed8eec96861e9e54141ebff23c9ec89fe27b996b45f5ec3884987.
+//
+// Solidity: event ValidatorAdded(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) WatchValidatorAdded(opts *bind.WatchOpts, sink chan<- *VyraBridgeValidatorAdded, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "ValidatorAdded", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeValidatorAdded)
+				if err := _VyraBridge.contract.UnpackLog(event, "ValidatorAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorAdded is a log parse operation binding the contract event 0xe366c1c0452ed8eec96861e9e54141ebff23c9ec89fe27b996b45f5ec3884987.
+//
+// Solidity: event ValidatorAdded(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) ParseValidatorAdded(log types.Log) (*VyraBridgeValidatorAdded, error) {
+	event := new(VyraBridgeValidatorAdded)
+	if err := _VyraBridge.contract.UnpackLog(event, "ValidatorAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeValidatorRemovedIterator is returned from FilterValidatorRemoved and is used to iterate over the raw logs and unpacked data for ValidatorRemoved events raised by the VyraBridge contract.
+type VyraBridgeValidatorRemovedIterator struct {
+	Event *VyraBridgeValidatorRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeValidatorRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeValidatorRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeValidatorRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeValidatorRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeValidatorRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeValidatorRemoved represents a ValidatorRemoved event raised by the VyraBridge contract.
+type VyraBridgeValidatorRemoved struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorRemoved is a free log retrieval operation binding the contract event 0xe1434e25d6611e0db941968fdc97811c982ac1602e951637d206f5fdda9dd8f1.
+//
+// Solidity: event ValidatorRemoved(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) FilterValidatorRemoved(opts *bind.FilterOpts, validator []common.Address) (*VyraBridgeValidatorRemovedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "ValidatorRemoved", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeValidatorRemovedIterator{contract: _VyraBridge.contract, event: "ValidatorRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorRemoved is a free log subscription operation binding the contract event 0xe1434e25d6611e0db941968fdc97811c982ac1602e951637d206f5fdda9dd8f1.
+//
+// Solidity: event ValidatorRemoved(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) WatchValidatorRemoved(opts *bind.WatchOpts, sink chan<- *VyraBridgeValidatorRemoved, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "ValidatorRemoved", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeValidatorRemoved)
+				if err := _VyraBridge.contract.UnpackLog(event, "ValidatorRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorRemoved is a log parse operation binding the contract event 0xe1434e25d6611e0db941968fdc97811c982ac1602e951637d206f5fdda9dd8f1.
+//
+// Solidity: event ValidatorRemoved(address indexed validator)
+func (_VyraBridge *VyraBridgeFilterer) ParseValidatorRemoved(log types.Log) (*VyraBridgeValidatorRemoved, error) {
+	event := new(VyraBridgeValidatorRemoved)
+	if err := _VyraBridge.contract.UnpackLog(event, "ValidatorRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeWithdrawalInitiatedIterator is returned from FilterWithdrawalInitiated and is used to iterate over the raw logs and unpacked data for WithdrawalInitiated events raised by the VyraBridge contract.
+type VyraBridgeWithdrawalInitiatedIterator struct {
+	Event *VyraBridgeWithdrawalInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeWithdrawalInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeWithdrawalInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeWithdrawalInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeWithdrawalInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeWithdrawalInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeWithdrawalInitiated represents a WithdrawalInitiated event raised by the VyraBridge contract.
+type VyraBridgeWithdrawalInitiated struct {
+	User         common.Address
+	Amount       *big.Int
+	WithdrawalId [32]byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalInitiated is a free log retrieval operation binding the contract event 0x6a7d57d9deb734ddc35637a94f2f0511da31a072947def8e52f9b0502fc45b78.
+//
+// Solidity: event WithdrawalInitiated(address indexed user, uint256 amount, bytes32 indexed withdrawalId)
+func (_VyraBridge *VyraBridgeFilterer) FilterWithdrawalInitiated(opts *bind.FilterOpts, user []common.Address, withdrawalId [][32]byte) (*VyraBridgeWithdrawalInitiatedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var withdrawalIdRule []interface{}
+	for _, withdrawalIdItem := range withdrawalId {
+		withdrawalIdRule = append(withdrawalIdRule, withdrawalIdItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "WithdrawalInitiated", userRule, withdrawalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeWithdrawalInitiatedIterator{contract: _VyraBridge.contract, event: "WithdrawalInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalInitiated is a free log subscription operation binding the contract event 0x6a7d57d9deb734ddc35637a94f2f0511da31a072947def8e52f9b0502fc45b78.
+//
+// Solidity: event WithdrawalInitiated(address indexed user, uint256 amount, bytes32 indexed withdrawalId)
+func (_VyraBridge *VyraBridgeFilterer) WatchWithdrawalInitiated(opts *bind.WatchOpts, sink chan<- *VyraBridgeWithdrawalInitiated, user []common.Address, withdrawalId [][32]byte) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	var withdrawalIdRule []interface{}
+	for _, withdrawalIdItem := range withdrawalId {
+		withdrawalIdRule = append(withdrawalIdRule, withdrawalIdItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "WithdrawalInitiated", userRule, withdrawalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeWithdrawalInitiated)
+				if err := _VyraBridge.contract.UnpackLog(event, "WithdrawalInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalInitiated is a log parse operation binding the contract event 0x6a7d57d9deb734ddc35637a94f2f0511da31a072947def8e52f9b0502fc45b78.
+//
+// Solidity: event WithdrawalInitiated(address indexed user, uint256 amount, bytes32 indexed withdrawalId)
+func (_VyraBridge *VyraBridgeFilterer) ParseWithdrawalInitiated(log types.Log) (*VyraBridgeWithdrawalInitiated, error) {
+	event := new(VyraBridgeWithdrawalInitiated)
+	if err := _VyraBridge.contract.UnpackLog(event, "WithdrawalInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraBridgeWithdrawalProcessedIterator is returned from FilterWithdrawalProcessed and is used to iterate over the raw logs and unpacked data for WithdrawalProcessed events raised by the VyraBridge contract.
+type VyraBridgeWithdrawalProcessedIterator struct {
+	Event *VyraBridgeWithdrawalProcessed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraBridgeWithdrawalProcessedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraBridgeWithdrawalProcessed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraBridgeWithdrawalProcessed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraBridgeWithdrawalProcessedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraBridgeWithdrawalProcessedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraBridgeWithdrawalProcessed represents a WithdrawalProcessed event raised by the VyraBridge contract.
+type VyraBridgeWithdrawalProcessed struct {
+	WithdrawalId [32]byte
+	User         common.Address
+	Amount       *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalProcessed is a free log retrieval operation binding the contract event 0x7bc99555a3551f7af5c9766d73b50823d8cc5709a24fe9a20c0d6a42483c4556.
+//
+// Solidity: event WithdrawalProcessed(bytes32 indexed withdrawalId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) FilterWithdrawalProcessed(opts *bind.FilterOpts, withdrawalId [][32]byte, user []common.Address) (*VyraBridgeWithdrawalProcessedIterator, error) {
+
+	var withdrawalIdRule []interface{}
+	for _, withdrawalIdItem := range withdrawalId {
+		withdrawalIdRule = append(withdrawalIdRule, withdrawalIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.FilterLogs(opts, "WithdrawalProcessed", withdrawalIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraBridgeWithdrawalProcessedIterator{contract: _VyraBridge.contract, event: "WithdrawalProcessed", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalProcessed is a free log subscription operation binding the contract event 0x7bc99555a3551f7af5c9766d73b50823d8cc5709a24fe9a20c0d6a42483c4556.
+//
+// Solidity: event WithdrawalProcessed(bytes32 indexed withdrawalId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) WatchWithdrawalProcessed(opts *bind.WatchOpts, sink chan<- *VyraBridgeWithdrawalProcessed, withdrawalId [][32]byte, user []common.Address) (event.Subscription, error) {
+
+	var withdrawalIdRule []interface{}
+	for _, withdrawalIdItem := range withdrawalId {
+		withdrawalIdRule = append(withdrawalIdRule, withdrawalIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraBridge.contract.WatchLogs(opts, "WithdrawalProcessed", withdrawalIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraBridgeWithdrawalProcessed)
+				if err := _VyraBridge.contract.UnpackLog(event, "WithdrawalProcessed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalProcessed is a log parse operation binding the contract event 0x7bc99555a3551f7af5c9766d73b50823d8cc5709a24fe9a20c0d6a42483c4556.
+//
+// Solidity: event WithdrawalProcessed(bytes32 indexed withdrawalId, address indexed user, uint256 amount)
+func (_VyraBridge *VyraBridgeFilterer) ParseWithdrawalProcessed(log types.Log) (*VyraBridgeWithdrawalProcessed, error) {
+	event := new(VyraBridgeWithdrawalProcessed)
+	if err := _VyraBridge.contract.UnpackLog(event, "WithdrawalProcessed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
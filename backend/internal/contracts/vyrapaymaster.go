@@ -0,0 +1,2553 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VyraPaymasterMetaData contains all meta data concerning the VyraPaymaster contract.
+var VyraPaymasterMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_vyraToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_entryPoint\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"RATE_LIMITER_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SPONSOR_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"addSponsorBalance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createSessionKey\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"sessionKey\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"dailySponsorCount\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"entryPoint\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"gasPriceBuffer\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRequiredVyrAmount\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"gasEstimate\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"vyrAmount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hasSponsorBalance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"gasEstimate\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"hasBalance\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastResetDay\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastSponsorTime\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxDailySponsors\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minSponsorBalance\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"recoverToken\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeSessionKey\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sessionKeys\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"key\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setGasPriceBuffer\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newBuffer\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMaxDailySponsors\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newLimit\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setVyrTokenPrice\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newPrice\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sponsorGas\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"gasUsed\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"totalSponsoredGas\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSponsorships\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalVyrSpent\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"updateSessionKeyNonce\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"newNonce\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"userSponsorBalance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"validateSessionKey\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"sessionKey\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vyraToken\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vyraTokenPrice\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"SessionKeyCreated\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"key\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SessionKeyRevoked\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"key\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"GasSponsored\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasUsed\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"vyrSpent\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SponsorBalanceUpdated\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newBalance\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RateLimitUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newLimit\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"GasPriceBufferUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newBuffer\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidSessionKey\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SessionKeyExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientSponsorBalance\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"RateLimitExceeded\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SessionKeyNotActive\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidExpiry\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}]}]",
+}
+
+// VyraPaymasterABI is the input ABI used to generate the binding from.
+// Deprecated: Use VyraPaymasterMetaData.ABI instead.
+var VyraPaymasterABI = VyraPaymasterMetaData.ABI
+
+// VyraPaymaster is an auto generated Go binding around an Ethereum contract.
+type VyraPaymaster struct {
+	VyraPaymasterCaller     // Read-only binding to the contract
+	VyraPaymasterTransactor // Write-only binding to the contract
+	VyraPaymasterFilterer   // Log filterer for contract events
+}
+
+// VyraPaymasterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VyraPaymasterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPaymasterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VyraPaymasterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPaymasterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VyraPaymasterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPaymasterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VyraPaymasterSession struct {
+	Contract     *VyraPaymaster    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VyraPaymasterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VyraPaymasterCallerSession struct {
+	Contract *VyraPaymasterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// VyraPaymasterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VyraPaymasterTransactorSession struct {
+	Contract     *VyraPaymasterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// VyraPaymasterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VyraPaymasterRaw struct {
+	Contract *VyraPaymaster // Generic contract binding to access the raw methods on
+}
+
+// VyraPaymasterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VyraPaymasterCallerRaw struct {
+	Contract *VyraPaymasterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VyraPaymasterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VyraPaymasterTransactorRaw struct {
+	Contract *VyraPaymasterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVyraPaymaster creates a new instance of VyraPaymaster, bound to a specific deployed contract.
+func NewVyraPaymaster(address common.Address, backend bind.ContractBackend) (*VyraPaymaster, error) {
+	contract, err := bindVyraPaymaster(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymaster{VyraPaymasterCaller: VyraPaymasterCaller{contract: contract}, VyraPaymasterTransactor: VyraPaymasterTransactor{contract: contract}, VyraPaymasterFilterer: VyraPaymasterFilterer{contract: contract}}, nil
+}
+
+// NewVyraPaymasterCaller creates a new read-only instance of VyraPaymaster, bound to a specific deployed contract.
+func NewVyraPaymasterCaller(address common.Address, caller bind.ContractCaller) (*VyraPaymasterCaller, error) {
+	contract, err := bindVyraPaymaster(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterCaller{contract: contract}, nil
+}
+
+// NewVyraPaymasterTransactor creates a new write-only instance of VyraPaymaster, bound to a specific deployed contract.
+func NewVyraPaymasterTransactor(address common.Address, transactor bind.ContractTransactor) (*VyraPaymasterTransactor, error) {
+	contract, err := bindVyraPaymaster(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterTransactor{contract: contract}, nil
+}
+
+// NewVyraPaymasterFilterer creates a new log filterer instance of VyraPaymaster, bound to a specific deployed contract.
+func NewVyraPaymasterFilterer(address common.Address, filterer bind.ContractFilterer) (*VyraPaymasterFilterer, error) {
+	contract, err := bindVyraPaymaster(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterFilterer{contract: contract}, nil
+}
+
+// bindVyraPaymaster binds a generic wrapper to an already deployed contract.
+func bindVyraPaymaster(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VyraPaymasterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraPaymaster *VyraPaymasterRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraPaymaster.Contract.VyraPaymasterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraPaymaster *VyraPaymasterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.VyraPaymasterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraPaymaster *VyraPaymasterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.VyraPaymasterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraPaymaster *VyraPaymasterCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraPaymaster.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraPaymaster *VyraPaymasterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraPaymaster *VyraPaymasterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterSession) ADMINROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.ADMINROLE(&_VyraPaymaster.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCallerSession) ADMINROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.ADMINROLE(&_VyraPaymaster.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.DEFAULTADMINROLE(&_VyraPaymaster.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.DEFAULTADMINROLE(&_VyraPaymaster.CallOpts)
+}
+
+// RATELIMITERROLE is a free data retrieval call binding the contract method 0xe60d6462.
+//
+// Solidity: function RATE_LIMITER_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCaller) RATELIMITERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "RATE_LIMITER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// RATELIMITERROLE is a free data retrieval call binding the contract method 0xe60d6462.
+//
+// Solidity: function RATE_LIMITER_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterSession) RATELIMITERROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.RATELIMITERROLE(&_VyraPaymaster.CallOpts)
+}
+
+// RATELIMITERROLE is a free data retrieval call binding the contract method 0xe60d6462.
+//
+// Solidity: function RATE_LIMITER_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCallerSession) RATELIMITERROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.RATELIMITERROLE(&_VyraPaymaster.CallOpts)
+}
+
+// SPONSORROLE is a free data retrieval call binding the contract method 0xc2d79444.
+//
+// Solidity: function SPONSOR_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCaller) SPONSORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "SPONSOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SPONSORROLE is a free data retrieval call binding the contract method 0xc2d79444.
+//
+// Solidity: function SPONSOR_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterSession) SPONSORROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.SPONSORROLE(&_VyraPaymaster.CallOpts)
+}
+
+// SPONSORROLE is a free data retrieval call binding the contract method 0xc2d79444.
+//
+// Solidity: function SPONSOR_ROLE() view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCallerSession) SPONSORROLE() ([32]byte, error) {
+	return _VyraPaymaster.Contract.SPONSORROLE(&_VyraPaymaster.CallOpts)
+}
+
+// DailySponsorCount is a free data retrieval call binding the contract method 0xace70bfe.
+//
+// Solidity: function dailySponsorCount(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) DailySponsorCount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "dailySponsorCount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DailySponsorCount is a free data retrieval call binding the contract method 0xace70bfe.
+//
+// Solidity: function dailySponsorCount(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) DailySponsorCount(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.DailySponsorCount(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// DailySponsorCount is a free data retrieval call binding the contract method 0xace70bfe.
+//
+// Solidity: function dailySponsorCount(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) DailySponsorCount(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.DailySponsorCount(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// EntryPoint is a free data retrieval call binding the contract method 0xb0d691fe.
+//
+// Solidity: function entryPoint() view returns(address)
+func (_VyraPaymaster *VyraPaymasterCaller) EntryPoint(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "entryPoint")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EntryPoint is a free data retrieval call binding the contract method 0xb0d691fe.
+//
+// Solidity: function entryPoint() view returns(address)
+func (_VyraPaymaster *VyraPaymasterSession) EntryPoint() (common.Address, error) {
+	return _VyraPaymaster.Contract.EntryPoint(&_VyraPaymaster.CallOpts)
+}
+
+// EntryPoint is a free data retrieval call binding the contract method 0xb0d691fe.
+//
+// Solidity: function entryPoint() view returns(address)
+func (_VyraPaymaster *VyraPaymasterCallerSession) EntryPoint() (common.Address, error) {
+	return _VyraPaymaster.Contract.EntryPoint(&_VyraPaymaster.CallOpts)
+}
+
+// GasPriceBuffer is a free data retrieval call binding the contract method 0x09b398b3.
+//
+// Solidity: function gasPriceBuffer() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) GasPriceBuffer(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "gasPriceBuffer")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GasPriceBuffer is a free data retrieval call binding the contract method 0x09b398b3.
+//
+// Solidity: function gasPriceBuffer() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) GasPriceBuffer() (*big.Int, error) {
+	return _VyraPaymaster.Contract.GasPriceBuffer(&_VyraPaymaster.CallOpts)
+}
+
+// GasPriceBuffer is a free data retrieval call binding the contract method 0x09b398b3.
+//
+// Solidity: function gasPriceBuffer() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) GasPriceBuffer() (*big.Int, error) {
+	return _VyraPaymaster.Contract.GasPriceBuffer(&_VyraPaymaster.CallOpts)
+}
+
+// GetRequiredVyrAmount is a free data retrieval call binding the contract method 0x6fb4a33c.
+//
+// Solidity: function getRequiredVyrAmount(uint256 gasEstimate) view returns(uint256 vyrAmount)
+func (_VyraPaymaster *VyraPaymasterCaller) GetRequiredVyrAmount(opts *bind.CallOpts, gasEstimate *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "getRequiredVyrAmount", gasEstimate)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetRequiredVyrAmount is a free data retrieval call binding the contract method 0x6fb4a33c.
+//
+// Solidity: function getRequiredVyrAmount(uint256 gasEstimate) view returns(uint256 vyrAmount)
+func (_VyraPaymaster *VyraPaymasterSession) GetRequiredVyrAmount(gasEstimate *big.Int) (*big.Int, error) {
+	return _VyraPaymaster.Contract.GetRequiredVyrAmount(&_VyraPaymaster.CallOpts, gasEstimate)
+}
+
+// GetRequiredVyrAmount is a free data retrieval call binding the contract method 0x6fb4a33c.
+//
+// Solidity: function getRequiredVyrAmount(uint256 gasEstimate) view returns(uint256 vyrAmount)
+func (_VyraPaymaster *VyraPaymasterCallerSession) GetRequiredVyrAmount(gasEstimate *big.Int) (*big.Int, error) {
+	return _VyraPaymaster.Contract.GetRequiredVyrAmount(&_VyraPaymaster.CallOpts, gasEstimate)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraPaymaster.Contract.GetRoleAdmin(&_VyraPaymaster.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPaymaster *VyraPaymasterCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraPaymaster.Contract.GetRoleAdmin(&_VyraPaymaster.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraPaymaster.Contract.HasRole(&_VyraPaymaster.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraPaymaster.Contract.HasRole(&_VyraPaymaster.CallOpts, role, account)
+}
+
+// HasSponsorBalance is a free data retrieval call binding the contract method 0x5c869262.
+//
+// Solidity: function hasSponsorBalance(address user, uint256 gasEstimate) view returns(bool hasBalance)
+func (_VyraPaymaster *VyraPaymasterCaller) HasSponsorBalance(opts *bind.CallOpts, user common.Address, gasEstimate *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "hasSponsorBalance", user, gasEstimate)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasSponsorBalance is a free data retrieval call binding the contract method 0x5c869262.
+//
+// Solidity: function hasSponsorBalance(address user, uint256 gasEstimate) view returns(bool hasBalance)
+func (_VyraPaymaster *VyraPaymasterSession) HasSponsorBalance(user common.Address, gasEstimate *big.Int) (bool, error) {
+	return _VyraPaymaster.Contract.HasSponsorBalance(&_VyraPaymaster.CallOpts, user, gasEstimate)
+}
+
+// HasSponsorBalance is a free data retrieval call binding the contract method 0x5c869262.
+//
+// Solidity: function hasSponsorBalance(address user, uint256 gasEstimate) view returns(bool hasBalance)
+func (_VyraPaymaster *VyraPaymasterCallerSession) HasSponsorBalance(user common.Address, gasEstimate *big.Int) (bool, error) {
+	return _VyraPaymaster.Contract.HasSponsorBalance(&_VyraPaymaster.CallOpts, user, gasEstimate)
+}
+
+// LastResetDay is a free data retrieval call binding the contract method 0x33b9baa5.
+//
+// Solidity: function lastResetDay(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) LastResetDay(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "lastResetDay", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastResetDay is a free data retrieval call binding the contract method 0x33b9baa5.
+//
+// Solidity: function lastResetDay(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) LastResetDay(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.LastResetDay(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// LastResetDay is a free data retrieval call binding the contract method 0x33b9baa5.
+//
+// Solidity: function lastResetDay(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) LastResetDay(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.LastResetDay(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// LastSponsorTime is a free data retrieval call binding the contract method 0xa8d5fc1b.
+//
+// Solidity: function lastSponsorTime(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) LastSponsorTime(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "lastSponsorTime", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastSponsorTime is a free data retrieval call binding the contract method 0xa8d5fc1b.
+//
+// Solidity: function lastSponsorTime(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) LastSponsorTime(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.LastSponsorTime(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// LastSponsorTime is a free data retrieval call binding the contract method 0xa8d5fc1b.
+//
+// Solidity: function lastSponsorTime(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) LastSponsorTime(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.LastSponsorTime(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// MaxDailySponsors is a free data retrieval call binding the contract method 0x72d5b55b.
+//
+// Solidity: function maxDailySponsors() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) MaxDailySponsors(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "maxDailySponsors")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDailySponsors is a free data retrieval call binding the contract method 0x72d5b55b.
+//
+// Solidity: function maxDailySponsors() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) MaxDailySponsors() (*big.Int, error) {
+	return _VyraPaymaster.Contract.MaxDailySponsors(&_VyraPaymaster.CallOpts)
+}
+
+// MaxDailySponsors is a free data retrieval call binding the contract method 0x72d5b55b.
+//
+// Solidity: function maxDailySponsors() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) MaxDailySponsors() (*big.Int, error) {
+	return _VyraPaymaster.Contract.MaxDailySponsors(&_VyraPaymaster.CallOpts)
+}
+
+// MinSponsorBalance is a free data retrieval call binding the contract method 0x66381bb0.
+//
+// Solidity: function minSponsorBalance() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) MinSponsorBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "minSponsorBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinSponsorBalance is a free data retrieval call binding the contract method 0x66381bb0.
+//
+// Solidity: function minSponsorBalance() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) MinSponsorBalance() (*big.Int, error) {
+	return _VyraPaymaster.Contract.MinSponsorBalance(&_VyraPaymaster.CallOpts)
+}
+
+// MinSponsorBalance is a free data retrieval call binding the contract method 0x66381bb0.
+//
+// Solidity: function minSponsorBalance() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) MinSponsorBalance() (*big.Int, error) {
+	return _VyraPaymaster.Contract.MinSponsorBalance(&_VyraPaymaster.CallOpts)
+}
+
+// SessionKeys is a free data retrieval call binding the contract method 0xb7b8d604.
+//
+// Solidity: function sessionKeys(address ) view returns(address key, uint256 nonce, uint256 expiry, bool active)
+func (_VyraPaymaster *VyraPaymasterCaller) SessionKeys(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Key    common.Address
+	Nonce  *big.Int
+	Expiry *big.Int
+	Active bool
+}, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "sessionKeys", arg0)
+
+	outstruct := new(struct {
+		Key    common.Address
+		Nonce  *big.Int
+		Expiry *big.Int
+		Active bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Key = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Nonce = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Expiry = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Active = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// SessionKeys is a free data retrieval call binding the contract method 0xb7b8d604.
+//
+// Solidity: function sessionKeys(address ) view returns(address key, uint256 nonce, uint256 expiry, bool active)
+func (_VyraPaymaster *VyraPaymasterSession) SessionKeys(arg0 common.Address) (struct {
+	Key    common.Address
+	Nonce  *big.Int
+	Expiry *big.Int
+	Active bool
+}, error) {
+	return _VyraPaymaster.Contract.SessionKeys(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// SessionKeys is a free data retrieval call binding the contract method 0xb7b8d604.
+//
+// Solidity: function sessionKeys(address ) view returns(address key, uint256 nonce, uint256 expiry, bool active)
+func (_VyraPaymaster *VyraPaymasterCallerSession) SessionKeys(arg0 common.Address) (struct {
+	Key    common.Address
+	Nonce  *big.Int
+	Expiry *big.Int
+	Active bool
+}, error) {
+	return _VyraPaymaster.Contract.SessionKeys(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraPaymaster.Contract.SupportsInterface(&_VyraPaymaster.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraPaymaster.Contract.SupportsInterface(&_VyraPaymaster.CallOpts, interfaceId)
+}
+
+// TotalSponsoredGas is a free data retrieval call binding the contract method 0x94bebade.
+//
+// Solidity: function totalSponsoredGas() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) TotalSponsoredGas(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "totalSponsoredGas")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSponsoredGas is a free data retrieval call binding the contract method 0x94bebade.
+//
+// Solidity: function totalSponsoredGas() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) TotalSponsoredGas() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalSponsoredGas(&_VyraPaymaster.CallOpts)
+}
+
+// TotalSponsoredGas is a free data retrieval call binding the contract method 0x94bebade.
+//
+// Solidity: function totalSponsoredGas() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) TotalSponsoredGas() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalSponsoredGas(&_VyraPaymaster.CallOpts)
+}
+
+// TotalSponsorships is a free data retrieval call binding the contract method 0x7315ab50.
+//
+// Solidity: function totalSponsorships() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) TotalSponsorships(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "totalSponsorships")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSponsorships is a free data retrieval call binding the contract method 0x7315ab50.
+//
+// Solidity: function totalSponsorships() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) TotalSponsorships() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalSponsorships(&_VyraPaymaster.CallOpts)
+}
+
+// TotalSponsorships is a free data retrieval call binding the contract method 0x7315ab50.
+//
+// Solidity: function totalSponsorships() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) TotalSponsorships() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalSponsorships(&_VyraPaymaster.CallOpts)
+}
+
+// TotalVyrSpent is a free data retrieval call binding the contract method 0x3f20b81a.
+//
+// Solidity: function totalVyrSpent() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) TotalVyrSpent(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "totalVyrSpent")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalVyrSpent is a free data retrieval call binding the contract method 0x3f20b81a.
+//
+// Solidity: function totalVyrSpent() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) TotalVyrSpent() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalVyrSpent(&_VyraPaymaster.CallOpts)
+}
+
+// TotalVyrSpent is a free data retrieval call binding the contract method 0x3f20b81a.
+//
+// Solidity: function totalVyrSpent() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) TotalVyrSpent() (*big.Int, error) {
+	return _VyraPaymaster.Contract.TotalVyrSpent(&_VyraPaymaster.CallOpts)
+}
+
+// UserSponsorBalance is a free data retrieval call binding the contract method 0xcccbadf4.
+//
+// Solidity: function userSponsorBalance(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) UserSponsorBalance(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "userSponsorBalance", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UserSponsorBalance is a free data retrieval call binding the contract method 0xcccbadf4.
+//
+// Solidity: function userSponsorBalance(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) UserSponsorBalance(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.UserSponsorBalance(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// UserSponsorBalance is a free data retrieval call binding the contract method 0xcccbadf4.
+//
+// Solidity: function userSponsorBalance(address ) view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) UserSponsorBalance(arg0 common.Address) (*big.Int, error) {
+	return _VyraPaymaster.Contract.UserSponsorBalance(&_VyraPaymaster.CallOpts, arg0)
+}
+
+// ValidateSessionKey is a free data retrieval call binding the contract method 0x58f11fee.
+//
+// Solidity: function validateSessionKey(address user, address sessionKey, uint256 nonce, bytes signature) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCaller) ValidateSessionKey(opts *bind.CallOpts, user common.Address, sessionKey common.Address, nonce *big.Int, signature []byte) (bool, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "validateSessionKey", user, sessionKey, nonce, signature)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ValidateSessionKey is a free data retrieval call binding the contract method 0x58f11fee.
+//
+// Solidity: function validateSessionKey(address user, address sessionKey, uint256 nonce, bytes signature) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterSession) ValidateSessionKey(user common.Address, sessionKey common.Address, nonce *big.Int, signature []byte) (bool, error) {
+	return _VyraPaymaster.Contract.ValidateSessionKey(&_VyraPaymaster.CallOpts, user, sessionKey, nonce, signature)
+}
+
+// ValidateSessionKey is a free data retrieval call binding the contract method 0x58f11fee.
+//
+// Solidity: function validateSessionKey(address user, address sessionKey, uint256 nonce, bytes signature) view returns(bool)
+func (_VyraPaymaster *VyraPaymasterCallerSession) ValidateSessionKey(user common.Address, sessionKey common.Address, nonce *big.Int, signature []byte) (bool, error) {
+	return _VyraPaymaster.Contract.ValidateSessionKey(&_VyraPaymaster.CallOpts, user, sessionKey, nonce, signature)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPaymaster *VyraPaymasterCaller) VyraToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "vyraToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPaymaster *VyraPaymasterSession) VyraToken() (common.Address, error) {
+	return _VyraPaymaster.Contract.VyraToken(&_VyraPaymaster.CallOpts)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPaymaster *VyraPaymasterCallerSession) VyraToken() (common.Address, error) {
+	return _VyraPaymaster.Contract.VyraToken(&_VyraPaymaster.CallOpts)
+}
+
+// VyraTokenPrice is a free data retrieval call binding the contract method 0x01e17b13.
+//
+// Solidity: function vyraTokenPrice() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCaller) VyraTokenPrice(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPaymaster.contract.Call(opts, &out, "vyraTokenPrice")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VyraTokenPrice is a free data retrieval call binding the contract method 0x01e17b13.
+//
+// Solidity: function vyraTokenPrice() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterSession) VyraTokenPrice() (*big.Int, error) {
+	return _VyraPaymaster.Contract.VyraTokenPrice(&_VyraPaymaster.CallOpts)
+}
+
+// VyraTokenPrice is a free data retrieval call binding the contract method 0x01e17b13.
+//
+// Solidity: function vyraTokenPrice() view returns(uint256)
+func (_VyraPaymaster *VyraPaymasterCallerSession) VyraTokenPrice() (*big.Int, error) {
+	return _VyraPaymaster.Contract.VyraTokenPrice(&_VyraPaymaster.CallOpts)
+}
+
+// AddSponsorBalance is a paid mutator transaction binding the contract method 0xadaae593.
+//
+// Solidity: function addSponsorBalance(address user, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) AddSponsorBalance(opts *bind.TransactOpts, user common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "addSponsorBalance", user, amount)
+}
+
+// AddSponsorBalance is a paid mutator transaction binding the contract method 0xadaae593.
+//
+// Solidity: function addSponsorBalance(address user, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterSession) AddSponsorBalance(user common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.AddSponsorBalance(&_VyraPaymaster.TransactOpts, user, amount)
+}
+
+// AddSponsorBalance is a paid mutator transaction binding the contract method 0xadaae593.
+//
+// Solidity: function addSponsorBalance(address user, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) AddSponsorBalance(user common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.AddSponsorBalance(&_VyraPaymaster.TransactOpts, user, amount)
+}
+
+// CreateSessionKey is a paid mutator transaction binding the contract method 0xfc0cc883.
+//
+// Solidity: function createSessionKey(address sessionKey, uint256 expiry) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) CreateSessionKey(opts *bind.TransactOpts, sessionKey common.Address, expiry *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "createSessionKey", sessionKey, expiry)
+}
+
+// CreateSessionKey is a paid mutator transaction binding the contract method 0xfc0cc883.
+//
+// Solidity: function createSessionKey(address sessionKey, uint256 expiry) returns()
+func (_VyraPaymaster *VyraPaymasterSession) CreateSessionKey(sessionKey common.Address, expiry *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.CreateSessionKey(&_VyraPaymaster.TransactOpts, sessionKey, expiry)
+}
+
+// CreateSessionKey is a paid mutator transaction binding the contract method 0xfc0cc883.
+//
+// Solidity: function createSessionKey(address sessionKey, uint256 expiry) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) CreateSessionKey(sessionKey common.Address, expiry *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.CreateSessionKey(&_VyraPaymaster.TransactOpts, sessionKey, expiry)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.GrantRole(&_VyraPaymaster.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.GrantRole(&_VyraPaymaster.TransactOpts, role, account)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) RecoverToken(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "recoverToken", token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RecoverToken(&_VyraPaymaster.TransactOpts, token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RecoverToken(&_VyraPaymaster.TransactOpts, token, amount)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPaymaster *VyraPaymasterSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RenounceRole(&_VyraPaymaster.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RenounceRole(&_VyraPaymaster.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RevokeRole(&_VyraPaymaster.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RevokeRole(&_VyraPaymaster.TransactOpts, role, account)
+}
+
+// RevokeSessionKey is a paid mutator transaction binding the contract method 0x48e69d1f.
+//
+// Solidity: function revokeSessionKey() returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) RevokeSessionKey(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "revokeSessionKey")
+}
+
+// RevokeSessionKey is a paid mutator transaction binding the contract method 0x48e69d1f.
+//
+// Solidity: function revokeSessionKey() returns()
+func (_VyraPaymaster *VyraPaymasterSession) RevokeSessionKey() (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RevokeSessionKey(&_VyraPaymaster.TransactOpts)
+}
+
+// RevokeSessionKey is a paid mutator transaction binding the contract method 0x48e69d1f.
+//
+// Solidity: function revokeSessionKey() returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) RevokeSessionKey() (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.RevokeSessionKey(&_VyraPaymaster.TransactOpts)
+}
+
+// SetGasPriceBuffer is a paid mutator transaction binding the contract method 0x99cd8bf6.
+//
+// Solidity: function setGasPriceBuffer(uint256 newBuffer) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) SetGasPriceBuffer(opts *bind.TransactOpts, newBuffer *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "setGasPriceBuffer", newBuffer)
+}
+
+// SetGasPriceBuffer is a paid mutator transaction binding the contract method 0x99cd8bf6.
+//
+// Solidity: function setGasPriceBuffer(uint256 newBuffer) returns()
+func (_VyraPaymaster *VyraPaymasterSession) SetGasPriceBuffer(newBuffer *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetGasPriceBuffer(&_VyraPaymaster.TransactOpts, newBuffer)
+}
+
+// SetGasPriceBuffer is a paid mutator transaction binding the contract method 0x99cd8bf6.
+//
+// Solidity: function setGasPriceBuffer(uint256 newBuffer) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) SetGasPriceBuffer(newBuffer *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetGasPriceBuffer(&_VyraPaymaster.TransactOpts, newBuffer)
+}
+
+// SetMaxDailySponsors is a paid mutator transaction binding the contract method 0x3eceb93c.
+//
+// Solidity: function setMaxDailySponsors(uint256 newLimit) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) SetMaxDailySponsors(opts *bind.TransactOpts, newLimit *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "setMaxDailySponsors", newLimit)
+}
+
+// SetMaxDailySponsors is a paid mutator transaction binding the contract method 0x3eceb93c.
+//
+// Solidity: function setMaxDailySponsors(uint256 newLimit) returns()
+func (_VyraPaymaster *VyraPaymasterSession) SetMaxDailySponsors(newLimit *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetMaxDailySponsors(&_VyraPaymaster.TransactOpts, newLimit)
+}
+
+// SetMaxDailySponsors is a paid mutator transaction binding the contract method 0x3eceb93c.
+//
+// Solidity: function setMaxDailySponsors(uint256 newLimit) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) SetMaxDailySponsors(newLimit *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetMaxDailySponsors(&_VyraPaymaster.TransactOpts, newLimit)
+}
+
+// SetVyrTokenPrice is a paid mutator transaction binding the contract method 0xedfecbff.
+//
+// Solidity: function setVyrTokenPrice(uint256 newPrice) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) SetVyrTokenPrice(opts *bind.TransactOpts, newPrice *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "setVyrTokenPrice", newPrice)
+}
+
+// SetVyrTokenPrice is a paid mutator transaction binding the contract method 0xedfecbff.
+//
+// Solidity: function setVyrTokenPrice(uint256 newPrice) returns()
+func (_VyraPaymaster *VyraPaymasterSession) SetVyrTokenPrice(newPrice *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetVyrTokenPrice(&_VyraPaymaster.TransactOpts, newPrice)
+}
+
+// SetVyrTokenPrice is a paid mutator transaction binding the contract method 0xedfecbff.
+//
+// Solidity: function setVyrTokenPrice(uint256 newPrice) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) SetVyrTokenPrice(newPrice *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SetVyrTokenPrice(&_VyraPaymaster.TransactOpts, newPrice)
+}
+
+// SponsorGas is a paid mutator transaction binding the contract method 0xccea2733.
+//
+// Solidity: function sponsorGas(address user, uint256 gasUsed, bytes signature) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) SponsorGas(opts *bind.TransactOpts, user common.Address, gasUsed *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "sponsorGas", user, gasUsed, signature)
+}
+
+// SponsorGas is a paid mutator transaction binding the contract method 0xccea2733.
+//
+// Solidity: function sponsorGas(address user, uint256 gasUsed, bytes signature) returns()
+func (_VyraPaymaster *VyraPaymasterSession) SponsorGas(user common.Address, gasUsed *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SponsorGas(&_VyraPaymaster.TransactOpts, user, gasUsed, signature)
+}
+
+// SponsorGas is a paid mutator transaction binding the contract method 0xccea2733.
+//
+// Solidity: function sponsorGas(address user, uint256 gasUsed, bytes signature) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) SponsorGas(user common.Address, gasUsed *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.SponsorGas(&_VyraPaymaster.TransactOpts, user, gasUsed, signature)
+}
+
+// UpdateSessionKeyNonce is a paid mutator transaction binding the contract method 0x2266d2cf.
+//
+// Solidity: function updateSessionKeyNonce(address user, uint256 newNonce) returns()
+func (_VyraPaymaster *VyraPaymasterTransactor) UpdateSessionKeyNonce(opts *bind.TransactOpts, user common.Address, newNonce *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.contract.Transact(opts, "updateSessionKeyNonce", user, newNonce)
+}
+
+// UpdateSessionKeyNonce is a paid mutator transaction binding the contract method 0x2266d2cf.
+//
+// Solidity: function updateSessionKeyNonce(address user, uint256 newNonce) returns()
+func (_VyraPaymaster *VyraPaymasterSession) UpdateSessionKeyNonce(user common.Address, newNonce *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.UpdateSessionKeyNonce(&_VyraPaymaster.TransactOpts, user, newNonce)
+}
+
+// UpdateSessionKeyNonce is a paid mutator transaction binding the contract method 0x2266d2cf.
+//
+// Solidity: function updateSessionKeyNonce(address user, uint256 newNonce) returns()
+func (_VyraPaymaster *VyraPaymasterTransactorSession) UpdateSessionKeyNonce(user common.Address, newNonce *big.Int) (*types.Transaction, error) {
+	return _VyraPaymaster.Contract.UpdateSessionKeyNonce(&_VyraPaymaster.TransactOpts, user, newNonce)
+}
+
+// VyraPaymasterGasPriceBufferUpdatedIterator is returned from FilterGasPriceBufferUpdated and is used to iterate over the raw logs and unpacked data for GasPriceBufferUpdated events raised by the VyraPaymaster contract.
+type VyraPaymasterGasPriceBufferUpdatedIterator struct {
+	Event *VyraPaymasterGasPriceBufferUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterGasPriceBufferUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterGasPriceBufferUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterGasPriceBufferUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterGasPriceBufferUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterGasPriceBufferUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterGasPriceBufferUpdated represents a GasPriceBufferUpdated event raised by the VyraPaymaster contract.
+type VyraPaymasterGasPriceBufferUpdated struct {
+	NewBuffer *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterGasPriceBufferUpdated is a free log retrieval operation binding the contract event 0x3cc1528ee6d3ffbb5462fb093b47425909cc71dd1dd7592c962a0609d5dc057c.
+//
+// Solidity: event GasPriceBufferUpdated(uint256 newBuffer)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterGasPriceBufferUpdated(opts *bind.FilterOpts) (*VyraPaymasterGasPriceBufferUpdatedIterator, error) {
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "GasPriceBufferUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterGasPriceBufferUpdatedIterator{contract: _VyraPaymaster.contract, event: "GasPriceBufferUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchGasPriceBufferUpdated is a free log subscription operation binding the contract event 0x3cc1528ee6d3ffbb5462fb093b47425909cc71dd1dd7592c962a0609d5dc057c.
+//
+// Solidity: event GasPriceBufferUpdated(uint256 newBuffer)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchGasPriceBufferUpdated(opts *bind.WatchOpts, sink chan<- *VyraPaymasterGasPriceBufferUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "GasPriceBufferUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterGasPriceBufferUpdated)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "GasPriceBufferUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGasPriceBufferUpdated is a log parse operation binding the contract event 0x3cc1528ee6d3ffbb5462fb093b47425909cc71dd1dd7592c962a0609d5dc057c.
+//
+// Solidity: event GasPriceBufferUpdated(uint256 newBuffer)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseGasPriceBufferUpdated(log types.Log) (*VyraPaymasterGasPriceBufferUpdated, error) {
+	event := new(VyraPaymasterGasPriceBufferUpdated)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "GasPriceBufferUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterGasSponsoredIterator is returned from FilterGasSponsored and is used to iterate over the raw logs and unpacked data for GasSponsored events raised by the VyraPaymaster contract.
+type VyraPaymasterGasSponsoredIterator struct {
+	Event *VyraPaymasterGasSponsored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterGasSponsoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterGasSponsored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterGasSponsored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterGasSponsoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterGasSponsoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterGasSponsored represents a GasSponsored event raised by the VyraPaymaster contract.
+type VyraPaymasterGasSponsored struct {
+	User     common.Address
+	GasUsed  *big.Int
+	VyrSpent *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterGasSponsored is a free log retrieval operation binding the contract event 0xb3d8da13e2eec10930982ae94cc39cd56a1e686b864a50f632765647bdfe622b.
+//
+// Solidity: event GasSponsored(address indexed user, uint256 gasUsed, uint256 vyrSpent)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterGasSponsored(opts *bind.FilterOpts, user []common.Address) (*VyraPaymasterGasSponsoredIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "GasSponsored", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterGasSponsoredIterator{contract: _VyraPaymaster.contract, event: "GasSponsored", logs: logs, sub: sub}, nil
+}
+
+// WatchGasSponsored is a free log subscription operation binding the contract event 0xb3d8da13e2eec10930982ae94cc39cd56a1e686b864a50f632765647bdfe622b.
+//
+// Solidity: event GasSponsored(address indexed user, uint256 gasUsed, uint256 vyrSpent)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchGasSponsored(opts *bind.WatchOpts, sink chan<- *VyraPaymasterGasSponsored, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "GasSponsored", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterGasSponsored)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "GasSponsored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseGasSponsored is a log parse operation binding the contract event 0xb3d8da13e2eec10930982ae94cc39cd56a1e686b864a50f632765647bdfe622b.
+//
+// Solidity: event GasSponsored(address indexed user, uint256 gasUsed, uint256 vyrSpent)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseGasSponsored(log types.Log) (*VyraPaymasterGasSponsored, error) {
+	event := new(VyraPaymasterGasSponsored)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "GasSponsored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterRateLimitUpdatedIterator is returned from FilterRateLimitUpdated and is used to iterate over the raw logs and unpacked data for RateLimitUpdated events raised by the VyraPaymaster contract.
+type VyraPaymasterRateLimitUpdatedIterator struct {
+	Event *VyraPaymasterRateLimitUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterRateLimitUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterRateLimitUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterRateLimitUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterRateLimitUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterRateLimitUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterRateLimitUpdated represents a RateLimitUpdated event raised by the VyraPaymaster contract.
+type VyraPaymasterRateLimitUpdated struct {
+	NewLimit *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRateLimitUpdated is a free log retrieval operation binding the contract event 0x1939de75d13c836ba62103f23c7a2622e9cbc2113aa33a8c74eb28e409313db2.
+//
+// Solidity: event RateLimitUpdated(uint256 newLimit)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterRateLimitUpdated(opts *bind.FilterOpts) (*VyraPaymasterRateLimitUpdatedIterator, error) {
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "RateLimitUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterRateLimitUpdatedIterator{contract: _VyraPaymaster.contract, event: "RateLimitUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRateLimitUpdated is a free log subscription operation binding the contract event 0x1939de75d13c836ba62103f23c7a2622e9cbc2113aa33a8c74eb28e409313db2.
+//
+// Solidity: event RateLimitUpdated(uint256 newLimit)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchRateLimitUpdated(opts *bind.WatchOpts, sink chan<- *VyraPaymasterRateLimitUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "RateLimitUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterRateLimitUpdated)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRateLimitUpdated is a log parse operation binding the contract event 0x1939de75d13c836ba62103f23c7a2622e9cbc2113aa33a8c74eb28e409313db2.
+//
+// Solidity: event RateLimitUpdated(uint256 newLimit)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseRateLimitUpdated(log types.Log) (*VyraPaymasterRateLimitUpdated, error) {
+	event := new(VyraPaymasterRateLimitUpdated)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the VyraPaymaster contract.
+type VyraPaymasterRoleAdminChangedIterator struct {
+	Event *VyraPaymasterRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterRoleAdminChanged represents a RoleAdminChanged event raised by the VyraPaymaster contract.
+type VyraPaymasterRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*VyraPaymasterRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterRoleAdminChangedIterator{contract: _VyraPaymaster.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *VyraPaymasterRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterRoleAdminChanged)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseRoleAdminChanged(log types.Log) (*VyraPaymasterRoleAdminChanged, error) {
+	event := new(VyraPaymasterRoleAdminChanged)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the VyraPaymaster contract.
+type VyraPaymasterRoleGrantedIterator struct {
+	Event *VyraPaymasterRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterRoleGranted represents a RoleGranted event raised by the VyraPaymaster contract.
+type VyraPaymasterRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraPaymasterRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterRoleGrantedIterator{contract: _VyraPaymaster.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *VyraPaymasterRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterRoleGranted)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseRoleGranted(log types.Log) (*VyraPaymasterRoleGranted, error) {
+	event := new(VyraPaymasterRoleGranted)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the VyraPaymaster contract.
+type VyraPaymasterRoleRevokedIterator struct {
+	Event *VyraPaymasterRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterRoleRevoked represents a RoleRevoked event raised by the VyraPaymaster contract.
+type VyraPaymasterRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraPaymasterRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterRoleRevokedIterator{contract: _VyraPaymaster.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *VyraPaymasterRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterRoleRevoked)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseRoleRevoked(log types.Log) (*VyraPaymasterRoleRevoked, error) {
+	event := new(VyraPaymasterRoleRevoked)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterSessionKeyCreatedIterator is returned from FilterSessionKeyCreated and is used to iterate over the raw logs and unpacked data for SessionKeyCreated events raised by the VyraPaymaster contract.
+type VyraPaymasterSessionKeyCreatedIterator struct {
+	Event *VyraPaymasterSessionKeyCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterSessionKeyCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterSessionKeyCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterSessionKeyCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterSessionKeyCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterSessionKeyCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterSessionKeyCreated represents a SessionKeyCreated event raised by the VyraPaymaster contract.
+type VyraPaymasterSessionKeyCreated struct {
+	User   common.Address
+	Key    common.Address
+	Expiry *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSessionKeyCreated is a free log retrieval operation binding the contract event 0x2818d72211406e83a2aafbed4d2d6650d64f65aeaac80a4f1ef6d06c5fb58a5e.
+//
+// Solidity: event SessionKeyCreated(address indexed user, address indexed key, uint256 expiry)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterSessionKeyCreated(opts *bind.FilterOpts, user []common.Address, key []common.Address) (*VyraPaymasterSessionKeyCreatedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var keyRule []interface{}
+	for _, keyItem := range key {
+		keyRule = append(keyRule, keyItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "SessionKeyCreated", userRule, keyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterSessionKeyCreatedIterator{contract: _VyraPaymaster.contract, event: "SessionKeyCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchSessionKeyCreated is a free log subscription operation binding the contract event 0x2818d72211406e83a2aafbed4d2d6650d64f65aeaac80a4f1ef6d06c5fb58a5e.
+//
+// Solidity: event SessionKeyCreated(address indexed user, address indexed key, uint256 expiry)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchSessionKeyCreated(opts *bind.WatchOpts, sink chan<- *VyraPaymasterSessionKeyCreated, user []common.Address, key []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var keyRule []interface{}
+	for _, keyItem := range key {
+		keyRule = append(keyRule, keyItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "SessionKeyCreated", userRule, keyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterSessionKeyCreated)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "SessionKeyCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSessionKeyCreated is a log parse operation binding the contract event 0x2818d72211406e83a2aafbed4d2d6650d64f65aeaac80a4f1ef6d06c5fb58a5e.
+//
+// Solidity: event SessionKeyCreated(address indexed user, address indexed key, uint256 expiry)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseSessionKeyCreated(log types.Log) (*VyraPaymasterSessionKeyCreated, error) {
+	event := new(VyraPaymasterSessionKeyCreated)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "SessionKeyCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterSessionKeyRevokedIterator is returned from FilterSessionKeyRevoked and is used to iterate over the raw logs and unpacked data for SessionKeyRevoked events raised by the VyraPaymaster contract.
+type VyraPaymasterSessionKeyRevokedIterator struct {
+	Event *VyraPaymasterSessionKeyRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterSessionKeyRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterSessionKeyRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterSessionKeyRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterSessionKeyRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterSessionKeyRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterSessionKeyRevoked represents a SessionKeyRevoked event raised by the VyraPaymaster contract.
+type VyraPaymasterSessionKeyRevoked struct {
+	User common.Address
+	Key  common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSessionKeyRevoked is a free log retrieval operation binding the contract event 0x744157ccffbd293a2e8644928cd7d23d650f869b88f72d7bfea8041b76ca6bec.
+//
+// Solidity: event SessionKeyRevoked(address indexed user, address indexed key)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterSessionKeyRevoked(opts *bind.FilterOpts, user []common.Address, key []common.Address) (*VyraPaymasterSessionKeyRevokedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var keyRule []interface{}
+	for _, keyItem := range key {
+		keyRule = append(keyRule, keyItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "SessionKeyRevoked", userRule, keyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterSessionKeyRevokedIterator{contract: _VyraPaymaster.contract, event: "SessionKeyRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchSessionKeyRevoked is a free log subscription operation binding the contract event 0x744157ccffbd293a2e8644928cd7d23d650f869b88f72d7bfea8041b76ca6bec.
+//
+// Solidity: event SessionKeyRevoked(address indexed user, address indexed key)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchSessionKeyRevoked(opts *bind.WatchOpts, sink chan<- *VyraPaymasterSessionKeyRevoked, user []common.Address, key []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var keyRule []interface{}
+	for _, keyItem := range key {
+		keyRule = append(keyRule, keyItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "SessionKeyRevoked", userRule, keyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterSessionKeyRevoked)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "SessionKeyRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSessionKeyRevoked is a log parse operation binding the contract event 0x744157ccffbd293a2e8644928cd7d23d650f869b88f72d7bfea8041b76ca6bec.
+//
+// Solidity: event SessionKeyRevoked(address indexed user, address indexed key)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseSessionKeyRevoked(log types.Log) (*VyraPaymasterSessionKeyRevoked, error) {
+	event := new(VyraPaymasterSessionKeyRevoked)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "SessionKeyRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPaymasterSponsorBalanceUpdatedIterator is returned from FilterSponsorBalanceUpdated and is used to iterate over the raw logs and unpacked data for SponsorBalanceUpdated events raised by the VyraPaymaster contract.
+type VyraPaymasterSponsorBalanceUpdatedIterator struct {
+	Event *VyraPaymasterSponsorBalanceUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPaymasterSponsorBalanceUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPaymasterSponsorBalanceUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPaymasterSponsorBalanceUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPaymasterSponsorBalanceUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPaymasterSponsorBalanceUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPaymasterSponsorBalanceUpdated represents a SponsorBalanceUpdated event raised by the VyraPaymaster contract.
+type VyraPaymasterSponsorBalanceUpdated struct {
+	User       common.Address
+	NewBalance *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSponsorBalanceUpdated is a free log retrieval operation binding the contract event 0xb694407d2b82f36db25e10f6129b17752f3ee33ef92f7a96b063b4f3a71e7653.
+//
+// Solidity: event SponsorBalanceUpdated(address indexed user, uint256 newBalance)
+func (_VyraPaymaster *VyraPaymasterFilterer) FilterSponsorBalanceUpdated(opts *bind.FilterOpts, user []common.Address) (*VyraPaymasterSponsorBalanceUpdatedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.FilterLogs(opts, "SponsorBalanceUpdated", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPaymasterSponsorBalanceUpdatedIterator{contract: _VyraPaymaster.contract, event: "SponsorBalanceUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchSponsorBalanceUpdated is a free log subscription operation binding the contract event 0xb694407d2b82f36db25e10f6129b17752f3ee33ef92f7a96b063b4f3a71e7653.
+//
+// Solidity: event SponsorBalanceUpdated(address indexed user, uint256 newBalance)
+func (_VyraPaymaster *VyraPaymasterFilterer) WatchSponsorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *VyraPaymasterSponsorBalanceUpdated, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VyraPaymaster.contract.WatchLogs(opts, "SponsorBalanceUpdated", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPaymasterSponsorBalanceUpdated)
+				if err := _VyraPaymaster.contract.UnpackLog(event, "SponsorBalanceUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSponsorBalanceUpdated is a log parse operation binding the contract event 0xb694407d2b82f36db25e10f6129b17752f3ee33ef92f7a96b063b4f3a71e7653.
+//
+// Solidity: event SponsorBalanceUpdated(address indexed user, uint256 newBalance)
+func (_VyraPaymaster *VyraPaymasterFilterer) ParseSponsorBalanceUpdated(log types.Log) (*VyraPaymasterSponsorBalanceUpdated, error) {
+	event := new(VyraPaymasterSponsorBalanceUpdated)
+	if err := _VyraPaymaster.contract.UnpackLog(event, "SponsorBalanceUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
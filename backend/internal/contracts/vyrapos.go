@@ -0,0 +1,2995 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VyraPOSMetaData contains all meta data concerning the VyraPOS contract.
+var VyraPOSMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_vyraToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_platformTreasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FEE_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MERCHANT_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"REFUND_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"createInvoice\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"invoiceId\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getMerchantStats\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"merchant\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"earnings\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"transactionCount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"invoices\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"merchant\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"paid\",\"type\":\"bool\"},{\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"merchantEarnings\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"merchantFeeRate\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"merchantNonces\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"payments\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"customer\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"merchant\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"merchantFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"platformFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"refunded\",\"type\":\"bool\"},{\"internalType\":\"bytes32\",\"name\":\"invoiceId\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"platformEarnings\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"platformFeeRate\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"platformTreasury\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"processPayment\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"invoiceId\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"customer\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"processSplitPayment\",\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"recipients\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"percentages\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"totalAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"customer\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"recoverToken\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"refundPayment\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"refundAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMerchantFeeRate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPlatformFeeRate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPlatformTreasury\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"totalFees\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalTransactions\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalVolume\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vyraToken\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"InvoiceCreated\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"invoiceId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"merchant\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PaymentProcessed\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"invoiceId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"customer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PaymentRefunded\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"refundAmount\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SplitPaymentProcessed\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"paymentId\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"recipients\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MerchantFeeUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PlatformFeeUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PlatformTreasuryUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oldTreasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvoiceNotFound\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvoiceExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvoiceAlreadyPaid\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PaymentNotFound\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PaymentAlreadyRefunded\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidAmount\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidRecipients\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidPercentage\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UnauthorizedMerchant\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}]}]",
+}
+
+// VyraPOSABI is the input ABI used to generate the binding from.
+// Deprecated: Use VyraPOSMetaData.ABI instead.
+var VyraPOSABI = VyraPOSMetaData.ABI
+
+// VyraPOS is an auto generated Go binding around an Ethereum contract.
+type VyraPOS struct {
+	VyraPOSCaller     // Read-only binding to the contract
+	VyraPOSTransactor // Write-only binding to the contract
+	VyraPOSFilterer   // Log filterer for contract events
+}
+
+// VyraPOSCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VyraPOSCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPOSTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VyraPOSTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPOSFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VyraPOSFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraPOSSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VyraPOSSession struct {
+	Contract     *VyraPOS          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VyraPOSCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VyraPOSCallerSession struct {
+	Contract *VyraPOSCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// VyraPOSTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VyraPOSTransactorSession struct {
+	Contract     *VyraPOSTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// VyraPOSRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VyraPOSRaw struct {
+	Contract *VyraPOS // Generic contract binding to access the raw methods on
+}
+
+// VyraPOSCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VyraPOSCallerRaw struct {
+	Contract *VyraPOSCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VyraPOSTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VyraPOSTransactorRaw struct {
+	Contract *VyraPOSTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVyraPOS creates a new instance of VyraPOS, bound to a specific deployed contract.
+func NewVyraPOS(address common.Address, backend bind.ContractBackend) (*VyraPOS, error) {
+	contract, err := bindVyraPOS(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOS{VyraPOSCaller: VyraPOSCaller{contract: contract}, VyraPOSTransactor: VyraPOSTransactor{contract: contract}, VyraPOSFilterer: VyraPOSFilterer{contract: contract}}, nil
+}
+
+// NewVyraPOSCaller creates a new read-only instance of VyraPOS, bound to a specific deployed contract.
+func NewVyraPOSCaller(address common.Address, caller bind.ContractCaller) (*VyraPOSCaller, error) {
+	contract, err := bindVyraPOS(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSCaller{contract: contract}, nil
+}
+
+// NewVyraPOSTransactor creates a new write-only instance of VyraPOS, bound to a specific deployed contract.
+func NewVyraPOSTransactor(address common.Address, transactor bind.ContractTransactor) (*VyraPOSTransactor, error) {
+	contract, err := bindVyraPOS(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSTransactor{contract: contract}, nil
+}
+
+// NewVyraPOSFilterer creates a new log filterer instance of VyraPOS, bound to a specific deployed contract.
+func NewVyraPOSFilterer(address common.Address, filterer bind.ContractFilterer) (*VyraPOSFilterer, error) {
+	contract, err := bindVyraPOS(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSFilterer{contract: contract}, nil
+}
+
+// bindVyraPOS binds a generic wrapper to an already deployed contract.
+func bindVyraPOS(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VyraPOSMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraPOS *VyraPOSRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraPOS.Contract.VyraPOSCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraPOS *VyraPOSRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPOS.Contract.VyraPOSTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraPOS *VyraPOSRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraPOS.Contract.VyraPOSTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraPOS *VyraPOSCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraPOS.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraPOS *VyraPOSTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPOS.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraPOS *VyraPOSTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraPOS.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSSession) ADMINROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.ADMINROLE(&_VyraPOS.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCallerSession) ADMINROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.ADMINROLE(&_VyraPOS.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.DEFAULTADMINROLE(&_VyraPOS.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.DEFAULTADMINROLE(&_VyraPOS.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) FEEDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "FEE_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraPOS.Contract.FEEDENOMINATOR(&_VyraPOS.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraPOS.Contract.FEEDENOMINATOR(&_VyraPOS.CallOpts)
+}
+
+// MERCHANTROLE is a free data retrieval call binding the contract method 0xb3d35839.
+//
+// Solidity: function MERCHANT_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCaller) MERCHANTROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "MERCHANT_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MERCHANTROLE is a free data retrieval call binding the contract method 0xb3d35839.
+//
+// Solidity: function MERCHANT_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSSession) MERCHANTROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.MERCHANTROLE(&_VyraPOS.CallOpts)
+}
+
+// MERCHANTROLE is a free data retrieval call binding the contract method 0xb3d35839.
+//
+// Solidity: function MERCHANT_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCallerSession) MERCHANTROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.MERCHANTROLE(&_VyraPOS.CallOpts)
+}
+
+// REFUNDROLE is a free data retrieval call binding the contract method 0x627c0906.
+//
+// Solidity: function REFUND_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCaller) REFUNDROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "REFUND_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REFUNDROLE is a free data retrieval call binding the contract method 0x627c0906.
+//
+// Solidity: function REFUND_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSSession) REFUNDROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.REFUNDROLE(&_VyraPOS.CallOpts)
+}
+
+// REFUNDROLE is a free data retrieval call binding the contract method 0x627c0906.
+//
+// Solidity: function REFUND_ROLE() view returns(bytes32)
+func (_VyraPOS *VyraPOSCallerSession) REFUNDROLE() ([32]byte, error) {
+	return _VyraPOS.Contract.REFUNDROLE(&_VyraPOS.CallOpts)
+}
+
+// GetMerchantStats is a free data retrieval call binding the contract method 0xb435dee1.
+//
+// Solidity: function getMerchantStats(address merchant) view returns(uint256 earnings, uint256 transactionCount)
+func (_VyraPOS *VyraPOSCaller) GetMerchantStats(opts *bind.CallOpts, merchant common.Address) (struct {
+	Earnings         *big.Int
+	TransactionCount *big.Int
+}, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "getMerchantStats", merchant)
+
+	outstruct := new(struct {
+		Earnings         *big.Int
+		TransactionCount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Earnings = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.TransactionCount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetMerchantStats is a free data retrieval call binding the contract method 0xb435dee1.
+//
+// Solidity: function getMerchantStats(address merchant) view returns(uint256 earnings, uint256 transactionCount)
+func (_VyraPOS *VyraPOSSession) GetMerchantStats(merchant common.Address) (struct {
+	Earnings         *big.Int
+	TransactionCount *big.Int
+}, error) {
+	return _VyraPOS.Contract.GetMerchantStats(&_VyraPOS.CallOpts, merchant)
+}
+
+// GetMerchantStats is a free data retrieval call binding the contract method 0xb435dee1.
+//
+// Solidity: function getMerchantStats(address merchant) view returns(uint256 earnings, uint256 transactionCount)
+func (_VyraPOS *VyraPOSCallerSession) GetMerchantStats(merchant common.Address) (struct {
+	Earnings         *big.Int
+	TransactionCount *big.Int
+}, error) {
+	return _VyraPOS.Contract.GetMerchantStats(&_VyraPOS.CallOpts, merchant)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPOS *VyraPOSCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPOS *VyraPOSSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraPOS.Contract.GetRoleAdmin(&_VyraPOS.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraPOS *VyraPOSCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraPOS.Contract.GetRoleAdmin(&_VyraPOS.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPOS *VyraPOSCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPOS *VyraPOSSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraPOS.Contract.HasRole(&_VyraPOS.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraPOS *VyraPOSCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraPOS.Contract.HasRole(&_VyraPOS.CallOpts, role, account)
+}
+
+// Invoices is a free data retrieval call binding the contract method 0xf8a8a076.
+//
+// Solidity: function invoices(bytes32 ) view returns(address merchant, uint256 amount, string description, uint256 expiry, bool paid, bytes32 paymentId)
+func (_VyraPOS *VyraPOSCaller) Invoices(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	Merchant    common.Address
+	Amount      *big.Int
+	Description string
+	Expiry      *big.Int
+	Paid        bool
+	PaymentId   [32]byte
+}, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "invoices", arg0)
+
+	outstruct := new(struct {
+		Merchant    common.Address
+		Amount      *big.Int
+		Description string
+		Expiry      *big.Int
+		Paid        bool
+		PaymentId   [32]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Merchant = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Description = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.Expiry = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Paid = *abi.ConvertType(out[4], new(bool)).(*bool)
+	outstruct.PaymentId = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+
+	return *outstruct, err
+
+}
+
+// Invoices is a free data retrieval call binding the contract method 0xf8a8a076.
+//
+// Solidity: function invoices(bytes32 ) view returns(address merchant, uint256 amount, string description, uint256 expiry, bool paid, bytes32 paymentId)
+func (_VyraPOS *VyraPOSSession) Invoices(arg0 [32]byte) (struct {
+	Merchant    common.Address
+	Amount      *big.Int
+	Description string
+	Expiry      *big.Int
+	Paid        bool
+	PaymentId   [32]byte
+}, error) {
+	return _VyraPOS.Contract.Invoices(&_VyraPOS.CallOpts, arg0)
+}
+
+// Invoices is a free data retrieval call binding the contract method 0xf8a8a076.
+//
+// Solidity: function invoices(bytes32 ) view returns(address merchant, uint256 amount, string description, uint256 expiry, bool paid, bytes32 paymentId)
+func (_VyraPOS *VyraPOSCallerSession) Invoices(arg0 [32]byte) (struct {
+	Merchant    common.Address
+	Amount      *big.Int
+	Description string
+	Expiry      *big.Int
+	Paid        bool
+	PaymentId   [32]byte
+}, error) {
+	return _VyraPOS.Contract.Invoices(&_VyraPOS.CallOpts, arg0)
+}
+
+// MerchantEarnings is a free data retrieval call binding the contract method 0x376089ec.
+//
+// Solidity: function merchantEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) MerchantEarnings(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "merchantEarnings", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MerchantEarnings is a free data retrieval call binding the contract method 0x376089ec.
+//
+// Solidity: function merchantEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSSession) MerchantEarnings(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantEarnings(&_VyraPOS.CallOpts, arg0)
+}
+
+// MerchantEarnings is a free data retrieval call binding the contract method 0x376089ec.
+//
+// Solidity: function merchantEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) MerchantEarnings(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantEarnings(&_VyraPOS.CallOpts, arg0)
+}
+
+// MerchantFeeRate is a free data retrieval call binding the contract method 0x04b2b0f7.
+//
+// Solidity: function merchantFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) MerchantFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "merchantFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MerchantFeeRate is a free data retrieval call binding the contract method 0x04b2b0f7.
+//
+// Solidity: function merchantFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) MerchantFeeRate() (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantFeeRate(&_VyraPOS.CallOpts)
+}
+
+// MerchantFeeRate is a free data retrieval call binding the contract method 0x04b2b0f7.
+//
+// Solidity: function merchantFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) MerchantFeeRate() (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantFeeRate(&_VyraPOS.CallOpts)
+}
+
+// MerchantNonces is a free data retrieval call binding the contract method 0x10823efc.
+//
+// Solidity: function merchantNonces(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) MerchantNonces(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "merchantNonces", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MerchantNonces is a free data retrieval call binding the contract method 0x10823efc.
+//
+// Solidity: function merchantNonces(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSSession) MerchantNonces(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantNonces(&_VyraPOS.CallOpts, arg0)
+}
+
+// MerchantNonces is a free data retrieval call binding the contract method 0x10823efc.
+//
+// Solidity: function merchantNonces(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) MerchantNonces(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.MerchantNonces(&_VyraPOS.CallOpts, arg0)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraPOS *VyraPOSCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraPOS *VyraPOSSession) Paused() (bool, error) {
+	return _VyraPOS.Contract.Paused(&_VyraPOS.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraPOS *VyraPOSCallerSession) Paused() (bool, error) {
+	return _VyraPOS.Contract.Paused(&_VyraPOS.CallOpts)
+}
+
+// Payments is a free data retrieval call binding the contract method 0x0716326d.
+//
+// Solidity: function payments(bytes32 ) view returns(address customer, address merchant, uint256 amount, uint256 merchantFee, uint256 platformFee, uint256 timestamp, bool refunded, bytes32 invoiceId)
+func (_VyraPOS *VyraPOSCaller) Payments(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	Customer    common.Address
+	Merchant    common.Address
+	Amount      *big.Int
+	MerchantFee *big.Int
+	PlatformFee *big.Int
+	Timestamp   *big.Int
+	Refunded    bool
+	InvoiceId   [32]byte
+}, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "payments", arg0)
+
+	outstruct := new(struct {
+		Customer    common.Address
+		Merchant    common.Address
+		Amount      *big.Int
+		MerchantFee *big.Int
+		PlatformFee *big.Int
+		Timestamp   *big.Int
+		Refunded    bool
+		InvoiceId   [32]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Customer = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Merchant = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.MerchantFee = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.PlatformFee = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Timestamp = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.Refunded = *abi.ConvertType(out[6], new(bool)).(*bool)
+	outstruct.InvoiceId = *abi.ConvertType(out[7], new([32]byte)).(*[32]byte)
+
+	return *outstruct, err
+
+}
+
+// Payments is a free data retrieval call binding the contract method 0x0716326d.
+//
+// Solidity: function payments(bytes32 ) view returns(address customer, address merchant, uint256 amount, uint256 merchantFee, uint256 platformFee, uint256 timestamp, bool refunded, bytes32 invoiceId)
+func (_VyraPOS *VyraPOSSession) Payments(arg0 [32]byte) (struct {
+	Customer    common.Address
+	Merchant    common.Address
+	Amount      *big.Int
+	MerchantFee *big.Int
+	PlatformFee *big.Int
+	Timestamp   *big.Int
+	Refunded    bool
+	InvoiceId   [32]byte
+}, error) {
+	return _VyraPOS.Contract.Payments(&_VyraPOS.CallOpts, arg0)
+}
+
+// Payments is a free data retrieval call binding the contract method 0x0716326d.
+//
+// Solidity: function payments(bytes32 ) view returns(address customer, address merchant, uint256 amount, uint256 merchantFee, uint256 platformFee, uint256 timestamp, bool refunded, bytes32 invoiceId)
+func (_VyraPOS *VyraPOSCallerSession) Payments(arg0 [32]byte) (struct {
+	Customer    common.Address
+	Merchant    common.Address
+	Amount      *big.Int
+	MerchantFee *big.Int
+	PlatformFee *big.Int
+	Timestamp   *big.Int
+	Refunded    bool
+	InvoiceId   [32]byte
+}, error) {
+	return _VyraPOS.Contract.Payments(&_VyraPOS.CallOpts, arg0)
+}
+
+// PlatformEarnings is a free data retrieval call binding the contract method 0xc33a57d1.
+//
+// Solidity: function platformEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) PlatformEarnings(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "platformEarnings", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PlatformEarnings is a free data retrieval call binding the contract method 0xc33a57d1.
+//
+// Solidity: function platformEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSSession) PlatformEarnings(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.PlatformEarnings(&_VyraPOS.CallOpts, arg0)
+}
+
+// PlatformEarnings is a free data retrieval call binding the contract method 0xc33a57d1.
+//
+// Solidity: function platformEarnings(address ) view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) PlatformEarnings(arg0 common.Address) (*big.Int, error) {
+	return _VyraPOS.Contract.PlatformEarnings(&_VyraPOS.CallOpts, arg0)
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) PlatformFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "platformFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) PlatformFeeRate() (*big.Int, error) {
+	return _VyraPOS.Contract.PlatformFeeRate(&_VyraPOS.CallOpts)
+}
+
+// PlatformFeeRate is a free data retrieval call binding the contract method 0xeeca08f0.
+//
+// Solidity: function platformFeeRate() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) PlatformFeeRate() (*big.Int, error) {
+	return _VyraPOS.Contract.PlatformFeeRate(&_VyraPOS.CallOpts)
+}
+
+// PlatformTreasury is a free data retrieval call binding the contract method 0xe138818c.
+//
+// Solidity: function platformTreasury() view returns(address)
+func (_VyraPOS *VyraPOSCaller) PlatformTreasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "platformTreasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PlatformTreasury is a free data retrieval call binding the contract method 0xe138818c.
+//
+// Solidity: function platformTreasury() view returns(address)
+func (_VyraPOS *VyraPOSSession) PlatformTreasury() (common.Address, error) {
+	return _VyraPOS.Contract.PlatformTreasury(&_VyraPOS.CallOpts)
+}
+
+// PlatformTreasury is a free data retrieval call binding the contract method 0xe138818c.
+//
+// Solidity: function platformTreasury() view returns(address)
+func (_VyraPOS *VyraPOSCallerSession) PlatformTreasury() (common.Address, error) {
+	return _VyraPOS.Contract.PlatformTreasury(&_VyraPOS.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPOS *VyraPOSCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPOS *VyraPOSSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraPOS.Contract.SupportsInterface(&_VyraPOS.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraPOS *VyraPOSCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraPOS.Contract.SupportsInterface(&_VyraPOS.CallOpts, interfaceId)
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) TotalFees(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "totalFees")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) TotalFees() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalFees(&_VyraPOS.CallOpts)
+}
+
+// TotalFees is a free data retrieval call binding the contract method 0x13114a9d.
+//
+// Solidity: function totalFees() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) TotalFees() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalFees(&_VyraPOS.CallOpts)
+}
+
+// TotalTransactions is a free data retrieval call binding the contract method 0xb9a60038.
+//
+// Solidity: function totalTransactions() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) TotalTransactions(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "totalTransactions")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalTransactions is a free data retrieval call binding the contract method 0xb9a60038.
+//
+// Solidity: function totalTransactions() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) TotalTransactions() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalTransactions(&_VyraPOS.CallOpts)
+}
+
+// TotalTransactions is a free data retrieval call binding the contract method 0xb9a60038.
+//
+// Solidity: function totalTransactions() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) TotalTransactions() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalTransactions(&_VyraPOS.CallOpts)
+}
+
+// TotalVolume is a free data retrieval call binding the contract method 0x5f81a57c.
+//
+// Solidity: function totalVolume() view returns(uint256)
+func (_VyraPOS *VyraPOSCaller) TotalVolume(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "totalVolume")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalVolume is a free data retrieval call binding the contract method 0x5f81a57c.
+//
+// Solidity: function totalVolume() view returns(uint256)
+func (_VyraPOS *VyraPOSSession) TotalVolume() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalVolume(&_VyraPOS.CallOpts)
+}
+
+// TotalVolume is a free data retrieval call binding the contract method 0x5f81a57c.
+//
+// Solidity: function totalVolume() view returns(uint256)
+func (_VyraPOS *VyraPOSCallerSession) TotalVolume() (*big.Int, error) {
+	return _VyraPOS.Contract.TotalVolume(&_VyraPOS.CallOpts)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPOS *VyraPOSCaller) VyraToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraPOS.contract.Call(opts, &out, "vyraToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPOS *VyraPOSSession) VyraToken() (common.Address, error) {
+	return _VyraPOS.Contract.VyraToken(&_VyraPOS.CallOpts)
+}
+
+// VyraToken is a free data retrieval call binding the contract method 0xd84d495e.
+//
+// Solidity: function vyraToken() view returns(address)
+func (_VyraPOS *VyraPOSCallerSession) VyraToken() (common.Address, error) {
+	return _VyraPOS.Contract.VyraToken(&_VyraPOS.CallOpts)
+}
+
+// CreateInvoice is a paid mutator transaction binding the contract method 0x07ed21d1.
+//
+// Solidity: function createInvoice(uint256 amount, string description, uint256 expiry, bytes signature) returns(bytes32 invoiceId)
+func (_VyraPOS *VyraPOSTransactor) CreateInvoice(opts *bind.TransactOpts, amount *big.Int, description string, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "createInvoice", amount, description, expiry, signature)
+}
+
+// CreateInvoice is a paid mutator transaction binding the contract method 0x07ed21d1.
+//
+// Solidity: function createInvoice(uint256 amount, string description, uint256 expiry, bytes signature) returns(bytes32 invoiceId)
+func (_VyraPOS *VyraPOSSession) CreateInvoice(amount *big.Int, description string, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.CreateInvoice(&_VyraPOS.TransactOpts, amount, description, expiry, signature)
+}
+
+// CreateInvoice is a paid mutator transaction binding the contract method 0x07ed21d1.
+//
+// Solidity: function createInvoice(uint256 amount, string description, uint256 expiry, bytes signature) returns(bytes32 invoiceId)
+func (_VyraPOS *VyraPOSTransactorSession) CreateInvoice(amount *big.Int, description string, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.CreateInvoice(&_VyraPOS.TransactOpts, amount, description, expiry, signature)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.GrantRole(&_VyraPOS.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.GrantRole(&_VyraPOS.TransactOpts, role, account)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraPOS *VyraPOSTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraPOS *VyraPOSSession) Pause() (*types.Transaction, error) {
+	return _VyraPOS.Contract.Pause(&_VyraPOS.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraPOS *VyraPOSTransactorSession) Pause() (*types.Transaction, error) {
+	return _VyraPOS.Contract.Pause(&_VyraPOS.TransactOpts)
+}
+
+// ProcessPayment is a paid mutator transaction binding the contract method 0x93f90b1f.
+//
+// Solidity: function processPayment(bytes32 invoiceId, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSTransactor) ProcessPayment(opts *bind.TransactOpts, invoiceId [32]byte, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "processPayment", invoiceId, customer, signature)
+}
+
+// ProcessPayment is a paid mutator transaction binding the contract method 0x93f90b1f.
+//
+// Solidity: function processPayment(bytes32 invoiceId, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSSession) ProcessPayment(invoiceId [32]byte, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.ProcessPayment(&_VyraPOS.TransactOpts, invoiceId, customer, signature)
+}
+
+// ProcessPayment is a paid mutator transaction binding the contract method 0x93f90b1f.
+//
+// Solidity: function processPayment(bytes32 invoiceId, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSTransactorSession) ProcessPayment(invoiceId [32]byte, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.ProcessPayment(&_VyraPOS.TransactOpts, invoiceId, customer, signature)
+}
+
+// ProcessSplitPayment is a paid mutator transaction binding the contract method 0x9e3fb531.
+//
+// Solidity: function processSplitPayment(address[] recipients, uint256[] percentages, uint256 totalAmount, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSTransactor) ProcessSplitPayment(opts *bind.TransactOpts, recipients []common.Address, percentages []*big.Int, totalAmount *big.Int, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "processSplitPayment", recipients, percentages, totalAmount, customer, signature)
+}
+
+// ProcessSplitPayment is a paid mutator transaction binding the contract method 0x9e3fb531.
+//
+// Solidity: function processSplitPayment(address[] recipients, uint256[] percentages, uint256 totalAmount, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSSession) ProcessSplitPayment(recipients []common.Address, percentages []*big.Int, totalAmount *big.Int, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.ProcessSplitPayment(&_VyraPOS.TransactOpts, recipients, percentages, totalAmount, customer, signature)
+}
+
+// ProcessSplitPayment is a paid mutator transaction binding the contract method 0x9e3fb531.
+//
+// Solidity: function processSplitPayment(address[] recipients, uint256[] percentages, uint256 totalAmount, address customer, bytes signature) returns(bytes32 paymentId)
+func (_VyraPOS *VyraPOSTransactorSession) ProcessSplitPayment(recipients []common.Address, percentages []*big.Int, totalAmount *big.Int, customer common.Address, signature []byte) (*types.Transaction, error) {
+	return _VyraPOS.Contract.ProcessSplitPayment(&_VyraPOS.TransactOpts, recipients, percentages, totalAmount, customer, signature)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPOS *VyraPOSTransactor) RecoverToken(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "recoverToken", token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPOS *VyraPOSSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RecoverToken(&_VyraPOS.TransactOpts, token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraPOS *VyraPOSTransactorSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RecoverToken(&_VyraPOS.TransactOpts, token, amount)
+}
+
+// RefundPayment is a paid mutator transaction binding the contract method 0x9e7f2101.
+//
+// Solidity: function refundPayment(bytes32 paymentId, uint256 refundAmount) returns()
+func (_VyraPOS *VyraPOSTransactor) RefundPayment(opts *bind.TransactOpts, paymentId [32]byte, refundAmount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "refundPayment", paymentId, refundAmount)
+}
+
+// RefundPayment is a paid mutator transaction binding the contract method 0x9e7f2101.
+//
+// Solidity: function refundPayment(bytes32 paymentId, uint256 refundAmount) returns()
+func (_VyraPOS *VyraPOSSession) RefundPayment(paymentId [32]byte, refundAmount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RefundPayment(&_VyraPOS.TransactOpts, paymentId, refundAmount)
+}
+
+// RefundPayment is a paid mutator transaction binding the contract method 0x9e7f2101.
+//
+// Solidity: function refundPayment(bytes32 paymentId, uint256 refundAmount) returns()
+func (_VyraPOS *VyraPOSTransactorSession) RefundPayment(paymentId [32]byte, refundAmount *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RefundPayment(&_VyraPOS.TransactOpts, paymentId, refundAmount)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPOS *VyraPOSTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPOS *VyraPOSSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RenounceRole(&_VyraPOS.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraPOS *VyraPOSTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RenounceRole(&_VyraPOS.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RevokeRole(&_VyraPOS.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraPOS *VyraPOSTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.RevokeRole(&_VyraPOS.TransactOpts, role, account)
+}
+
+// SetMerchantFeeRate is a paid mutator transaction binding the contract method 0x04f08dee.
+//
+// Solidity: function setMerchantFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSTransactor) SetMerchantFeeRate(opts *bind.TransactOpts, newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "setMerchantFeeRate", newRate)
+}
+
+// SetMerchantFeeRate is a paid mutator transaction binding the contract method 0x04f08dee.
+//
+// Solidity: function setMerchantFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSSession) SetMerchantFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetMerchantFeeRate(&_VyraPOS.TransactOpts, newRate)
+}
+
+// SetMerchantFeeRate is a paid mutator transaction binding the contract method 0x04f08dee.
+//
+// Solidity: function setMerchantFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSTransactorSession) SetMerchantFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetMerchantFeeRate(&_VyraPOS.TransactOpts, newRate)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSTransactor) SetPlatformFeeRate(opts *bind.TransactOpts, newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "setPlatformFeeRate", newRate)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSSession) SetPlatformFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetPlatformFeeRate(&_VyraPOS.TransactOpts, newRate)
+}
+
+// SetPlatformFeeRate is a paid mutator transaction binding the contract method 0x927fef2e.
+//
+// Solidity: function setPlatformFeeRate(uint256 newRate) returns()
+func (_VyraPOS *VyraPOSTransactorSession) SetPlatformFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetPlatformFeeRate(&_VyraPOS.TransactOpts, newRate)
+}
+
+// SetPlatformTreasury is a paid mutator transaction binding the contract method 0x7cd86d60.
+//
+// Solidity: function setPlatformTreasury(address newTreasury) returns()
+func (_VyraPOS *VyraPOSTransactor) SetPlatformTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "setPlatformTreasury", newTreasury)
+}
+
+// SetPlatformTreasury is a paid mutator transaction binding the contract method 0x7cd86d60.
+//
+// Solidity: function setPlatformTreasury(address newTreasury) returns()
+func (_VyraPOS *VyraPOSSession) SetPlatformTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetPlatformTreasury(&_VyraPOS.TransactOpts, newTreasury)
+}
+
+// SetPlatformTreasury is a paid mutator transaction binding the contract method 0x7cd86d60.
+//
+// Solidity: function setPlatformTreasury(address newTreasury) returns()
+func (_VyraPOS *VyraPOSTransactorSession) SetPlatformTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraPOS.Contract.SetPlatformTreasury(&_VyraPOS.TransactOpts, newTreasury)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraPOS *VyraPOSTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraPOS.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraPOS *VyraPOSSession) Unpause() (*types.Transaction, error) {
+	return _VyraPOS.Contract.Unpause(&_VyraPOS.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraPOS *VyraPOSTransactorSession) Unpause() (*types.Transaction, error) {
+	return _VyraPOS.Contract.Unpause(&_VyraPOS.TransactOpts)
+}
+
+// VyraPOSInvoiceCreatedIterator is returned from FilterInvoiceCreated and is used to iterate over the raw logs and unpacked data for InvoiceCreated events raised by the VyraPOS contract.
+type VyraPOSInvoiceCreatedIterator struct {
+	Event *VyraPOSInvoiceCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSInvoiceCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSInvoiceCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSInvoiceCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSInvoiceCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSInvoiceCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSInvoiceCreated represents a InvoiceCreated event raised by the VyraPOS contract.
+type VyraPOSInvoiceCreated struct {
+	InvoiceId [32]byte
+	Merchant  common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterInvoiceCreated is a free log retrieval operation binding the contract event 0x3114db1aece0ce04bcd2aad6e3239f2341ca90a4926ce081dccdd32fd4cbcfff.
+//
+// Solidity: event InvoiceCreated(bytes32 indexed invoiceId, address indexed merchant, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) FilterInvoiceCreated(opts *bind.FilterOpts, invoiceId [][32]byte, merchant []common.Address) (*VyraPOSInvoiceCreatedIterator, error) {
+
+	var invoiceIdRule []interface{}
+	for _, invoiceIdItem := range invoiceId {
+		invoiceIdRule = append(invoiceIdRule, invoiceIdItem)
+	}
+	var merchantRule []interface{}
+	for _, merchantItem := range merchant {
+		merchantRule = append(merchantRule, merchantItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "InvoiceCreated", invoiceIdRule, merchantRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSInvoiceCreatedIterator{contract: _VyraPOS.contract, event: "InvoiceCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchInvoiceCreated is a free log subscription operation binding the contract event 0x3114db1aece0ce04bcd2aad6e3239f2341ca90a4926ce081dccdd32fd4cbcfff.
+//
+// Solidity: event InvoiceCreated(bytes32 indexed invoiceId, address indexed merchant, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) WatchInvoiceCreated(opts *bind.WatchOpts, sink chan<- *VyraPOSInvoiceCreated, invoiceId [][32]byte, merchant []common.Address) (event.Subscription, error) {
+
+	var invoiceIdRule []interface{}
+	for _, invoiceIdItem := range invoiceId {
+		invoiceIdRule = append(invoiceIdRule, invoiceIdItem)
+	}
+	var merchantRule []interface{}
+	for _, merchantItem := range merchant {
+		merchantRule = append(merchantRule, merchantItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "InvoiceCreated", invoiceIdRule, merchantRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSInvoiceCreated)
+				if err := _VyraPOS.contract.UnpackLog(event, "InvoiceCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInvoiceCreated is a log parse operation binding the contract event 0x3114db1aece0ce04bcd2aad6e3239f2341ca90a4926ce081dccdd32fd4cbcfff.
+//
+// Solidity: event InvoiceCreated(bytes32 indexed invoiceId, address indexed merchant, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) ParseInvoiceCreated(log types.Log) (*VyraPOSInvoiceCreated, error) {
+	event := new(VyraPOSInvoiceCreated)
+	if err := _VyraPOS.contract.UnpackLog(event, "InvoiceCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSMerchantFeeUpdatedIterator is returned from FilterMerchantFeeUpdated and is used to iterate over the raw logs and unpacked data for MerchantFeeUpdated events raised by the VyraPOS contract.
+type VyraPOSMerchantFeeUpdatedIterator struct {
+	Event *VyraPOSMerchantFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSMerchantFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSMerchantFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSMerchantFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSMerchantFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSMerchantFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSMerchantFeeUpdated represents a MerchantFeeUpdated event raised by the VyraPOS contract.
+type VyraPOSMerchantFeeUpdated struct {
+	OldRate *big.Int
+	NewRate *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMerchantFeeUpdated is a free log retrieval operation binding the contract event 0x3261ab5b02c79fbc0b9e701eee1148c44ea11aae94f9a7606d01bfb211b8f51b.
+//
+// Solidity: event MerchantFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) FilterMerchantFeeUpdated(opts *bind.FilterOpts) (*VyraPOSMerchantFeeUpdatedIterator, error) {
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "MerchantFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSMerchantFeeUpdatedIterator{contract: _VyraPOS.contract, event: "MerchantFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMerchantFeeUpdated is a free log subscription operation binding the contract event 0x3261ab5b02c79fbc0b9e701eee1148c44ea11aae94f9a7606d01bfb211b8f51b.
+//
+// Solidity: event MerchantFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) WatchMerchantFeeUpdated(opts *bind.WatchOpts, sink chan<- *VyraPOSMerchantFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "MerchantFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSMerchantFeeUpdated)
+				if err := _VyraPOS.contract.UnpackLog(event, "MerchantFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMerchantFeeUpdated is a log parse operation binding the contract event 0x3261ab5b02c79fbc0b9e701eee1148c44ea11aae94f9a7606d01bfb211b8f51b.
+//
+// Solidity: event MerchantFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) ParseMerchantFeeUpdated(log types.Log) (*VyraPOSMerchantFeeUpdated, error) {
+	event := new(VyraPOSMerchantFeeUpdated)
+	if err := _VyraPOS.contract.UnpackLog(event, "MerchantFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the VyraPOS contract.
+type VyraPOSPausedIterator struct {
+	Event *VyraPOSPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSPaused represents a Paused event raised by the VyraPOS contract.
+type VyraPOSPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraPOS *VyraPOSFilterer) FilterPaused(opts *bind.FilterOpts) (*VyraPOSPausedIterator, error) {
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSPausedIterator{contract: _VyraPOS.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraPOS *VyraPOSFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *VyraPOSPaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSPaused)
+				if err := _VyraPOS.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraPOS *VyraPOSFilterer) ParsePaused(log types.Log) (*VyraPOSPaused, error) {
+	event := new(VyraPOSPaused)
+	if err := _VyraPOS.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSPaymentProcessedIterator is returned from FilterPaymentProcessed and is used to iterate over the raw logs and unpacked data for PaymentProcessed events raised by the VyraPOS contract.
+type VyraPOSPaymentProcessedIterator struct {
+	Event *VyraPOSPaymentProcessed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSPaymentProcessedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSPaymentProcessed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSPaymentProcessed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSPaymentProcessedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSPaymentProcessedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSPaymentProcessed represents a PaymentProcessed event raised by the VyraPOS contract.
+type VyraPOSPaymentProcessed struct {
+	PaymentId [32]byte
+	InvoiceId [32]byte
+	Customer  common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaymentProcessed is a free log retrieval operation binding the contract event 0xf35530226cd362ac76defdbab67a89f2530317969a540baa84974a4f31376039.
+//
+// Solidity: event PaymentProcessed(bytes32 indexed paymentId, bytes32 indexed invoiceId, address indexed customer, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) FilterPaymentProcessed(opts *bind.FilterOpts, paymentId [][32]byte, invoiceId [][32]byte, customer []common.Address) (*VyraPOSPaymentProcessedIterator, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+	var invoiceIdRule []interface{}
+	for _, invoiceIdItem := range invoiceId {
+		invoiceIdRule = append(invoiceIdRule, invoiceIdItem)
+	}
+	var customerRule []interface{}
+	for _, customerItem := range customer {
+		customerRule = append(customerRule, customerItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "PaymentProcessed", paymentIdRule, invoiceIdRule, customerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSPaymentProcessedIterator{contract: _VyraPOS.contract, event: "PaymentProcessed", logs: logs, sub: sub}, nil
+}
+
+// WatchPaymentProcessed is a free log subscription operation binding the contract event 0xf35530226cd362ac76defdbab67a89f2530317969a540baa84974a4f31376039.
+//
+// Solidity: event PaymentProcessed(bytes32 indexed paymentId, bytes32 indexed invoiceId, address indexed customer, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) WatchPaymentProcessed(opts *bind.WatchOpts, sink chan<- *VyraPOSPaymentProcessed, paymentId [][32]byte, invoiceId [][32]byte, customer []common.Address) (event.Subscription, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+	var invoiceIdRule []interface{}
+	for _, invoiceIdItem := range invoiceId {
+		invoiceIdRule = append(invoiceIdRule, invoiceIdItem)
+	}
+	var customerRule []interface{}
+	for _, customerItem := range customer {
+		customerRule = append(customerRule, customerItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "PaymentProcessed", paymentIdRule, invoiceIdRule, customerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSPaymentProcessed)
+				if err := _VyraPOS.contract.UnpackLog(event, "PaymentProcessed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaymentProcessed is a log parse operation binding the contract event 0xf35530226cd362ac76defdbab67a89f2530317969a540baa84974a4f31376039.
+//
+// Solidity: event PaymentProcessed(bytes32 indexed paymentId, bytes32 indexed invoiceId, address indexed customer, uint256 amount)
+func (_VyraPOS *VyraPOSFilterer) ParsePaymentProcessed(log types.Log) (*VyraPOSPaymentProcessed, error) {
+	event := new(VyraPOSPaymentProcessed)
+	if err := _VyraPOS.contract.UnpackLog(event, "PaymentProcessed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSPaymentRefundedIterator is returned from FilterPaymentRefunded and is used to iterate over the raw logs and unpacked data for PaymentRefunded events raised by the VyraPOS contract.
+type VyraPOSPaymentRefundedIterator struct {
+	Event *VyraPOSPaymentRefunded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSPaymentRefundedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSPaymentRefunded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSPaymentRefunded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSPaymentRefundedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSPaymentRefundedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSPaymentRefunded represents a PaymentRefunded event raised by the VyraPOS contract.
+type VyraPOSPaymentRefunded struct {
+	PaymentId    [32]byte
+	RefundAmount *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaymentRefunded is a free log retrieval operation binding the contract event 0x7a1dd09597e0454bccf83a57e47d6ce29d4f89f3dd01f54d4bcf1768b1728654.
+//
+// Solidity: event PaymentRefunded(bytes32 indexed paymentId, uint256 refundAmount)
+func (_VyraPOS *VyraPOSFilterer) FilterPaymentRefunded(opts *bind.FilterOpts, paymentId [][32]byte) (*VyraPOSPaymentRefundedIterator, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "PaymentRefunded", paymentIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSPaymentRefundedIterator{contract: _VyraPOS.contract, event: "PaymentRefunded", logs: logs, sub: sub}, nil
+}
+
+// WatchPaymentRefunded is a free log subscription operation binding the contract event 0x7a1dd09597e0454bccf83a57e47d6ce29d4f89f3dd01f54d4bcf1768b1728654.
+//
+// Solidity: event PaymentRefunded(bytes32 indexed paymentId, uint256 refundAmount)
+func (_VyraPOS *VyraPOSFilterer) WatchPaymentRefunded(opts *bind.WatchOpts, sink chan<- *VyraPOSPaymentRefunded, paymentId [][32]byte) (event.Subscription, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "PaymentRefunded", paymentIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSPaymentRefunded)
+				if err := _VyraPOS.contract.UnpackLog(event, "PaymentRefunded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaymentRefunded is a log parse operation binding the contract event 0x7a1dd09597e0454bccf83a57e47d6ce29d4f89f3dd01f54d4bcf1768b1728654.
+//
+// Solidity: event PaymentRefunded(bytes32 indexed paymentId, uint256 refundAmount)
+func (_VyraPOS *VyraPOSFilterer) ParsePaymentRefunded(log types.Log) (*VyraPOSPaymentRefunded, error) {
+	event := new(VyraPOSPaymentRefunded)
+	if err := _VyraPOS.contract.UnpackLog(event, "PaymentRefunded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSPlatformFeeUpdatedIterator is returned from FilterPlatformFeeUpdated and is used to iterate over the raw logs and unpacked data for PlatformFeeUpdated events raised by the VyraPOS contract.
+type VyraPOSPlatformFeeUpdatedIterator struct {
+	Event *VyraPOSPlatformFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSPlatformFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSPlatformFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSPlatformFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSPlatformFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSPlatformFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSPlatformFeeUpdated represents a PlatformFeeUpdated event raised by the VyraPOS contract.
+type VyraPOSPlatformFeeUpdated struct {
+	OldRate *big.Int
+	NewRate *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPlatformFeeUpdated is a free log retrieval operation binding the contract event 0xd347e206f25a89b917fc9482f1a2d294d749baa4dc9bde7fb495ee11fe491643.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) FilterPlatformFeeUpdated(opts *bind.FilterOpts) (*VyraPOSPlatformFeeUpdatedIterator, error) {
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "PlatformFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSPlatformFeeUpdatedIterator{contract: _VyraPOS.contract, event: "PlatformFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPlatformFeeUpdated is a free log subscription operation binding the contract event 0xd347e206f25a89b917fc9482f1a2d294d749baa4dc9bde7fb495ee11fe491643.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) WatchPlatformFeeUpdated(opts *bind.WatchOpts, sink chan<- *VyraPOSPlatformFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "PlatformFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSPlatformFeeUpdated)
+				if err := _VyraPOS.contract.UnpackLog(event, "PlatformFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePlatformFeeUpdated is a log parse operation binding the contract event 0xd347e206f25a89b917fc9482f1a2d294d749baa4dc9bde7fb495ee11fe491643.
+//
+// Solidity: event PlatformFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraPOS *VyraPOSFilterer) ParsePlatformFeeUpdated(log types.Log) (*VyraPOSPlatformFeeUpdated, error) {
+	event := new(VyraPOSPlatformFeeUpdated)
+	if err := _VyraPOS.contract.UnpackLog(event, "PlatformFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSPlatformTreasuryUpdatedIterator is returned from FilterPlatformTreasuryUpdated and is used to iterate over the raw logs and unpacked data for PlatformTreasuryUpdated events raised by the VyraPOS contract.
+type VyraPOSPlatformTreasuryUpdatedIterator struct {
+	Event *VyraPOSPlatformTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSPlatformTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSPlatformTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSPlatformTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSPlatformTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSPlatformTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSPlatformTreasuryUpdated represents a PlatformTreasuryUpdated event raised by the VyraPOS contract.
+type VyraPOSPlatformTreasuryUpdated struct {
+	OldTreasury common.Address
+	NewTreasury common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPlatformTreasuryUpdated is a free log retrieval operation binding the contract event 0xf3814cc4b6220f5bf02409e4cebe75e5032fdb20cef0db459431fd5cba574b93.
+//
+// Solidity: event PlatformTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraPOS *VyraPOSFilterer) FilterPlatformTreasuryUpdated(opts *bind.FilterOpts) (*VyraPOSPlatformTreasuryUpdatedIterator, error) {
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "PlatformTreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSPlatformTreasuryUpdatedIterator{contract: _VyraPOS.contract, event: "PlatformTreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPlatformTreasuryUpdated is a free log subscription operation binding the contract event 0xf3814cc4b6220f5bf02409e4cebe75e5032fdb20cef0db459431fd5cba574b93.
+//
+// Solidity: event PlatformTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraPOS *VyraPOSFilterer) WatchPlatformTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *VyraPOSPlatformTreasuryUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "PlatformTreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSPlatformTreasuryUpdated)
+				if err := _VyraPOS.contract.UnpackLog(event, "PlatformTreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePlatformTreasuryUpdated is a log parse operation binding the contract event 0xf3814cc4b6220f5bf02409e4cebe75e5032fdb20cef0db459431fd5cba574b93.
+//
+// Solidity: event PlatformTreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraPOS *VyraPOSFilterer) ParsePlatformTreasuryUpdated(log types.Log) (*VyraPOSPlatformTreasuryUpdated, error) {
+	event := new(VyraPOSPlatformTreasuryUpdated)
+	if err := _VyraPOS.contract.UnpackLog(event, "PlatformTreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the VyraPOS contract.
+type VyraPOSRoleAdminChangedIterator struct {
+	Event *VyraPOSRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSRoleAdminChanged represents a RoleAdminChanged event raised by the VyraPOS contract.
+type VyraPOSRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPOS *VyraPOSFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*VyraPOSRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSRoleAdminChangedIterator{contract: _VyraPOS.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPOS *VyraPOSFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *VyraPOSRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSRoleAdminChanged)
+				if err := _VyraPOS.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraPOS *VyraPOSFilterer) ParseRoleAdminChanged(log types.Log) (*VyraPOSRoleAdminChanged, error) {
+	event := new(VyraPOSRoleAdminChanged)
+	if err := _VyraPOS.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the VyraPOS contract.
+type VyraPOSRoleGrantedIterator struct {
+	Event *VyraPOSRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSRoleGranted represents a RoleGranted event raised by the VyraPOS contract.
+type VyraPOSRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraPOSRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSRoleGrantedIterator{contract: _VyraPOS.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *VyraPOSRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSRoleGranted)
+				if err := _VyraPOS.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) ParseRoleGranted(log types.Log) (*VyraPOSRoleGranted, error) {
+	event := new(VyraPOSRoleGranted)
+	if err := _VyraPOS.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the VyraPOS contract.
+type VyraPOSRoleRevokedIterator struct {
+	Event *VyraPOSRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSRoleRevoked represents a RoleRevoked event raised by the VyraPOS contract.
+type VyraPOSRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraPOSRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSRoleRevokedIterator{contract: _VyraPOS.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *VyraPOSRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSRoleRevoked)
+				if err := _VyraPOS.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraPOS *VyraPOSFilterer) ParseRoleRevoked(log types.Log) (*VyraPOSRoleRevoked, error) {
+	event := new(VyraPOSRoleRevoked)
+	if err := _VyraPOS.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSSplitPaymentProcessedIterator is returned from FilterSplitPaymentProcessed and is used to iterate over the raw logs and unpacked data for SplitPaymentProcessed events raised by the VyraPOS contract.
+type VyraPOSSplitPaymentProcessedIterator struct {
+	Event *VyraPOSSplitPaymentProcessed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSSplitPaymentProcessedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSSplitPaymentProcessed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSSplitPaymentProcessed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSSplitPaymentProcessedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSSplitPaymentProcessedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSSplitPaymentProcessed represents a SplitPaymentProcessed event raised by the VyraPOS contract.
+type VyraPOSSplitPaymentProcessed struct {
+	PaymentId  [32]byte
+	Recipients []common.Address
+	Amounts    []*big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSplitPaymentProcessed is a free log retrieval operation binding the contract event 0x1ff3c0ea48191dc78a89b144cb5cd72de67f32a5547f5de82a96f2b0c6c13b3b.
+//
+// Solidity: event SplitPaymentProcessed(bytes32 indexed paymentId, address[] recipients, uint256[] amounts)
+func (_VyraPOS *VyraPOSFilterer) FilterSplitPaymentProcessed(opts *bind.FilterOpts, paymentId [][32]byte) (*VyraPOSSplitPaymentProcessedIterator, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "SplitPaymentProcessed", paymentIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSSplitPaymentProcessedIterator{contract: _VyraPOS.contract, event: "SplitPaymentProcessed", logs: logs, sub: sub}, nil
+}
+
+// WatchSplitPaymentProcessed is a free log subscription operation binding the contract event 0x1ff3c0ea48191dc78a89b144cb5cd72de67f32a5547f5de82a96f2b0c6c13b3b.
+//
+// Solidity: event SplitPaymentProcessed(bytes32 indexed paymentId, address[] recipients, uint256[] amounts)
+func (_VyraPOS *VyraPOSFilterer) WatchSplitPaymentProcessed(opts *bind.WatchOpts, sink chan<- *VyraPOSSplitPaymentProcessed, paymentId [][32]byte) (event.Subscription, error) {
+
+	var paymentIdRule []interface{}
+	for _, paymentIdItem := range paymentId {
+		paymentIdRule = append(paymentIdRule, paymentIdItem)
+	}
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "SplitPaymentProcessed", paymentIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSSplitPaymentProcessed)
+				if err := _VyraPOS.contract.UnpackLog(event, "SplitPaymentProcessed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSplitPaymentProcessed is a log parse operation binding the contract event 0x1ff3c0ea48191dc78a89b144cb5cd72de67f32a5547f5de82a96f2b0c6c13b3b.
+//
+// Solidity: event SplitPaymentProcessed(bytes32 indexed paymentId, address[] recipients, uint256[] amounts)
+func (_VyraPOS *VyraPOSFilterer) ParseSplitPaymentProcessed(log types.Log) (*VyraPOSSplitPaymentProcessed, error) {
+	event := new(VyraPOSSplitPaymentProcessed)
+	if err := _VyraPOS.contract.UnpackLog(event, "SplitPaymentProcessed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraPOSUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the VyraPOS contract.
+type VyraPOSUnpausedIterator struct {
+	Event *VyraPOSUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraPOSUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraPOSUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraPOSUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraPOSUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraPOSUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraPOSUnpaused represents a Unpaused event raised by the VyraPOS contract.
+type VyraPOSUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraPOS *VyraPOSFilterer) FilterUnpaused(opts *bind.FilterOpts) (*VyraPOSUnpausedIterator, error) {
+
+	logs, sub, err := _VyraPOS.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraPOSUnpausedIterator{contract: _VyraPOS.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraPOS *VyraPOSFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *VyraPOSUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraPOS.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraPOSUnpaused)
+				if err := _VyraPOS.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraPOS *VyraPOSFilterer) ParseUnpaused(log types.Log) (*VyraPOSUnpaused, error) {
+	event := new(VyraPOSUnpaused)
+	if err := _VyraPOS.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3009 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VyraTokenMetaData contains all meta data concerning the VyraToken contract.
+var VyraTokenMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"BURNER_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FEE_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"GENESIS_SUPPLY\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_SUPPLY\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MINTER_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PAUSER_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TREASURY_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"burnFrom\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"collectFees\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"collectedFees\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTransferFee\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTreasuryFees\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFees\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mint\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"recoverToken\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTransferFeeRate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTreasury\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTreasuryFeeRate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transfer\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferFeeRate\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"treasury\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"treasuryFeeRate\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FeesCollected\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokensBurned\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferFeeUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TreasuryFeeUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"oldRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newRate\",\"type\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TreasuryUpdated\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oldTreasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ERC20InsufficientAllowance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC20InsufficientBalance\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidApprover\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidReceiver\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidSender\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC20InvalidSpender\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}]},{\"type\":\"error\",\"name\":\"ExceedsMaxSupply\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidFeeRate\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidTreasury\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TransferToZeroAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TransferFromZeroAddress\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]}]",
+}
+
+// VyraTokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use VyraTokenMetaData.ABI instead.
+var VyraTokenABI = VyraTokenMetaData.ABI
+
+// VyraToken is an auto generated Go binding around an Ethereum contract.
+type VyraToken struct {
+	VyraTokenCaller     // Read-only binding to the contract
+	VyraTokenTransactor // Write-only binding to the contract
+	VyraTokenFilterer   // Log filterer for contract events
+}
+
+// VyraTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VyraTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VyraTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VyraTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VyraTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VyraTokenSession struct {
+	Contract     *VyraToken        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VyraTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VyraTokenCallerSession struct {
+	Contract *VyraTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// VyraTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VyraTokenTransactorSession struct {
+	Contract     *VyraTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// VyraTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VyraTokenRaw struct {
+	Contract *VyraToken // Generic contract binding to access the raw methods on
+}
+
+// VyraTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VyraTokenCallerRaw struct {
+	Contract *VyraTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VyraTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VyraTokenTransactorRaw struct {
+	Contract *VyraTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVyraToken creates a new instance of VyraToken, bound to a specific deployed contract.
+func NewVyraToken(address common.Address, backend bind.ContractBackend) (*VyraToken, error) {
+	contract, err := bindVyraToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraToken{VyraTokenCaller: VyraTokenCaller{contract: contract}, VyraTokenTransactor: VyraTokenTransactor{contract: contract}, VyraTokenFilterer: VyraTokenFilterer{contract: contract}}, nil
+}
+
+// NewVyraTokenCaller creates a new read-only instance of VyraToken, bound to a specific deployed contract.
+func NewVyraTokenCaller(address common.Address, caller bind.ContractCaller) (*VyraTokenCaller, error) {
+	contract, err := bindVyraToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenCaller{contract: contract}, nil
+}
+
+// NewVyraTokenTransactor creates a new write-only instance of VyraToken, bound to a specific deployed contract.
+func NewVyraTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*VyraTokenTransactor, error) {
+	contract, err := bindVyraToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTransactor{contract: contract}, nil
+}
+
+// NewVyraTokenFilterer creates a new log filterer instance of VyraToken, bound to a specific deployed contract.
+func NewVyraTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*VyraTokenFilterer, error) {
+	contract, err := bindVyraToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenFilterer{contract: contract}, nil
+}
+
+// bindVyraToken binds a generic wrapper to an already deployed contract.
+func bindVyraToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VyraTokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraToken *VyraTokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraToken.Contract.VyraTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraToken *VyraTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraToken.Contract.VyraTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraToken *VyraTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraToken.Contract.VyraTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VyraToken *VyraTokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VyraToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VyraToken *VyraTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VyraToken *VyraTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VyraToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) BURNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "BURNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenSession) BURNERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.BURNERROLE(&_VyraToken.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) BURNERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.BURNERROLE(&_VyraToken.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraToken.Contract.DEFAULTADMINROLE(&_VyraToken.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VyraToken.Contract.DEFAULTADMINROLE(&_VyraToken.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) FEEDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "FEE_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraToken *VyraTokenSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraToken.Contract.FEEDENOMINATOR(&_VyraToken.CallOpts)
+}
+
+// FEEDENOMINATOR is a free data retrieval call binding the contract method 0xd73792a9.
+//
+// Solidity: function FEE_DENOMINATOR() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) FEEDENOMINATOR() (*big.Int, error) {
+	return _VyraToken.Contract.FEEDENOMINATOR(&_VyraToken.CallOpts)
+}
+
+// GENESISSUPPLY is a free data retrieval call binding the contract method 0x99ec6765.
+//
+// Solidity: function GENESIS_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) GENESISSUPPLY(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "GENESIS_SUPPLY")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GENESISSUPPLY is a free data retrieval call binding the contract method 0x99ec6765.
+//
+// Solidity: function GENESIS_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenSession) GENESISSUPPLY() (*big.Int, error) {
+	return _VyraToken.Contract.GENESISSUPPLY(&_VyraToken.CallOpts)
+}
+
+// GENESISSUPPLY is a free data retrieval call binding the contract method 0x99ec6765.
+//
+// Solidity: function GENESIS_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) GENESISSUPPLY() (*big.Int, error) {
+	return _VyraToken.Contract.GENESISSUPPLY(&_VyraToken.CallOpts)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) MAXSUPPLY(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "MAX_SUPPLY")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenSession) MAXSUPPLY() (*big.Int, error) {
+	return _VyraToken.Contract.MAXSUPPLY(&_VyraToken.CallOpts)
+}
+
+// MAXSUPPLY is a free data retrieval call binding the contract method 0x32cb6b0c.
+//
+// Solidity: function MAX_SUPPLY() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) MAXSUPPLY() (*big.Int, error) {
+	return _VyraToken.Contract.MAXSUPPLY(&_VyraToken.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenSession) MINTERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.MINTERROLE(&_VyraToken.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) MINTERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.MINTERROLE(&_VyraToken.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "PAUSER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenSession) PAUSERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.PAUSERROLE(&_VyraToken.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) PAUSERROLE() ([32]byte, error) {
+	return _VyraToken.Contract.PAUSERROLE(&_VyraToken.CallOpts)
+}
+
+// TREASURYROLE is a free data retrieval call binding the contract method 0xd11a57ec.
+//
+// Solidity: function TREASURY_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) TREASURYROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "TREASURY_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TREASURYROLE is a free data retrieval call binding the contract method 0xd11a57ec.
+//
+// Solidity: function TREASURY_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenSession) TREASURYROLE() ([32]byte, error) {
+	return _VyraToken.Contract.TREASURYROLE(&_VyraToken.CallOpts)
+}
+
+// TREASURYROLE is a free data retrieval call binding the contract method 0xd11a57ec.
+//
+// Solidity: function TREASURY_ROLE() view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) TREASURYROLE() ([32]byte, error) {
+	return _VyraToken.Contract.TREASURYROLE(&_VyraToken.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_VyraToken *VyraTokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_VyraToken *VyraTokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _VyraToken.Contract.Allowance(&_VyraToken.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _VyraToken.Contract.Allowance(&_VyraToken.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_VyraToken *VyraTokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_VyraToken *VyraTokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _VyraToken.Contract.BalanceOf(&_VyraToken.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _VyraToken.Contract.BalanceOf(&_VyraToken.CallOpts, account)
+}
+
+// CollectedFees is a free data retrieval call binding the contract method 0x9003adfe.
+//
+// Solidity: function collectedFees() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) CollectedFees(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "collectedFees")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CollectedFees is a free data retrieval call binding the contract method 0x9003adfe.
+//
+// Solidity: function collectedFees() view returns(uint256)
+func (_VyraToken *VyraTokenSession) CollectedFees() (*big.Int, error) {
+	return _VyraToken.Contract.CollectedFees(&_VyraToken.CallOpts)
+}
+
+// CollectedFees is a free data retrieval call binding the contract method 0x9003adfe.
+//
+// Solidity: function collectedFees() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) CollectedFees() (*big.Int, error) {
+	return _VyraToken.Contract.CollectedFees(&_VyraToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_VyraToken *VyraTokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_VyraToken *VyraTokenSession) Decimals() (uint8, error) {
+	return _VyraToken.Contract.Decimals(&_VyraToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_VyraToken *VyraTokenCallerSession) Decimals() (uint8, error) {
+	return _VyraToken.Contract.Decimals(&_VyraToken.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraToken *VyraTokenCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraToken *VyraTokenSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraToken.Contract.GetRoleAdmin(&_VyraToken.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VyraToken *VyraTokenCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VyraToken.Contract.GetRoleAdmin(&_VyraToken.CallOpts, role)
+}
+
+// GetTransferFee is a free data retrieval call binding the contract method 0x56c1e949.
+//
+// Solidity: function getTransferFee(uint256 amount) view returns(uint256 fee)
+func (_VyraToken *VyraTokenCaller) GetTransferFee(opts *bind.CallOpts, amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "getTransferFee", amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTransferFee is a free data retrieval call binding the contract method 0x56c1e949.
+//
+// Solidity: function getTransferFee(uint256 amount) view returns(uint256 fee)
+func (_VyraToken *VyraTokenSession) GetTransferFee(amount *big.Int) (*big.Int, error) {
+	return _VyraToken.Contract.GetTransferFee(&_VyraToken.CallOpts, amount)
+}
+
+// GetTransferFee is a free data retrieval call binding the contract method 0x56c1e949.
+//
+// Solidity: function getTransferFee(uint256 amount) view returns(uint256 fee)
+func (_VyraToken *VyraTokenCallerSession) GetTransferFee(amount *big.Int) (*big.Int, error) {
+	return _VyraToken.Contract.GetTransferFee(&_VyraToken.CallOpts, amount)
+}
+
+// GetTreasuryFees is a free data retrieval call binding the contract method 0x45084aba.
+//
+// Solidity: function getTreasuryFees() view returns(uint256 treasuryFees)
+func (_VyraToken *VyraTokenCaller) GetTreasuryFees(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "getTreasuryFees")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTreasuryFees is a free data retrieval call binding the contract method 0x45084aba.
+//
+// Solidity: function getTreasuryFees() view returns(uint256 treasuryFees)
+func (_VyraToken *VyraTokenSession) GetTreasuryFees() (*big.Int, error) {
+	return _VyraToken.Contract.GetTreasuryFees(&_VyraToken.CallOpts)
+}
+
+// GetTreasuryFees is a free data retrieval call binding the contract method 0x45084aba.
+//
+// Solidity: function getTreasuryFees() view returns(uint256 treasuryFees)
+func (_VyraToken *VyraTokenCallerSession) GetTreasuryFees() (*big.Int, error) {
+	return _VyraToken.Contract.GetTreasuryFees(&_VyraToken.CallOpts)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraToken *VyraTokenCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraToken *VyraTokenSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraToken.Contract.HasRole(&_VyraToken.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VyraToken *VyraTokenCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VyraToken.Contract.HasRole(&_VyraToken.CallOpts, role, account)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VyraToken *VyraTokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VyraToken *VyraTokenSession) Name() (string, error) {
+	return _VyraToken.Contract.Name(&_VyraToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VyraToken *VyraTokenCallerSession) Name() (string, error) {
+	return _VyraToken.Contract.Name(&_VyraToken.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraToken *VyraTokenCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraToken *VyraTokenSession) Paused() (bool, error) {
+	return _VyraToken.Contract.Paused(&_VyraToken.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_VyraToken *VyraTokenCallerSession) Paused() (bool, error) {
+	return _VyraToken.Contract.Paused(&_VyraToken.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraToken *VyraTokenCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraToken *VyraTokenSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraToken.Contract.SupportsInterface(&_VyraToken.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VyraToken *VyraTokenCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VyraToken.Contract.SupportsInterface(&_VyraToken.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VyraToken *VyraTokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VyraToken *VyraTokenSession) Symbol() (string, error) {
+	return _VyraToken.Contract.Symbol(&_VyraToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VyraToken *VyraTokenCallerSession) Symbol() (string, error) {
+	return _VyraToken.Contract.Symbol(&_VyraToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_VyraToken *VyraTokenSession) TotalSupply() (*big.Int, error) {
+	return _VyraToken.Contract.TotalSupply(&_VyraToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _VyraToken.Contract.TotalSupply(&_VyraToken.CallOpts)
+}
+
+// TransferFeeRate is a free data retrieval call binding the contract method 0x351bf518.
+//
+// Solidity: function transferFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) TransferFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "transferFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferFeeRate is a free data retrieval call binding the contract method 0x351bf518.
+//
+// Solidity: function transferFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenSession) TransferFeeRate() (*big.Int, error) {
+	return _VyraToken.Contract.TransferFeeRate(&_VyraToken.CallOpts)
+}
+
+// TransferFeeRate is a free data retrieval call binding the contract method 0x351bf518.
+//
+// Solidity: function transferFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) TransferFeeRate() (*big.Int, error) {
+	return _VyraToken.Contract.TransferFeeRate(&_VyraToken.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_VyraToken *VyraTokenCaller) Treasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "treasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_VyraToken *VyraTokenSession) Treasury() (common.Address, error) {
+	return _VyraToken.Contract.Treasury(&_VyraToken.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_VyraToken *VyraTokenCallerSession) Treasury() (common.Address, error) {
+	return _VyraToken.Contract.Treasury(&_VyraToken.CallOpts)
+}
+
+// TreasuryFeeRate is a free data retrieval call binding the contract method 0x66601032.
+//
+// Solidity: function treasuryFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenCaller) TreasuryFeeRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _VyraToken.contract.Call(opts, &out, "treasuryFeeRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TreasuryFeeRate is a free data retrieval call binding the contract method 0x66601032.
+//
+// Solidity: function treasuryFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenSession) TreasuryFeeRate() (*big.Int, error) {
+	return _VyraToken.Contract.TreasuryFeeRate(&_VyraToken.CallOpts)
+}
+
+// TreasuryFeeRate is a free data retrieval call binding the contract method 0x66601032.
+//
+// Solidity: function treasuryFeeRate() view returns(uint256)
+func (_VyraToken *VyraTokenCallerSession) TreasuryFeeRate() (*big.Int, error) {
+	return _VyraToken.Contract.TreasuryFeeRate(&_VyraToken.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Approve(&_VyraToken.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Approve(&_VyraToken.TransactOpts, spender, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x7641e6f3.
+//
+// Solidity: function burn(uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenTransactor) Burn(opts *bind.TransactOpts, amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "burn", amount, reason)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x7641e6f3.
+//
+// Solidity: function burn(uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenSession) Burn(amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.Contract.Burn(&_VyraToken.TransactOpts, amount, reason)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x7641e6f3.
+//
+// Solidity: function burn(uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenTransactorSession) Burn(amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.Contract.Burn(&_VyraToken.TransactOpts, amount, reason)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x979430d2.
+//
+// Solidity: function burnFrom(address from, uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenTransactor) BurnFrom(opts *bind.TransactOpts, from common.Address, amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "burnFrom", from, amount, reason)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x979430d2.
+//
+// Solidity: function burnFrom(address from, uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenSession) BurnFrom(from common.Address, amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.Contract.BurnFrom(&_VyraToken.TransactOpts, from, amount, reason)
+}
+
+// BurnFrom is a paid mutator transaction binding the contract method 0x979430d2.
+//
+// Solidity: function burnFrom(address from, uint256 amount, string reason) returns()
+func (_VyraToken *VyraTokenTransactorSession) BurnFrom(from common.Address, amount *big.Int, reason string) (*types.Transaction, error) {
+	return _VyraToken.Contract.BurnFrom(&_VyraToken.TransactOpts, from, amount, reason)
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_VyraToken *VyraTokenTransactor) CollectFees(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "collectFees")
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_VyraToken *VyraTokenSession) CollectFees() (*types.Transaction, error) {
+	return _VyraToken.Contract.CollectFees(&_VyraToken.TransactOpts)
+}
+
+// CollectFees is a paid mutator transaction binding the contract method 0xc8796572.
+//
+// Solidity: function collectFees() returns()
+func (_VyraToken *VyraTokenTransactorSession) CollectFees() (*types.Transaction, error) {
+	return _VyraToken.Contract.CollectFees(&_VyraToken.TransactOpts)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.GrantRole(&_VyraToken.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.GrantRole(&_VyraToken.TransactOpts, role, account)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_VyraToken *VyraTokenTransactor) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "mint", to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_VyraToken *VyraTokenSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Mint(&_VyraToken.TransactOpts, to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (_VyraToken *VyraTokenTransactorSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Mint(&_VyraToken.TransactOpts, to, amount)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraToken *VyraTokenTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraToken *VyraTokenSession) Pause() (*types.Transaction, error) {
+	return _VyraToken.Contract.Pause(&_VyraToken.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_VyraToken *VyraTokenTransactorSession) Pause() (*types.Transaction, error) {
+	return _VyraToken.Contract.Pause(&_VyraToken.TransactOpts)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraToken *VyraTokenTransactor) RecoverToken(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "recoverToken", token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraToken *VyraTokenSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.RecoverToken(&_VyraToken.TransactOpts, token, amount)
+}
+
+// RecoverToken is a paid mutator transaction binding the contract method 0xb29a8140.
+//
+// Solidity: function recoverToken(address token, uint256 amount) returns()
+func (_VyraToken *VyraTokenTransactorSession) RecoverToken(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.RecoverToken(&_VyraToken.TransactOpts, token, amount)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraToken *VyraTokenTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraToken *VyraTokenSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.RenounceRole(&_VyraToken.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_VyraToken *VyraTokenTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.RenounceRole(&_VyraToken.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.RevokeRole(&_VyraToken.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VyraToken *VyraTokenTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.RevokeRole(&_VyraToken.TransactOpts, role, account)
+}
+
+// SetTransferFeeRate is a paid mutator transaction binding the contract method 0xc894e1e5.
+//
+// Solidity: function setTransferFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenTransactor) SetTransferFeeRate(opts *bind.TransactOpts, newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "setTransferFeeRate", newRate)
+}
+
+// SetTransferFeeRate is a paid mutator transaction binding the contract method 0xc894e1e5.
+//
+// Solidity: function setTransferFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenSession) SetTransferFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTransferFeeRate(&_VyraToken.TransactOpts, newRate)
+}
+
+// SetTransferFeeRate is a paid mutator transaction binding the contract method 0xc894e1e5.
+//
+// Solidity: function setTransferFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenTransactorSession) SetTransferFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTransferFeeRate(&_VyraToken.TransactOpts, newRate)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_VyraToken *VyraTokenTransactor) SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "setTreasury", newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_VyraToken *VyraTokenSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTreasury(&_VyraToken.TransactOpts, newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_VyraToken *VyraTokenTransactorSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTreasury(&_VyraToken.TransactOpts, newTreasury)
+}
+
+// SetTreasuryFeeRate is a paid mutator transaction binding the contract method 0xce43303c.
+//
+// Solidity: function setTreasuryFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenTransactor) SetTreasuryFeeRate(opts *bind.TransactOpts, newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "setTreasuryFeeRate", newRate)
+}
+
+// SetTreasuryFeeRate is a paid mutator transaction binding the contract method 0xce43303c.
+//
+// Solidity: function setTreasuryFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenSession) SetTreasuryFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTreasuryFeeRate(&_VyraToken.TransactOpts, newRate)
+}
+
+// SetTreasuryFeeRate is a paid mutator transaction binding the contract method 0xce43303c.
+//
+// Solidity: function setTreasuryFeeRate(uint256 newRate) returns()
+func (_VyraToken *VyraTokenTransactorSession) SetTreasuryFeeRate(newRate *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.SetTreasuryFeeRate(&_VyraToken.TransactOpts, newRate)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Transfer(&_VyraToken.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.Transfer(&_VyraToken.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.TransferFrom(&_VyraToken.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_VyraToken *VyraTokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _VyraToken.Contract.TransferFrom(&_VyraToken.TransactOpts, from, to, value)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraToken *VyraTokenTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VyraToken.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraToken *VyraTokenSession) Unpause() (*types.Transaction, error) {
+	return _VyraToken.Contract.Unpause(&_VyraToken.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_VyraToken *VyraTokenTransactorSession) Unpause() (*types.Transaction, error) {
+	return _VyraToken.Contract.Unpause(&_VyraToken.TransactOpts)
+}
+
+// VyraTokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the VyraToken contract.
+type VyraTokenApprovalIterator struct {
+	Event *VyraTokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenApproval represents a Approval event raised by the VyraToken contract.
+type VyraTokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_VyraToken *VyraTokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*VyraTokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenApprovalIterator{contract: _VyraToken.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_VyraToken *VyraTokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *VyraTokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenApproval)
+				if err := _VyraToken.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_VyraToken *VyraTokenFilterer) ParseApproval(log types.Log) (*VyraTokenApproval, error) {
+	event := new(VyraTokenApproval)
+	if err := _VyraToken.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenFeesCollectedIterator is returned from FilterFeesCollected and is used to iterate over the raw logs and unpacked data for FeesCollected events raised by the VyraToken contract.
+type VyraTokenFeesCollectedIterator struct {
+	Event *VyraTokenFeesCollected // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenFeesCollectedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenFeesCollected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenFeesCollected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenFeesCollectedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenFeesCollectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenFeesCollected represents a FeesCollected event raised by the VyraToken contract.
+type VyraTokenFeesCollected struct {
+	Amount   *big.Int
+	Treasury common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeesCollected is a free log retrieval operation binding the contract event 0x0c2a2f565c7774c59e49ef6b3c255329f4d254147e06e724d3a8569bb7bd21ad.
+//
+// Solidity: event FeesCollected(uint256 amount, address treasury)
+func (_VyraToken *VyraTokenFilterer) FilterFeesCollected(opts *bind.FilterOpts) (*VyraTokenFeesCollectedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "FeesCollected")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenFeesCollectedIterator{contract: _VyraToken.contract, event: "FeesCollected", logs: logs, sub: sub}, nil
+}
+
+// WatchFeesCollected is a free log subscription operation binding the contract event 0x0c2a2f565c7774c59e49ef6b3c255329f4d254147e06e724d3a8569bb7bd21ad.
+//
+// Solidity: event FeesCollected(uint256 amount, address treasury)
+func (_VyraToken *VyraTokenFilterer) WatchFeesCollected(opts *bind.WatchOpts, sink chan<- *VyraTokenFeesCollected) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "FeesCollected")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenFeesCollected)
+				if err := _VyraToken.contract.UnpackLog(event, "FeesCollected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeesCollected is a log parse operation binding the contract event 0x0c2a2f565c7774c59e49ef6b3c255329f4d254147e06e724d3a8569bb7bd21ad.
+//
+// Solidity: event FeesCollected(uint256 amount, address treasury)
+func (_VyraToken *VyraTokenFilterer) ParseFeesCollected(log types.Log) (*VyraTokenFeesCollected, error) {
+	event := new(VyraTokenFeesCollected)
+	if err := _VyraToken.contract.UnpackLog(event, "FeesCollected", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the VyraToken contract.
+type VyraTokenPausedIterator struct {
+	Event *VyraTokenPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenPaused represents a Paused event raised by the VyraToken contract.
+type VyraTokenPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraToken *VyraTokenFilterer) FilterPaused(opts *bind.FilterOpts) (*VyraTokenPausedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenPausedIterator{contract: _VyraToken.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraToken *VyraTokenFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *VyraTokenPaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenPaused)
+				if err := _VyraToken.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_VyraToken *VyraTokenFilterer) ParsePaused(log types.Log) (*VyraTokenPaused, error) {
+	event := new(VyraTokenPaused)
+	if err := _VyraToken.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the VyraToken contract.
+type VyraTokenRoleAdminChangedIterator struct {
+	Event *VyraTokenRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenRoleAdminChanged represents a RoleAdminChanged event raised by the VyraToken contract.
+type VyraTokenRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraToken *VyraTokenFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*VyraTokenRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenRoleAdminChangedIterator{contract: _VyraToken.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraToken *VyraTokenFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *VyraTokenRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenRoleAdminChanged)
+				if err := _VyraToken.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VyraToken *VyraTokenFilterer) ParseRoleAdminChanged(log types.Log) (*VyraTokenRoleAdminChanged, error) {
+	event := new(VyraTokenRoleAdminChanged)
+	if err := _VyraToken.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the VyraToken contract.
+type VyraTokenRoleGrantedIterator struct {
+	Event *VyraTokenRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenRoleGranted represents a RoleGranted event raised by the VyraToken contract.
+type VyraTokenRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraTokenRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenRoleGrantedIterator{contract: _VyraToken.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *VyraTokenRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenRoleGranted)
+				if err := _VyraToken.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) ParseRoleGranted(log types.Log) (*VyraTokenRoleGranted, error) {
+	event := new(VyraTokenRoleGranted)
+	if err := _VyraToken.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the VyraToken contract.
+type VyraTokenRoleRevokedIterator struct {
+	Event *VyraTokenRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenRoleRevoked represents a RoleRevoked event raised by the VyraToken contract.
+type VyraTokenRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VyraTokenRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenRoleRevokedIterator{contract: _VyraToken.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *VyraTokenRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenRoleRevoked)
+				if err := _VyraToken.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VyraToken *VyraTokenFilterer) ParseRoleRevoked(log types.Log) (*VyraTokenRoleRevoked, error) {
+	event := new(VyraTokenRoleRevoked)
+	if err := _VyraToken.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenTokensBurnedIterator is returned from FilterTokensBurned and is used to iterate over the raw logs and unpacked data for TokensBurned events raised by the VyraToken contract.
+type VyraTokenTokensBurnedIterator struct {
+	Event *VyraTokenTokensBurned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenTokensBurnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenTokensBurned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenTokensBurned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenTokensBurnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenTokensBurnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenTokensBurned represents a TokensBurned event raised by the VyraToken contract.
+type VyraTokenTokensBurned struct {
+	Account common.Address
+	Amount  *big.Int
+	Reason  string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensBurned is a free log retrieval operation binding the contract event 0xfad31924d655455395c87544c8aa1ffdb5a7505a22a3c2e03f28003b6556a75f.
+//
+// Solidity: event TokensBurned(address indexed account, uint256 amount, string reason)
+func (_VyraToken *VyraTokenFilterer) FilterTokensBurned(opts *bind.FilterOpts, account []common.Address) (*VyraTokenTokensBurnedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "TokensBurned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTokensBurnedIterator{contract: _VyraToken.contract, event: "TokensBurned", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensBurned is a free log subscription operation binding the contract event 0xfad31924d655455395c87544c8aa1ffdb5a7505a22a3c2e03f28003b6556a75f.
+//
+// Solidity: event TokensBurned(address indexed account, uint256 amount, string reason)
+func (_VyraToken *VyraTokenFilterer) WatchTokensBurned(opts *bind.WatchOpts, sink chan<- *VyraTokenTokensBurned, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "TokensBurned", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenTokensBurned)
+				if err := _VyraToken.contract.UnpackLog(event, "TokensBurned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensBurned is a log parse operation binding the contract event 0xfad31924d655455395c87544c8aa1ffdb5a7505a22a3c2e03f28003b6556a75f.
+//
+// Solidity: event TokensBurned(address indexed account, uint256 amount, string reason)
+func (_VyraToken *VyraTokenFilterer) ParseTokensBurned(log types.Log) (*VyraTokenTokensBurned, error) {
+	event := new(VyraTokenTokensBurned)
+	if err := _VyraToken.contract.UnpackLog(event, "TokensBurned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the VyraToken contract.
+type VyraTokenTransferIterator struct {
+	Event *VyraTokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenTransfer represents a Transfer event raised by the VyraToken contract.
+type VyraTokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_VyraToken *VyraTokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*VyraTokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTransferIterator{contract: _VyraToken.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_VyraToken *VyraTokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *VyraTokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenTransfer)
+				if err := _VyraToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_VyraToken *VyraTokenFilterer) ParseTransfer(log types.Log) (*VyraTokenTransfer, error) {
+	event := new(VyraTokenTransfer)
+	if err := _VyraToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenTransferFeeUpdatedIterator is returned from FilterTransferFeeUpdated and is used to iterate over the raw logs and unpacked data for TransferFeeUpdated events raised by the VyraToken contract.
+type VyraTokenTransferFeeUpdatedIterator struct {
+	Event *VyraTokenTransferFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenTransferFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenTransferFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenTransferFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenTransferFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenTransferFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenTransferFeeUpdated represents a TransferFeeUpdated event raised by the VyraToken contract.
+type VyraTokenTransferFeeUpdated struct {
+	OldRate *big.Int
+	NewRate *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferFeeUpdated is a free log retrieval operation binding the contract event 0x940334a9f5c76529ad9447ac490c2073b06d880209383a3d3e4b0ecab72a0d99.
+//
+// Solidity: event TransferFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) FilterTransferFeeUpdated(opts *bind.FilterOpts) (*VyraTokenTransferFeeUpdatedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "TransferFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTransferFeeUpdatedIterator{contract: _VyraToken.contract, event: "TransferFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferFeeUpdated is a free log subscription operation binding the contract event 0x940334a9f5c76529ad9447ac490c2073b06d880209383a3d3e4b0ecab72a0d99.
+//
+// Solidity: event TransferFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) WatchTransferFeeUpdated(opts *bind.WatchOpts, sink chan<- *VyraTokenTransferFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "TransferFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenTransferFeeUpdated)
+				if err := _VyraToken.contract.UnpackLog(event, "TransferFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferFeeUpdated is a log parse operation binding the contract event 0x940334a9f5c76529ad9447ac490c2073b06d880209383a3d3e4b0ecab72a0d99.
+//
+// Solidity: event TransferFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) ParseTransferFeeUpdated(log types.Log) (*VyraTokenTransferFeeUpdated, error) {
+	event := new(VyraTokenTransferFeeUpdated)
+	if err := _VyraToken.contract.UnpackLog(event, "TransferFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenTreasuryFeeUpdatedIterator is returned from FilterTreasuryFeeUpdated and is used to iterate over the raw logs and unpacked data for TreasuryFeeUpdated events raised by the VyraToken contract.
+type VyraTokenTreasuryFeeUpdatedIterator struct {
+	Event *VyraTokenTreasuryFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenTreasuryFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenTreasuryFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenTreasuryFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenTreasuryFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenTreasuryFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenTreasuryFeeUpdated represents a TreasuryFeeUpdated event raised by the VyraToken contract.
+type VyraTokenTreasuryFeeUpdated struct {
+	OldRate *big.Int
+	NewRate *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTreasuryFeeUpdated is a free log retrieval operation binding the contract event 0xa3548295fa266701fb2455011980392d0693eeff50c36c961fd1e6a8a8403429.
+//
+// Solidity: event TreasuryFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) FilterTreasuryFeeUpdated(opts *bind.FilterOpts) (*VyraTokenTreasuryFeeUpdatedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "TreasuryFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTreasuryFeeUpdatedIterator{contract: _VyraToken.contract, event: "TreasuryFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTreasuryFeeUpdated is a free log subscription operation binding the contract event 0xa3548295fa266701fb2455011980392d0693eeff50c36c961fd1e6a8a8403429.
+//
+// Solidity: event TreasuryFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) WatchTreasuryFeeUpdated(opts *bind.WatchOpts, sink chan<- *VyraTokenTreasuryFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "TreasuryFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenTreasuryFeeUpdated)
+				if err := _VyraToken.contract.UnpackLog(event, "TreasuryFeeUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTreasuryFeeUpdated is a log parse operation binding the contract event 0xa3548295fa266701fb2455011980392d0693eeff50c36c961fd1e6a8a8403429.
+//
+// Solidity: event TreasuryFeeUpdated(uint256 oldRate, uint256 newRate)
+func (_VyraToken *VyraTokenFilterer) ParseTreasuryFeeUpdated(log types.Log) (*VyraTokenTreasuryFeeUpdated, error) {
+	event := new(VyraTokenTreasuryFeeUpdated)
+	if err := _VyraToken.contract.UnpackLog(event, "TreasuryFeeUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenTreasuryUpdatedIterator is returned from FilterTreasuryUpdated and is used to iterate over the raw logs and unpacked data for TreasuryUpdated events raised by the VyraToken contract.
+type VyraTokenTreasuryUpdatedIterator struct {
+	Event *VyraTokenTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenTreasuryUpdated represents a TreasuryUpdated event raised by the VyraToken contract.
+type VyraTokenTreasuryUpdated struct {
+	OldTreasury common.Address
+	NewTreasury common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTreasuryUpdated is a free log retrieval operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraToken *VyraTokenFilterer) FilterTreasuryUpdated(opts *bind.FilterOpts) (*VyraTokenTreasuryUpdatedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "TreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenTreasuryUpdatedIterator{contract: _VyraToken.contract, event: "TreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTreasuryUpdated is a free log subscription operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraToken *VyraTokenFilterer) WatchTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *VyraTokenTreasuryUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "TreasuryUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenTreasuryUpdated)
+				if err := _VyraToken.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTreasuryUpdated is a log parse operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address oldTreasury, address newTreasury)
+func (_VyraToken *VyraTokenFilterer) ParseTreasuryUpdated(log types.Log) (*VyraTokenTreasuryUpdated, error) {
+	event := new(VyraTokenTreasuryUpdated)
+	if err := _VyraToken.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VyraTokenUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the VyraToken contract.
+type VyraTokenUnpausedIterator struct {
+	Event *VyraTokenUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VyraTokenUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VyraTokenUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VyraTokenUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VyraTokenUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VyraTokenUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VyraTokenUnpaused represents a Unpaused event raised by the VyraToken contract.
+type VyraTokenUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraToken *VyraTokenFilterer) FilterUnpaused(opts *bind.FilterOpts) (*VyraTokenUnpausedIterator, error) {
+
+	logs, sub, err := _VyraToken.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &VyraTokenUnpausedIterator{contract: _VyraToken.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraToken *VyraTokenFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *VyraTokenUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _VyraToken.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VyraTokenUnpaused)
+				if err := _VyraToken.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_VyraToken *VyraTokenFilterer) ParseUnpaused(log types.Log) (*VyraTokenUnpaused, error) {
+	event := new(VyraTokenUnpaused)
+	if err := _VyraToken.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -1,10 +1,25 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/middleware"
+	"vyra-backend/internal/paylink"
+	"vyra-backend/internal/repository"
 	"vyra-backend/internal/services"
+	"vyra-backend/internal/services/history"
+	"vyra-backend/internal/services/merchant"
+	"vyra-backend/internal/services/payment"
+	"vyra-backend/internal/services/wallet"
+	"vyra-backend/internal/version"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -15,8 +30,7 @@ type Handler struct {
 	services *services.Services
 }
 
-func New(cfg *config.Config) *Handler {
-	services := services.New(cfg)
+func New(cfg *config.Config, services *services.Services) *Handler {
 	return &Handler{
 		config:   cfg,
 		services: services,
@@ -25,52 +39,171 @@ func New(cfg *config.Config) *Handler {
 
 // HealthCheck returns the health status of the service
 func (h *Handler) HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"status":  "healthy",
 		"service": "vyra-backend",
-		"version": "0.1.0",
+		"version": version.Get(),
+	}
+
+	// The schema version is informational; an unreachable database is not
+	// reported as unhealthy here
+	if schema, err := h.services.Migrator.Version(c.Request.Context()); err == nil {
+		response["schemaVersion"] = schema
+	} else {
+		logger(c).WithError(err).Warn("Failed to read schema version")
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// LiveCheck reports that the process is up, without checking dependencies
+func (h *Handler) LiveCheck(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "ok",
+		"service": "vyra-backend",
+		"version": version.Get(),
+	})
+}
+
+// ReadyCheck reports whether every critical dependency is usable, with the
+// outcome of each check
+func (h *Handler) ReadyCheck(c *gin.Context) {
+	report := h.services.Health.Ready(c.Request.Context())
+	if !report.Ready() {
+		logger(c).WithField("checks", report.Checks).Warn("Readiness check failed")
+		c.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// GetAuthNonce issues a nonce for a Sign-In with Ethereum message, and the
+// message itself when an address is given
+func (h *Handler) GetAuthNonce(c *gin.Context) {
+	challenge, err := h.services.Auth.Nonce(c.Request.Context(), c.Query("address"))
+	if err != nil {
+		apierror.Respond(c, err, "Failed to issue nonce")
+		return
+	}
+
+	c.JSON(http.StatusOK, challenge)
+}
+
+// Login exchanges a signed Sign-In with Ethereum message for an access token
+// and a refresh token
+func (h *Handler) Login(c *gin.Context) {
+	var req struct {
+		Message   string `json:"message" binding:"required"`
+		Signature string `json:"signature" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	session, err := h.services.Auth.Login(c.Request.Context(), req.Message, req.Signature)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to sign in")
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// RefreshSession exchanges a refresh token for a new access token and
+// refresh token
+func (h *Handler) RefreshSession(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	session, err := h.services.Auth.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to refresh session")
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// Logout revokes a refresh token and every token from the same sign-in
+func (h *Handler) Logout(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if err := h.services.Auth.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		apierror.Respond(c, err, "Failed to sign out")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Signed out successfully",
 	})
 }
 
 // ConnectWallet handles wallet connection
 func (h *Handler) ConnectWallet(c *gin.Context) {
 	var req struct {
-		Type         string `json:"type" binding:"required"`
-		PrivateKey   string `json:"privateKey,omitempty"`
-		Mnemonic     string `json:"mnemonic,omitempty"`
-		DerivationIndex int `json:"derivationIndex,omitempty"`
+		Type            string `json:"type" binding:"required"`
+		PrivateKey      string `json:"privateKey,omitempty"`
+		Mnemonic        string `json:"mnemonic,omitempty"`
+		Passphrase      string `json:"passphrase,omitempty"`
+		DerivationIndex int    `json:"derivationIndex,omitempty"`
+		DerivationCount int    `json:"derivationCount,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	address, err := h.services.Wallet.Connect(req.Type, req.PrivateKey, req.Mnemonic, req.DerivationIndex)
+	address, err := h.services.Wallet.Connect(req.Type, req.PrivateKey, req.Mnemonic, req.Passphrase, req.DerivationIndex)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to connect wallet")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to connect wallet"})
+		apierror.Respond(c, err, "Failed to connect wallet")
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"address": address,
 		"message": "Wallet connected successfully",
-	})
+	}
+
+	// Account discovery: derive a range of addresses from the mnemonic
+	if req.Type == "mnemonic" && req.DerivationCount > 1 {
+		accounts, err := h.services.Wallet.DiscoverAccounts(req.Mnemonic, req.Passphrase, req.DerivationIndex, req.DerivationCount)
+		if err != nil {
+			apierror.Abort(c, apierror.BadRequest(err.Error()))
+			return
+		}
+		response["accounts"] = accounts
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetBalance returns the ETH balance for an address
 func (h *Handler) GetBalance(c *gin.Context) {
 	address := c.Param("address")
 	if address == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Address is required"})
+		apierror.Abort(c, apierror.BadRequest("Address is required"))
 		return
 	}
 
-	balance, err := h.services.Wallet.GetBalance(address)
+	balance, err := h.services.Wallet.GetBalance(c.Request.Context(), address)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get balance")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get balance"})
+		apierror.Respond(c, err, "Failed to get balance")
 		return
 	}
 
@@ -84,20 +217,23 @@ func (h *Handler) GetBalance(c *gin.Context) {
 func (h *Handler) GetVyraBalance(c *gin.Context) {
 	address := c.Param("address")
 	if address == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Address is required"})
+		apierror.Abort(c, apierror.BadRequest("Address is required"))
 		return
 	}
 
-	balance, err := h.services.Wallet.GetVyraBalance(address)
+	balance, err := h.services.Wallet.GetVyraBalance(c.Request.Context(), address)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get VYR balance")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get VYR balance"})
+		apierror.Respond(c, err, "Failed to get VYR balance")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"address": address,
-		"vyraBalance": balance,
+		"address":         address,
+		"vyraBalance":     balance.Balance,
+		"decimals":        balance.Decimals,
+		"transferFee":     balance.TransferFee,
+		"transferFeeRate": balance.TransferFeeRate,
+		"treasuryFees":    balance.TreasuryFees,
 	})
 }
 
@@ -105,155 +241,680 @@ func (h *Handler) GetVyraBalance(c *gin.Context) {
 func (h *Handler) SendPayment(c *gin.Context) {
 	address := c.Param("address")
 	if address == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Address is required"})
+		apierror.Abort(c, apierror.BadRequest("Address is required"))
 		return
 	}
 
 	var req struct {
-		To          string `json:"to" binding:"required"`
-		Amount      string `json:"amount" binding:"required"`
-		Description string `json:"description,omitempty"`
+		To            string                        `json:"to" binding:"required"`
+		Amount        string                        `json:"amount" binding:"required"`
+		Description   string                        `json:"description,omitempty"`
+		SignedTx      string                        `json:"signedTx,omitempty"`
+		Authorization *wallet.TransferAuthorization `json:"authorization,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	txHash, err := h.services.Wallet.SendPayment(address, req.To, req.Amount, req.Description)
+	result, err := h.services.Wallet.SendPayment(c.Request.Context(), wallet.SendRequest{
+		From:          address,
+		To:            req.To,
+		Amount:        req.Amount,
+		Description:   req.Description,
+		SignedTx:      req.SignedTx,
+		Authorization: req.Authorization,
+	})
 	if err != nil {
-		logrus.WithError(err).Error("Failed to send payment")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send payment"})
+		apierror.Respond(c, err, "Failed to send payment")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"txHash": txHash,
+		"txHash":  result.TxHash,
+		"quote":   result.Quote,
 		"message": "Payment sent successfully",
 	})
 }
 
-// CreateInvoice creates a payment invoice
+// QuoteTransfer returns the fee and net amount of a VYR transfer, along with
+// the EIP-712 authorization the sender can sign for a relayed send
+func (h *Handler) QuoteTransfer(c *gin.Context) {
+	address := c.Param("address")
+	to := c.Query("to")
+	amount := c.Query("amount")
+	if to == "" || amount == "" {
+		apierror.Abort(c, apierror.BadRequest("to and amount are required"))
+		return
+	}
+
+	quote, err := h.services.Wallet.QuoteTransfer(c.Request.Context(), address, to, amount)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to quote transfer")
+		return
+	}
+
+	typedData, err := h.services.Wallet.AuthorizationTypedData(c.Request.Context(), address, to, amount)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to quote transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quote":         quote,
+		"authorization": typedData,
+	})
+}
+
+// GetTransactions lists an address's on-chain activity, as a JSON page or
+// as a CSV export of everything matching the filters
+func (h *Handler) GetTransactions(c *gin.Context) {
+	address := c.Param("address")
+
+	filter := history.Filter{
+		Types:    splitList(c.Query("type")),
+		Statuses: splitList(c.Query("status")),
+		Cursor:   c.Query("cursor"),
+	}
+
+	var err error
+	if filter.From, err = parseDate(c.Query("from"), false); err != nil {
+		apierror.Abort(c, apierror.BadRequest("Invalid from date"))
+		return
+	}
+	if filter.To, err = parseDate(c.Query("to"), true); err != nil {
+		apierror.Abort(c, apierror.BadRequest("Invalid to date"))
+		return
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit < 1 {
+			apierror.Abort(c, apierror.BadRequest("Invalid limit"))
+			return
+		}
+	}
+
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		page, err := h.services.History.List(c.Request.Context(), address, filter)
+		if err != nil {
+			apierror.Respond(c, err, "Failed to list transactions")
+			return
+		}
+
+		c.JSON(http.StatusOK, page)
+
+	case "csv":
+		c.Header("Content-Type", "text/csv")
+		c.Header("Content-Disposition", `attachment; filename="vyra-transactions.csv"`)
+
+		err := h.services.History.ExportCSV(c.Request.Context(), c.Writer, address, filter)
+		if err == nil {
+			return
+		}
+		if c.Writer.Written() {
+			logger(c).WithError(err).Error("Transaction export interrupted")
+			return
+		}
+
+		c.Header("Content-Type", "")
+		c.Header("Content-Disposition", "")
+		apierror.Respond(c, err, "Failed to export transactions")
+
+	default:
+		apierror.Abort(c, apierror.BadRequest("format must be json or csv"))
+	}
+}
+
+// splitList parses a comma-separated query value.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseDate accepts an RFC 3339 timestamp or a YYYY-MM-DD date. A bare date
+// used as an upper bound covers the whole day.
+func parseDate(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// CreateInvoice creates a payment invoice on VyraPOS. Without a merchant
+// signature it returns the message to sign, with a signature the
+// createInvoice transaction to sign, and with a signed transaction the
+// created invoice
 func (h *Handler) CreateInvoice(c *gin.Context) {
 	var req struct {
+		Merchant    string `json:"merchant" binding:"required"`
 		Amount      string `json:"amount" binding:"required"`
 		Description string `json:"description" binding:"required"`
 		Expiry      int64  `json:"expiry,omitempty"`
+		Signature   string `json:"signature,omitempty"`
+		SignedTx    string `json:"signedTx,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	invoiceID, err := h.services.Payment.CreateInvoice(req.Amount, req.Description, req.Expiry)
-	if err != nil {
-		logrus.WithError(err).Error("Failed to create invoice")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create invoice"})
+	if !authorizeAddress(c, req.Merchant) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"invoiceId": invoiceID,
-		"message": "Invoice created successfully",
+	result, err := h.services.Payment.CreateInvoice(c.Request.Context(), payment.InvoiceRequest{
+		Merchant:    req.Merchant,
+		Amount:      req.Amount,
+		Description: req.Description,
+		Expiry:      req.Expiry,
+		Signature:   req.Signature,
+		SignedTx:    req.SignedTx,
 	})
+	if err != nil {
+		apierror.Respond(c, err, "Failed to create invoice")
+		return
+	}
+
+	response := gin.H{
+		"status":        result.Status,
+		"authorization": result.Authorization,
+	}
+	switch result.Status {
+	case payment.InvoiceCreated:
+		response = gin.H{
+			"status":    result.Status,
+			"invoiceId": result.InvoiceID,
+			"txHash":    result.TxHash,
+			"invoice":   result.Invoice,
+			"message":   "Invoice created successfully",
+		}
+	case payment.InvoiceTransactionRequired:
+		response["transaction"] = result.Transaction
+		response["message"] = "Sign the transaction and resubmit it as signedTx"
+	default:
+		response["message"] = "Sign the authorization hash and resubmit it as signature"
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetPayment retrieves payment information
 func (h *Handler) GetPayment(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Payment ID is required"})
+		apierror.Abort(c, apierror.BadRequest("Payment ID is required"))
 		return
 	}
 
-	payment, err := h.services.Payment.GetPayment(paymentID)
+	payment, err := h.services.Payment.GetPayment(c.Request.Context(), paymentID)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get payment")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get payment"})
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Abort(c, apierror.NotFound("Payment not found"))
+			return
+		}
+		apierror.Respond(c, err, "Failed to get payment")
 		return
 	}
 
 	c.JSON(http.StatusOK, payment)
 }
 
-// ProcessPayment processes a payment
+// GetPaymentLink returns the vyra:// deep link and EIP-681 URI for paying an
+// invoice
+func (h *Handler) GetPaymentLink(c *gin.Context) {
+	request, ok := h.paymentRequest(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		InvoiceID string `json:"invoiceId"`
+		*paylink.Links
+	}{request.InvoiceID, paylink.NewLinks(request)})
+}
+
+// ResolvePaymentLink checks a scanned vyra:// payment link against the
+// invoice it names and returns the invoice's current payment request
+func (h *Handler) ResolvePaymentLink(c *gin.Context) {
+	var req struct {
+		URI string `json:"uri" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	request, err := h.services.Payment.ResolvePaymentLink(c.Request.Context(), req.URI)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to resolve payment link")
+		return
+	}
+
+	c.JSON(http.StatusOK, struct {
+		InvoiceID string `json:"invoiceId"`
+		Amount    string `json:"amount"`
+		ChainID   int64  `json:"chainId"`
+		POS       string `json:"pos"`
+		*paylink.Links
+	}{
+		request.InvoiceID,
+		chain.FormatUnits(request.Amount, chain.VYRDecimals),
+		request.ChainID,
+		request.POS.Hex(),
+		paylink.NewLinks(request),
+	})
+}
+
+// GetPaymentQR renders an invoice's payment link as a PNG or SVG QR code
+func (h *Handler) GetPaymentQR(c *gin.Context) {
+	request, ok := h.paymentRequest(c)
+	if !ok {
+		return
+	}
+
+	size := paylink.DefaultQRSize
+	if value := c.Query("size"); value != "" {
+		var err error
+		if size, err = strconv.Atoi(value); err != nil || size < paylink.MinQRSize || size > paylink.MaxQRSize {
+			apierror.Abort(c, apierror.BadRequest("size must be between 64 and 1024"))
+			return
+		}
+	}
+
+	var uri string
+	switch c.DefaultQuery("scheme", paylink.SchemeVyra) {
+	case paylink.SchemeVyra:
+		uri = paylink.VyraURI(request)
+	case paylink.SchemeEthereum:
+		uri = paylink.EIP681(request)
+	default:
+		apierror.Abort(c, apierror.BadRequest("scheme must be vyra or ethereum"))
+		return
+	}
+
+	var image []byte
+	var contentType string
+	var err error
+	switch c.DefaultQuery("format", "png") {
+	case "png":
+		image, err = paylink.PNG(uri, size)
+		contentType = "image/png"
+	case "svg":
+		image, err = paylink.SVG(uri, size)
+		contentType = "image/svg+xml"
+	default:
+		apierror.Abort(c, apierror.BadRequest("format must be png or svg"))
+		return
+	}
+	if err != nil {
+		apierror.Respond(c, err, "Failed to render QR code")
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, image)
+}
+
+// paymentRequest looks up the payable invoice named by the id parameter,
+// responding with an error if there is none.
+func (h *Handler) paymentRequest(c *gin.Context) (*paylink.Invoice, bool) {
+	invoiceID := c.Param("id")
+	if invoiceID == "" {
+		apierror.Abort(c, apierror.BadRequest("Invoice ID is required"))
+		return nil, false
+	}
+
+	request, err := h.services.Payment.PaymentRequest(c.Request.Context(), invoiceID)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to get payment request")
+		return nil, false
+	}
+	return request, true
+}
+
+// ProcessPayment pays an invoice through the relayer. Without a customer
+// signature it returns the fees and the message to sign
 func (h *Handler) ProcessPayment(c *gin.Context) {
+	invoiceID := c.Param("id")
+	if invoiceID == "" {
+		apierror.Abort(c, apierror.BadRequest("Invoice ID is required"))
+		return
+	}
+
+	var req struct {
+		Customer  string `json:"customer" binding:"required"`
+		Signature string `json:"signature,omitempty"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	result, err := h.services.Payment.ProcessPayment(c.Request.Context(), invoiceID, req.Customer, req.Signature)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to process payment")
+		return
+	}
+
+	message := "Payment processed successfully"
+	if result.Status == payment.PaymentSignatureRequired {
+		message = "Sign the authorization hash and resubmit it as signature"
+	}
+
+	c.JSON(http.StatusOK, struct {
+		*payment.PaymentResult
+		Message string `json:"message"`
+	}{result, message})
+}
+
+// ProcessSplitPayment pays several recipients from one customer through the
+// relayer. The path ID is the customer's reference for the payment; a retry
+// with the same reference returns the original payment
+func (h *Handler) ProcessSplitPayment(c *gin.Context) {
+	reference := c.Param("id")
+	if reference == "" {
+		apierror.Abort(c, apierror.BadRequest("Payment reference is required"))
+		return
+	}
+
+	var req struct {
+		Customer   string                   `json:"customer" binding:"required"`
+		Amount     string                   `json:"amount" binding:"required"`
+		Recipients []payment.SplitRecipient `json:"recipients" binding:"required"`
+		Signature  string                   `json:"signature,omitempty"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if !authorizeAddress(c, req.Customer) {
+		return
+	}
+
+	result, err := h.services.Payment.ProcessSplitPayment(c.Request.Context(), payment.SplitRequest{
+		Reference:  reference,
+		Customer:   req.Customer,
+		Amount:     req.Amount,
+		Recipients: req.Recipients,
+		Signature:  req.Signature,
+	})
+	if err != nil {
+		apierror.Respond(c, err, "Failed to process split payment")
+		return
+	}
+
+	status, message := http.StatusOK, "Split payment processed successfully"
+	switch result.Status {
+	case payment.PaymentSignatureRequired:
+		message = "Sign the authorization hash and resubmit it as signature"
+	case repository.StatusPending:
+		status, message = http.StatusAccepted, "Split payment submitted, awaiting confirmation"
+	case repository.StatusFailed:
+		message = "Split payment failed"
+	}
+
+	c.JSON(status, struct {
+		*payment.SplitResult
+		Message string `json:"message"`
+	}{result, message})
+}
+
+// RefundPayment refunds a payment through the relayer. The Idempotency-Key
+// header is required; a retry with the same key returns the original refund
+func (h *Handler) RefundPayment(c *gin.Context) {
 	paymentID := c.Param("id")
 	if paymentID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Payment ID is required"})
+		apierror.Abort(c, apierror.BadRequest("Payment ID is required"))
+		return
+	}
+
+	key := c.GetHeader("Idempotency-Key")
+	if key == "" {
+		apierror.Abort(c, apierror.BadRequest("Idempotency-Key header is required"))
 		return
 	}
 
 	var req struct {
-		Customer string `json:"customer" binding:"required"`
+		Amount    string `json:"amount,omitempty"`
+		Reason    string `json:"reason,omitempty"`
+		Signature string `json:"signature,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	txHash, err := h.services.Payment.ProcessPayment(paymentID, req.Customer)
+	result, err := h.services.Payment.RefundPayment(c.Request.Context(), payment.RefundRequest{
+		PaymentID:      paymentID,
+		Merchant:       middleware.Subject(c),
+		Amount:         req.Amount,
+		Reason:         req.Reason,
+		IdempotencyKey: key,
+		Signature:      req.Signature,
+	})
 	if err != nil {
-		logrus.WithError(err).Error("Failed to process payment")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process payment"})
+		apierror.Respond(c, err, "Failed to refund payment")
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"txHash": txHash,
-		"message": "Payment processed successfully",
-	})
+	status, message := http.StatusOK, "Payment refunded successfully"
+	switch result.Status {
+	case payment.PaymentSignatureRequired:
+		message = "Sign the authorization and resubmit it as signature"
+	case repository.StatusPending:
+		status, message = http.StatusAccepted, "Refund submitted, awaiting confirmation"
+	case repository.StatusFailed:
+		message = "Refund failed"
+	}
+
+	c.JSON(status, struct {
+		*payment.RefundResult
+		Message string `json:"message"`
+	}{result, message})
+}
+
+// GetRefunds lists a payment's refunds
+func (h *Handler) GetRefunds(c *gin.Context) {
+	paymentID := c.Param("id")
+	if paymentID == "" {
+		apierror.Abort(c, apierror.BadRequest("Payment ID is required"))
+		return
+	}
+
+	refunds, err := h.services.Payment.ListRefunds(c.Request.Context(), paymentID)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to get refunds")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"refunds": refunds})
+}
+
+// CancelInvoice cancels an unpaid invoice
+func (h *Handler) CancelInvoice(c *gin.Context) {
+	invoiceID := c.Param("id")
+	if invoiceID == "" {
+		apierror.Abort(c, apierror.BadRequest("Invoice ID is required"))
+		return
+	}
+
+	invoice, err := h.services.Payment.CancelInvoice(c.Request.Context(), invoiceID, middleware.Subject(c))
+	if err != nil {
+		apierror.Respond(c, err, "Failed to cancel invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+// GetInvoiceTransitions returns an invoice's status history
+func (h *Handler) GetInvoiceTransitions(c *gin.Context) {
+	invoiceID := c.Param("id")
+	if invoiceID == "" {
+		apierror.Abort(c, apierror.BadRequest("Invoice ID is required"))
+		return
+	}
+
+	transitions, err := h.services.Payment.InvoiceHistory(c.Request.Context(), invoiceID)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to get invoice transitions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transitions": transitions})
+}
+
+// GetMerchantStats returns a merchant's sales, fees, refund rate and invoice
+// counts, optionally between from and to
+func (h *Handler) GetMerchantStats(c *gin.Context) {
+	r, ok := merchantRange(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.services.Merchant.Stats(c.Request.Context(), c.Param("address"), r)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to get merchant stats")
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetMerchantVolume returns a merchant's payment volume per day or week
+func (h *Handler) GetMerchantVolume(c *gin.Context) {
+	r, ok := merchantRange(c)
+	if !ok {
+		return
+	}
+
+	volume, err := h.services.Merchant.Volume(c.Request.Context(), c.Param("address"), c.DefaultQuery("interval", "day"), r)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to get merchant volume")
+		return
+	}
+
+	c.JSON(http.StatusOK, volume)
+}
+
+// GetSettlementReport downloads a merchant's settlements between from and to
+// as CSV
+func (h *Handler) GetSettlementReport(c *gin.Context) {
+	r, ok := merchantRange(c)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="vyra-settlements.csv"`)
+
+	err := h.services.Merchant.ExportSettlements(c.Request.Context(), c.Writer, c.Param("address"), r)
+	if err == nil {
+		return
+	}
+	if c.Writer.Written() {
+		logger(c).WithError(err).Error("Settlement export interrupted")
+		return
+	}
+
+	c.Header("Content-Type", "")
+	c.Header("Content-Disposition", "")
+	apierror.Respond(c, err, "Failed to export settlements")
+}
+
+// merchantRange parses the from and to query parameters, responding with an
+// error if either is malformed.
+func merchantRange(c *gin.Context) (merchant.Range, bool) {
+	var r merchant.Range
+	var err error
+	if r.From, err = parseDate(c.Query("from"), false); err != nil {
+		apierror.Abort(c, apierror.BadRequest("Invalid from date"))
+		return r, false
+	}
+	if r.To, err = parseDate(c.Query("to"), true); err != nil {
+		apierror.Abort(c, apierror.BadRequest("Invalid to date"))
+		return r, false
+	}
+	return r, true
 }
 
 // Deposit handles bridge deposits
 func (h *Handler) Deposit(c *gin.Context) {
 	var req struct {
-		Amount string `json:"amount" binding:"required"`
+		User     string `json:"user" binding:"required"`
+		Amount   string `json:"amount" binding:"required"`
+		L1TxHash string `json:"l1TxHash,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	depositID, err := h.services.Bridge.Deposit(req.Amount)
+	if !authorizeAddress(c, req.User) {
+		return
+	}
+
+	depositID, err := h.services.Bridge.Deposit(c.Request.Context(), req.User, req.Amount, req.L1TxHash)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to deposit")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to deposit"})
+		apierror.Respond(c, err, "Failed to deposit")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"depositId": depositID,
-		"message": "Deposit initiated successfully",
+		"message":   "Deposit initiated successfully",
 	})
 }
 
 // Withdraw handles bridge withdrawals
 func (h *Handler) Withdraw(c *gin.Context) {
 	var req struct {
-		Amount    string `json:"amount" binding:"required"`
-		L2TxHash  string `json:"l2TxHash" binding:"required"`
+		User       string   `json:"user" binding:"required"`
+		Amount     string   `json:"amount" binding:"required"`
+		L2TxHash   string   `json:"l2TxHash" binding:"required"`
 		Signatures []string `json:"signatures" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
 		return
 	}
 
-	withdrawalID, err := h.services.Bridge.Withdraw(req.Amount, req.L2TxHash, req.Signatures)
+	if !authorizeAddress(c, req.User) {
+		return
+	}
+
+	withdrawalID, err := h.services.Bridge.Withdraw(c.Request.Context(), req.User, req.Amount, req.L2TxHash, req.Signatures)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to withdraw")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to withdraw"})
+		apierror.Respond(c, err, "Failed to withdraw")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"withdrawalId": withdrawalID,
-		"message": "Withdrawal initiated successfully",
+		"message":      "Withdrawal initiated successfully",
 	})
 }
 
@@ -261,14 +922,17 @@ func (h *Handler) Withdraw(c *gin.Context) {
 func (h *Handler) GetBridgeStatus(c *gin.Context) {
 	txID := c.Param("id")
 	if txID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Transaction ID is required"})
+		apierror.Abort(c, apierror.BadRequest("Transaction ID is required"))
 		return
 	}
 
-	status, err := h.services.Bridge.GetStatus(txID)
+	status, err := h.services.Bridge.GetStatus(c.Request.Context(), txID)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to get bridge status")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get bridge status"})
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Abort(c, apierror.NotFound("Bridge transaction not found"))
+			return
+		}
+		apierror.Respond(c, err, "Failed to get bridge status")
 		return
 	}
 
@@ -278,33 +942,72 @@ func (h *Handler) GetBridgeStatus(c *gin.Context) {
 // CreateSessionKey creates a session key for gasless transactions
 func (h *Handler) CreateSessionKey(c *gin.Context) {
 	var req struct {
-		Expiry int64 `json:"expiry" binding:"required"`
+		User   string `json:"user" binding:"required"`
+		Expiry int64  `json:"expiry" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if !authorizeAddress(c, req.User) {
 		return
 	}
 
-	sessionKey, err := h.services.Paymaster.CreateSessionKey(req.Expiry)
+	sessionKey, err := h.services.Paymaster.CreateSessionKey(c.Request.Context(), req.User, req.Expiry)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to create session key")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create session key"})
+		apierror.Respond(c, err, "Failed to create session key")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"sessionKey": sessionKey,
-		"message": "Session key created successfully",
+		"sessionKey": sessionKey.Address,
+		"privateKey": sessionKey.PrivateKey,
+		"expiry":     sessionKey.Expiry,
+		"message":    "Session key created successfully",
 	})
 }
 
+// GetSessionKey returns a user's active session key
+func (h *Handler) GetSessionKey(c *gin.Context) {
+	user := c.Param("user")
+
+	sessionKey, err := h.services.Paymaster.GetSessionKey(c.Request.Context(), user)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Abort(c, apierror.NotFound("No active session key"))
+			return
+		}
+		apierror.Respond(c, err, "Failed to get session key")
+		return
+	}
+
+	c.JSON(http.StatusOK, sessionKey)
+}
+
 // RevokeSessionKey revokes a session key
 func (h *Handler) RevokeSessionKey(c *gin.Context) {
-	err := h.services.Paymaster.RevokeSessionKey()
+	var req struct {
+		User string `json:"user" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if !authorizeAddress(c, req.User) {
+		return
+	}
+
+	err := h.services.Paymaster.RevokeSessionKey(c.Request.Context(), req.User)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to revoke session key")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke session key"})
+		if errors.Is(err, repository.ErrNotFound) {
+			apierror.Abort(c, apierror.NotFound("No active session key"))
+			return
+		}
+		apierror.Respond(c, err, "Failed to revoke session key")
 		return
 	}
 
@@ -316,25 +1019,241 @@ func (h *Handler) RevokeSessionKey(c *gin.Context) {
 // SponsorGas sponsors gas for a transaction
 func (h *Handler) SponsorGas(c *gin.Context) {
 	var req struct {
-		User       string `json:"user" binding:"required"`
-		GasUsed    string `json:"gasUsed" binding:"required"`
-		Signature  string `json:"signature" binding:"required"`
+		User      string `json:"user" binding:"required"`
+		GasUsed   string `json:"gasUsed" binding:"required"`
+		Signature string `json:"signature" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if !authorizeAddress(c, req.User) {
+		return
+	}
+
+	sponsorship, err := h.services.Paymaster.SponsorGas(c.Request.Context(), req.User, req.GasUsed, req.Signature)
+	if err != nil {
+		apierror.Respond(c, err, "Failed to sponsor gas")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"txHash":      sponsorship.TransactionHash,
+		"sponsorship": sponsorship,
+		"message":     "Gas sponsored successfully",
+	})
+}
+
+// CreateAPIKey issues an API key for the signed-in merchant. The response
+// carries the key, which is not shown again
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	var req struct {
+		Name   string   `json:"name" binding:"required"`
+		Scopes []string `json:"scopes" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	key, err := h.services.APIKey.Create(c.Request.Context(), middleware.Subject(c), req.Name, req.Scopes)
+	if err != nil {
+		apiKeyError(c, err, "Failed to create API key")
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
+
+// GetAPIKeys lists the signed-in merchant's API keys, including revoked ones
+func (h *Handler) GetAPIKeys(c *gin.Context) {
+	keys, err := h.services.APIKey.List(c.Request.Context(), middleware.Subject(c))
+	if err != nil {
+		apiKeyError(c, err, "Failed to list API keys")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
+
+// RotateAPIKey revokes an API key and issues a replacement with the same name
+// and scopes
+func (h *Handler) RotateAPIKey(c *gin.Context) {
+	key, err := h.services.APIKey.Rotate(c.Request.Context(), middleware.Subject(c), c.Param("id"))
+	if err != nil {
+		apiKeyError(c, err, "Failed to rotate API key")
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
+
+// RevokeAPIKey revokes an API key
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	if err := h.services.APIKey.Revoke(c.Request.Context(), middleware.Subject(c), c.Param("id")); err != nil {
+		apiKeyError(c, err, "Failed to revoke API key")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "API key revoked successfully",
+	})
+}
+
+// CreateWebhookEndpoint registers a URL to receive an address's events. The
+// response carries the signing secret, which is not shown again.
+func (h *Handler) CreateWebhookEndpoint(c *gin.Context) {
+	var req struct {
+		Owner  string   `json:"owner" binding:"required"`
+		URL    string   `json:"url" binding:"required"`
+		Events []string `json:"events,omitempty"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierror.Abort(c, apierror.Binding(err))
+		return
+	}
+
+	if !authorizeAddress(c, req.Owner) {
+		return
+	}
+
+	endpoint, err := h.services.Webhook.Register(c.Request.Context(), req.Owner, req.URL, req.Events)
+	if err != nil {
+		webhookError(c, err, "Failed to create webhook endpoint")
+		return
+	}
+
+	c.JSON(http.StatusCreated, endpoint)
+}
+
+// GetWebhookEndpoints lists an owner's active webhook endpoints
+func (h *Handler) GetWebhookEndpoints(c *gin.Context) {
+	owner, ok := ownerQuery(c)
+	if !ok {
 		return
 	}
 
-	txHash, err := h.services.Paymaster.SponsorGas(req.User, req.GasUsed, req.Signature)
+	endpoints, err := h.services.Webhook.List(c.Request.Context(), owner)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to sponsor gas")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sponsor gas"})
+		webhookError(c, err, "Failed to list webhook endpoints")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"endpoints": endpoints})
+}
+
+// DeleteWebhookEndpoint stops sending events to an endpoint
+func (h *Handler) DeleteWebhookEndpoint(c *gin.Context) {
+	if err := h.services.Webhook.Delete(c.Request.Context(), middleware.Subject(c), c.Param("id")); err != nil {
+		webhookError(c, err, "Failed to delete webhook endpoint")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"txHash": txHash,
-		"message": "Gas sponsored successfully",
+		"message": "Webhook endpoint deleted successfully",
 	})
 }
+
+// GetWebhookDeliveries lists an endpoint's deliveries, optionally by status
+func (h *Handler) GetWebhookDeliveries(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	deliveries, err := h.services.Webhook.Deliveries(c.Request.Context(), middleware.Subject(c), c.Param("id"), c.Query("status"), limit)
+	if err != nil {
+		webhookError(c, err, "Failed to list webhook deliveries")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deliveries": deliveries})
+}
+
+// GetWebhookDelivery returns a delivery and the log of its attempts
+func (h *Handler) GetWebhookDelivery(c *gin.Context) {
+	delivery, err := h.services.Webhook.Delivery(c.Request.Context(), middleware.Subject(c), c.Param("id"))
+	if err != nil {
+		webhookError(c, err, "Failed to get webhook delivery")
+		return
+	}
+
+	c.JSON(http.StatusOK, delivery)
+}
+
+// ReplayWebhookDelivery queues a delivery to be sent again
+func (h *Handler) ReplayWebhookDelivery(c *gin.Context) {
+	delivery, err := h.services.Webhook.Replay(c.Request.Context(), middleware.Subject(c), c.Param("id"))
+	if err != nil {
+		webhookError(c, err, "Failed to replay webhook delivery")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+// GetWebhookDeadLetters lists deliveries to an owner's endpoints that ran out
+// of attempts
+func (h *Handler) GetWebhookDeadLetters(c *gin.Context) {
+	owner, ok := ownerQuery(c)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	deliveries, err := h.services.Webhook.DeadLetters(c.Request.Context(), owner, limit)
+	if err != nil {
+		webhookError(c, err, "Failed to list dead letters")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deliveries": deliveries})
+}
+
+// authorizeAddress responds 403 and returns false unless address is the
+// authenticated address.
+func authorizeAddress(c *gin.Context, address string) bool {
+	if !middleware.IsSubject(c, address) {
+		apierror.Abort(c, apierror.Forbidden("Not authorized for this address"))
+		return false
+	}
+	return true
+}
+
+// ownerQuery returns the owner query parameter, defaulting to the
+// authenticated address. It responds 403 and returns false for any other
+// address.
+func ownerQuery(c *gin.Context) (string, bool) {
+	owner := c.Query("owner")
+	if owner == "" {
+		owner = middleware.Subject(c)
+	}
+	return owner, authorizeAddress(c, owner)
+}
+
+// apiKeyError responds to an API key service error, logging it under
+// message if it is not the client's.
+func apiKeyError(c *gin.Context, err error, message string) {
+	if errors.Is(err, repository.ErrNotFound) {
+		apierror.Abort(c, apierror.NotFound("API key not found"))
+		return
+	}
+	apierror.Respond(c, err, message)
+}
+
+// webhookError responds to a webhook service error, logging it under
+// message if it is not the client's.
+func webhookError(c *gin.Context, err error, message string) {
+	if errors.Is(err, repository.ErrNotFound) {
+		apierror.Abort(c, apierror.NotFound("Webhook not found"))
+		return
+	}
+	apierror.Respond(c, err, message)
+}
+
+// logger returns a log entry carrying the request's ID and authenticated
+// address.
+func logger(c *gin.Context) *logrus.Entry {
+	return logging.FromContext(c.Request.Context())
+}
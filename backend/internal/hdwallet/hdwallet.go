@@ -0,0 +1,181 @@
+// Package hdwallet derives Ethereum keys from BIP-39 mnemonics along
+// BIP-44 paths (m/44'/60'/0'/0/i) using BIP-32 private key derivation.
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// hardenedOffset is added to an index to make a hardened BIP-32 child.
+const hardenedOffset = 0x80000000
+
+// MaxDiscoveryCount caps how many addresses DeriveAccounts returns at once.
+const MaxDiscoveryCount = 100
+
+// ErrInvalidKey is returned when a derivation step yields a key outside the
+// secp256k1 range. BIP-32 says to skip such indices; the chance of hitting
+// one is below 1 in 2^127.
+var ErrInvalidKey = errors.New("derived key is invalid")
+
+// Account is an address derived at a BIP-44 path.
+type Account struct {
+	Index   int    `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+// extendedKey is a BIP-32 extended private key.
+type extendedKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+// NewSeed validates a mnemonic against the BIP-39 English wordlist and its
+// checksum and returns the 64-byte seed for the given passphrase.
+func NewSeed(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return seed, nil
+}
+
+// AccountPath returns the BIP-44 Ethereum path m/44'/60'/0'/0/index.
+func AccountPath(index int) (accounts.DerivationPath, error) {
+	if index < 0 || index >= hardenedOffset {
+		return nil, fmt.Errorf("derivation index %d out of range", index)
+	}
+
+	path := make(accounts.DerivationPath, len(accounts.DefaultBaseDerivationPath))
+	copy(path, accounts.DefaultBaseDerivationPath)
+	path[len(path)-1] = uint32(index)
+	return path, nil
+}
+
+// DeriveKey derives the private key at path from a BIP-39 seed.
+func DeriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, err := masterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		if key, err = key.child(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return crypto.ToECDSA(key.key)
+}
+
+// DeriveAccounts derives count consecutive BIP-44 accounts starting at
+// index start, for account discovery.
+func DeriveAccounts(mnemonic, passphrase string, start, count int) ([]Account, error) {
+	if count < 1 || count > MaxDiscoveryCount {
+		return nil, fmt.Errorf("derivation count must be between 1 and %d", MaxDiscoveryCount)
+	}
+
+	seed, err := NewSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive the shared m/44'/60'/0'/0 parent once
+	parent, err := masterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	base := accounts.DefaultBaseDerivationPath
+	for _, index := range base[:len(base)-1] {
+		if parent, err = parent.child(index); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]Account, 0, count)
+	for i := start; i < start+count; i++ {
+		path, err := AccountPath(i)
+		if err != nil {
+			return nil, err
+		}
+
+		child, err := parent.child(uint32(i))
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		privateKey, err := crypto.ToECDSA(child.key)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, Account{
+			Index:   i,
+			Path:    path.String(),
+			Address: crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+		})
+	}
+
+	return result, nil
+}
+
+func masterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	k := new(big.Int).SetBytes(sum[:32])
+	if k.Sign() == 0 || k.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, ErrInvalidKey
+	}
+
+	return &extendedKey{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+// child implements BIP-32 CKDpriv for hardened and normal indices.
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0x00}, k.key...)
+	} else {
+		privateKey, err := crypto.ToECDSA(k.key)
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(n) >= 0 {
+		return nil, ErrInvalidKey
+	}
+
+	childKey := il.Add(il, new(big.Int).SetBytes(k.key))
+	childKey.Mod(childKey, n)
+	if childKey.Sign() == 0 {
+		return nil, ErrInvalidKey
+	}
+
+	return &extendedKey{
+		key:       childKey.FillBytes(make([]byte, 32)),
+		chainCode: sum[32:],
+	}, nil
+}
@@ -0,0 +1,138 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// TestBIP32Vector1 checks CKDpriv against BIP-32 test vector 1.
+func TestBIP32Vector1(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		path      string
+		chainCode string
+		key       string
+	}{
+		{"m", "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'", "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{"m/0'/1/2'/2", "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{"m/0'/1/2'/2/1000000000", "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+
+	for _, tt := range tests {
+		key, err := masterKey(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.path != "m" {
+			path, err := accounts.ParseDerivationPath(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, index := range path {
+				if key, err = key.child(index); err != nil {
+					t.Fatalf("%s: %v", tt.path, err)
+				}
+			}
+		}
+
+		if got := hex.EncodeToString(key.chainCode); got != tt.chainCode {
+			t.Errorf("%s chain code = %s, want %s", tt.path, got, tt.chainCode)
+		}
+		if got := hex.EncodeToString(key.key); got != tt.key {
+			t.Errorf("%s key = %s, want %s", tt.path, got, tt.key)
+		}
+	}
+}
+
+// TestNewSeed checks the seed against the BIP-39 reference vector for the
+// same mnemonic with the passphrase "TREZOR".
+func TestNewSeed(t *testing.T) {
+	seed, err := NewSeed("  "+strings.ReplaceAll(testMnemonic, " ", "  ")+"\n", "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"
+	if got := hex.EncodeToString(seed); got != want {
+		t.Errorf("seed = %s, want %s", got, want)
+	}
+}
+
+func TestNewSeedRejectsInvalidMnemonics(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+	}{
+		{"bad checksum", strings.Repeat("abandon ", 12)},
+		{"unknown word", strings.Replace(testMnemonic, "about", "aboutt", 1)},
+		{"wrong length", "abandon abandon abandon"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		if _, err := NewSeed(tt.mnemonic, ""); err == nil {
+			t.Errorf("%s: NewSeed(%q) succeeded", tt.name, tt.mnemonic)
+		}
+	}
+}
+
+func TestDeriveAccounts(t *testing.T) {
+	got, err := DeriveAccounts(testMnemonic, "", 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Account{
+		{Index: 0, Path: "m/44'/60'/0'/0/0", Address: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{Index: 1, Path: "m/44'/60'/0'/0/1", Address: "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d accounts, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("account %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// DeriveKey walks the whole path from the seed and must agree
+	seed, err := NewSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := AccountPath(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := DeriveKey(seed, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address := crypto.PubkeyToAddress(key.PublicKey).Hex(); address != want[1].Address {
+		t.Errorf("DeriveKey address = %s, want %s", address, want[1].Address)
+	}
+}
+
+func TestDeriveAccountsLimits(t *testing.T) {
+	tests := []struct {
+		start, count int
+	}{
+		{0, 0},
+		{0, MaxDiscoveryCount + 1},
+		{-1, 1},
+		{hardenedOffset - 1, 2},
+	}
+	for _, tt := range tests {
+		if _, err := DeriveAccounts(testMnemonic, "", tt.start, tt.count); err == nil {
+			t.Errorf("DeriveAccounts(start %d, count %d) succeeded", tt.start, tt.count)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Package health reports whether the server's dependencies are usable: the
+// RPC node and its chain ID, Postgres, Redis, the indexer's progress, the
+// relayer's balance and the deployed contracts. Each check is critical or
+// not; a failed critical check makes the server unready, while a failed
+// non-critical one only marks it degraded.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/migrations"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/version"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Check statuses. A disabled check is for a dependency the configuration
+// does not use.
+const (
+	StatusOK       = "ok"
+	StatusFail     = "fail"
+	StatusDisabled = "disabled"
+)
+
+// Report statuses.
+const (
+	StatusReady    = "ready"
+	StatusDegraded = "degraded"
+	StatusNotReady = "not_ready"
+)
+
+// checkTimeout bounds each check.
+const checkTimeout = 3 * time.Second
+
+// errDisabled is returned by a check whose dependency is not used.
+var errDisabled = errors.New("disabled")
+
+// Pinger is a dependency that can be pinged, such as the Redis rate limit
+// store.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Check is the outcome of one check.
+type Check struct {
+	Status    string                 `json:"status"`
+	Critical  bool                   `json:"critical"`
+	LatencyMs int64                  `json:"latencyMs"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// Report is the outcome of every check.
+type Report struct {
+	Status  string            `json:"status"`
+	Version version.Info      `json:"version"`
+	Checks  map[string]*Check `json:"checks"`
+}
+
+// Ready reports whether the server can take traffic.
+func (r *Report) Ready() bool {
+	return r.Status != StatusNotReady
+}
+
+// checkFunc runs one check, adding what it learns to details.
+type checkFunc func(ctx context.Context, details map[string]interface{}) error
+
+type Checker struct {
+	config   *config.Config
+	client   chain.Backend
+	store    *repository.Store
+	migrator *migrations.Migrator
+	redis    Pinger
+}
+
+// New creates a checker. redis is nil when Redis is not used.
+func New(cfg *config.Config, client chain.Backend, store *repository.Store, migrator *migrations.Migrator, redis Pinger) *Checker {
+	return &Checker{
+		config:   cfg,
+		client:   client,
+		store:    store,
+		migrator: migrator,
+		redis:    redis,
+	}
+}
+
+// Ready runs every check concurrently.
+func (c *Checker) Ready(ctx context.Context) *Report {
+	checks := []struct {
+		name     string
+		critical bool
+		run      checkFunc
+	}{
+		{"rpc", true, c.checkRPC},
+		{"chainId", true, c.checkChainID},
+		{"postgres", true, c.checkPostgres},
+		{"redis", true, c.checkRedis},
+		{"contracts", true, c.checkContracts},
+		{"indexer", false, c.checkIndexer},
+		{"relayer", false, c.checkRelayer},
+	}
+
+	report := &Report{
+		Status:  StatusReady,
+		Version: version.Get(),
+		Checks:  make(map[string]*Check, len(checks)),
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range checks {
+		wg.Add(1)
+		go func(name string, critical bool, run checkFunc) {
+			defer wg.Done()
+			result := runCheck(ctx, critical, run)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if result.Status != StatusFail {
+				return
+			}
+			if critical {
+				report.Status = StatusNotReady
+			} else if report.Status == StatusReady {
+				report.Status = StatusDegraded
+			}
+		}(check.name, check.critical, check.run)
+	}
+	wg.Wait()
+	return report
+}
+
+func runCheck(ctx context.Context, critical bool, run checkFunc) *Check {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	check := &Check{Status: StatusOK, Critical: critical, Details: make(map[string]interface{})}
+	started := time.Now()
+	err := run(ctx, check.Details)
+	check.LatencyMs = time.Since(started).Milliseconds()
+
+	switch {
+	case errors.Is(err, errDisabled):
+		check.Status = StatusDisabled
+	case err != nil:
+		check.Status = StatusFail
+		check.Error = err.Error()
+	}
+	if len(check.Details) == 0 {
+		check.Details = nil
+	}
+	return check
+}
+
+func (c *Checker) checkRPC(ctx context.Context, details map[string]interface{}) error {
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	details["block"] = head.Number.Int64()
+	details["blockAge"] = time.Since(time.Unix(int64(head.Time), 0)).Round(time.Second).String()
+	return nil
+}
+
+func (c *Checker) checkChainID(ctx context.Context, details map[string]interface{}) error {
+	details["expected"] = c.config.ChainID
+	reader, ok := c.client.(chain.ChainIDReader)
+	if !ok {
+		return errDisabled
+	}
+	id, err := reader.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	details["actual"] = id.Int64()
+	if id.Cmp(big.NewInt(c.config.ChainID)) != 0 {
+		return fmt.Errorf("RPC node is on chain %s, expected %d", id, c.config.ChainID)
+	}
+	return nil
+}
+
+func (c *Checker) checkPostgres(ctx context.Context, details map[string]interface{}) error {
+	if err := c.store.Ping(ctx); err != nil {
+		return err
+	}
+	schema, err := c.migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	details["schemaVersion"] = schema
+	if latest := c.migrator.Latest(); schema < latest {
+		return fmt.Errorf("schema is at version %d, expected %d", schema, latest)
+	}
+	return nil
+}
+
+func (c *Checker) checkRedis(ctx context.Context, details map[string]interface{}) error {
+	if c.redis == nil {
+		return errDisabled
+	}
+	return c.redis.Ping(ctx)
+}
+
+// checkContracts checks that every configured contract address has code.
+func (c *Checker) checkContracts(ctx context.Context, details map[string]interface{}) error {
+	contracts := []struct{ name, address string }{
+		{"VyraToken", c.config.VyraToken},
+		{"VyraPOS", c.config.POS},
+		{"VyraBridge", c.config.Bridge},
+		{"VyraPaymaster", c.config.Paymaster},
+		{"EntryPoint", c.config.EntryPoint},
+	}
+
+	var missing []string
+	for _, contract := range contracts {
+		details[contract.name] = contract.address
+		if !common.IsHexAddress(contract.address) {
+			missing = append(missing, contract.name)
+			continue
+		}
+		code, err := c.client.CodeAt(ctx, common.HexToAddress(contract.address), nil)
+		if err != nil {
+			return err
+		}
+		if len(code) == 0 {
+			missing = append(missing, contract.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no contract deployed for %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// checkIndexer checks that every contract's checkpoint is within
+// HealthMaxIndexerLag blocks of the head.
+func (c *Checker) checkIndexer(ctx context.Context, details map[string]interface{}) error {
+	if !c.config.IndexerEnabled {
+		return errDisabled
+	}
+
+	checkpoints, err := c.store.ListCheckpoints(ctx)
+	if err != nil {
+		return err
+	}
+	if len(checkpoints) == 0 {
+		return errors.New("indexer has not indexed any blocks")
+	}
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var lag int64
+	lags := make(map[string]int64, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		behind := head.Number.Int64() - checkpoint.Block
+		if behind < 0 {
+			behind = 0
+		}
+		lags[checkpoint.Name] = behind
+		if behind > lag {
+			lag = behind
+		}
+	}
+	details["lagBlocks"] = lags
+	details["maxLagBlocks"] = c.config.HealthMaxIndexerLag
+	if lag > c.config.HealthMaxIndexerLag {
+		return fmt.Errorf("indexer is %d blocks behind", lag)
+	}
+	return nil
+}
+
+// checkRelayer checks that the relayer can pay for gas.
+func (c *Checker) checkRelayer(ctx context.Context, details map[string]interface{}) error {
+	opts, err := chain.NewTransactor(c.config.RelayerKey, c.config.ChainID)
+	if errors.Is(err, chain.ErrRelayerNotConfigured) {
+		return errDisabled
+	}
+	if err != nil {
+		return err
+	}
+	details["address"] = opts.From.Hex()
+
+	balance, err := c.client.BalanceAt(ctx, opts.From, nil)
+	if err != nil {
+		return err
+	}
+	details["balance"] = chain.FormatUnits(balance, 18)
+	details["minBalance"] = chain.FormatUnits(c.config.HealthMinRelayerBalance, 18)
+	if balance.Cmp(c.config.HealthMinRelayerBalance) < 0 {
+		return errors.New("relayer balance is below the minimum")
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+// onchainID formats a bytes32 identifier as 64 hex digits without the 0x
+// prefix, the format the VARCHAR(64) ID columns hold.
+func onchainID(id [32]byte) string {
+	return hex.EncodeToString(id[:])
+}
+
+func formatVYR(amount *big.Int) string {
+	return chain.FormatUnits(amount, chain.VYRDecimals)
+}
+
+// maxTime caps contract timestamps to what a Postgres TIMESTAMP can hold.
+var maxTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+func unixTime(seconds *big.Int) time.Time {
+	if !seconds.IsInt64() || seconds.Int64() > maxTime.Unix() {
+		return maxTime
+	}
+	return time.Unix(seconds.Int64(), 0).UTC()
+}
+
+func (i *Indexer) onTransfer(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.token.ParseTransfer(log)
+	if err != nil {
+		return err
+	}
+
+	return tx.IndexTransfer(ctx, ref, &repository.Transaction{
+		FromAddress: event.From.Hex(),
+		ToAddress:   event.To.Hex(),
+		Amount:      formatVYR(event.Value),
+		Type:        repository.TypeTransfer,
+	})
+}
+
+func (i *Indexer) onInvoiceCreated(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.pos.ParseInvoiceCreated(log)
+	if err != nil {
+		return err
+	}
+
+	invoice := &repository.Invoice{
+		InvoiceID:       onchainID(event.InvoiceId),
+		MerchantAddress: event.Merchant.Hex(),
+		Amount:          formatVYR(event.Amount),
+	}
+
+	// The event has no description or expiry; both are immutable, so read
+	// them from the latest state
+	details, err := i.pos.Invoices(&bind.CallOpts{Context: ctx}, event.InvoiceId)
+	if err != nil {
+		return fmt.Errorf("failed to read invoice: %v", err)
+	}
+	if details.Merchant != (common.Address{}) {
+		expiry := unixTime(details.Expiry)
+		invoice.Description = &details.Description
+		invoice.Expiry = &expiry
+	}
+
+	return tx.IndexInvoice(ctx, ref, invoice)
+}
+
+func (i *Indexer) onPaymentProcessed(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.pos.ParsePaymentProcessed(log)
+	if err != nil {
+		return err
+	}
+
+	// Fees and merchant are only in contract storage
+	details, err := i.pos.Payments(&bind.CallOpts{Context: ctx}, event.PaymentId)
+	if err != nil {
+		return fmt.Errorf("failed to read payment: %v", err)
+	}
+
+	payment := &repository.Payment{
+		PaymentID:       onchainID(event.PaymentId),
+		CustomerAddress: event.Customer.Hex(),
+		MerchantAddress: details.Merchant.Hex(),
+		Amount:          formatVYR(event.Amount),
+		MerchantFee:     formatVYR(details.MerchantFee),
+		PlatformFee:     formatVYR(details.PlatformFee),
+	}
+
+	return tx.IndexPayment(ctx, ref, payment, onchainID(event.InvoiceId), nil)
+}
+
+func (i *Indexer) onPaymentRefunded(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.pos.ParsePaymentRefunded(log)
+	if err != nil {
+		return err
+	}
+
+	paymentID := onchainID(event.PaymentId)
+	err = tx.IndexRefund(ctx, ref, paymentID, formatVYR(event.RefundAmount))
+	if errors.Is(err, repository.ErrNotFound) {
+		logrus.WithField("paymentId", paymentID).Warn("Indexed refund for unknown payment")
+		return nil
+	}
+	return err
+}
+
+func (i *Indexer) onSplitPaymentProcessed(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.pos.ParseSplitPaymentProcessed(log)
+	if err != nil {
+		return err
+	}
+	if len(event.Recipients) == 0 || len(event.Recipients) != len(event.Amounts) {
+		return fmt.Errorf("malformed split payment %s", onchainID(event.PaymentId))
+	}
+
+	// The customer is a call argument, not part of the event
+	chainTx, args, err := i.calldata(ctx, log, i.posABI, "processSplitPayment")
+	if err != nil {
+		return err
+	}
+	customer, ok := common.Address{}, false
+	if args != nil {
+		customer, ok = args[3].(common.Address)
+	}
+	if !ok {
+		if customer, err = types.Sender(types.LatestSignerForChainID(chainTx.ChainId()), chainTx); err != nil {
+			return err
+		}
+	}
+
+	total := new(big.Int)
+	splits := make([]repository.PaymentSplit, len(event.Recipients))
+	for n, recipient := range event.Recipients {
+		total.Add(total, event.Amounts[n])
+		splits[n] = repository.PaymentSplit{
+			RecipientAddress: recipient.Hex(),
+			Amount:           formatVYR(event.Amounts[n]),
+		}
+	}
+
+	// A split has no single merchant; the first recipient stands in for it
+	// and the full breakdown goes to payment_splits
+	payment := &repository.Payment{
+		PaymentID:       onchainID(event.PaymentId),
+		CustomerAddress: customer.Hex(),
+		MerchantAddress: event.Recipients[0].Hex(),
+		Amount:          formatVYR(total),
+		MerchantFee:     "0",
+		PlatformFee:     "0",
+	}
+
+	return tx.IndexPayment(ctx, ref, payment, "", splits)
+}
+
+func (i *Indexer) onDepositInitiated(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.bridge.ParseDepositInitiated(log)
+	if err != nil {
+		return err
+	}
+
+	l1TxHash := ref.TxHash
+	return tx.IndexBridgeTransaction(ctx, ref, &repository.BridgeTransaction{
+		UserAddress: event.User.Hex(),
+		Amount:      formatVYR(event.Amount),
+		Direction:   repository.DirectionDeposit,
+		L1TxHash:    &l1TxHash,
+	}, onchainID(event.DepositId))
+}
+
+func (i *Indexer) onWithdrawalProcessed(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.bridge.ParseWithdrawalProcessed(log)
+	if err != nil {
+		return err
+	}
+
+	l1TxHash := ref.TxHash
+	withdrawal := &repository.BridgeTransaction{
+		UserAddress: event.User.Hex(),
+		Amount:      formatVYR(event.Amount),
+		Direction:   repository.DirectionWithdrawal,
+		L1TxHash:    &l1TxHash,
+	}
+
+	// The L2 hash links the withdrawal to the API request; it is only in
+	// the call arguments
+	_, args, err := i.calldata(ctx, log, i.bridgeABI, "initiateWithdrawal")
+	if err != nil {
+		return err
+	}
+	if args != nil {
+		if l2TxHash, ok := args[1].([32]byte); ok {
+			hash := common.Hash(l2TxHash).Hex()
+			withdrawal.L2TxHash = &hash
+		}
+	}
+
+	return tx.IndexBridgeTransaction(ctx, ref, withdrawal, onchainID(event.WithdrawalId))
+}
+
+func (i *Indexer) onGasSponsored(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.paymaster.ParseGasSponsored(log)
+	if err != nil {
+		return err
+	}
+
+	return tx.IndexSponsorship(ctx, ref, &repository.PaymasterSponsorship{
+		UserAddress: event.User.Hex(),
+		GasUsed:     event.GasUsed.Int64(),
+		VyrCost:     formatVYR(event.VyrSpent),
+	})
+}
+
+func (i *Indexer) onSessionKeyCreated(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.paymaster.ParseSessionKeyCreated(log)
+	if err != nil {
+		return err
+	}
+
+	return tx.IndexSessionKey(ctx, ref, event.User.Hex(), event.Key.Hex(), unixTime(event.Expiry))
+}
+
+func (i *Indexer) onSessionKeyRevoked(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error {
+	event, err := i.paymaster.ParseSessionKeyRevoked(log)
+	if err != nil {
+		return err
+	}
+
+	return tx.IndexSessionKeyRevoked(ctx, ref, event.User.Hex(), event.Key.Hex())
+}
+
+// calldata returns the transaction that emitted log and, if it called method
+// on the emitting contract directly, the decoded arguments. The arguments
+// are nil when the call came through another contract.
+func (i *Indexer) calldata(ctx context.Context, log types.Log, parsed *abi.ABI, method string) (*types.Transaction, []interface{}, error) {
+	chainTx, _, err := i.client.TransactionByHash(ctx, log.TxHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transaction: %v", err)
+	}
+
+	input := chainTx.Data()
+	m := parsed.Methods[method]
+	if chainTx.To() == nil || *chainTx.To() != log.Address || len(input) < 4 || !bytes.Equal(input[:4], m.ID) {
+		return chainTx, nil, nil
+	}
+
+	args, err := m.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode %s call: %v", method, err)
+	}
+	return chainTx, args, nil
+}
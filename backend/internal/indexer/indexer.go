@@ -0,0 +1,356 @@
+// Package indexer follows the Vyra contracts on-chain and mirrors their
+// events into Postgres. It polls eth_getLogs for each contract from its own
+// checkpoint, writes a batch of decoded events and the new checkpoint in one
+// database transaction, and rolls back rows from orphaned blocks when it sees
+// a reorg.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/metrics"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+)
+
+// maxReorgDepth is how far back, in blocks, the indexer remembers block
+// hashes to find the common ancestor after a reorg.
+const maxReorgDepth = 128
+
+// backfillBatchSize is how many blocks backfillBlockTimes looks up at once.
+const backfillBatchSize = 100
+
+// handlerFunc decodes one log and writes it through tx.
+type handlerFunc func(ctx context.Context, tx *repository.Tx, log types.Log, ref repository.EventRef) error
+
+// watchedContract is a contract address and the events indexed from it.
+type watchedContract struct {
+	name     string
+	address  common.Address
+	handlers map[common.Hash]handlerFunc
+}
+
+type Indexer struct {
+	config    *config.Config
+	client    chain.Backend
+	store     *repository.Store
+	token     *contracts.VyraToken
+	pos       *contracts.VyraPOS
+	bridge    *contracts.VyraBridge
+	paymaster *contracts.VyraPaymaster
+	posABI    *abi.ABI
+	bridgeABI *abi.ABI
+	contracts []*watchedContract
+
+	// backfilled is set once rows indexed without a block time have had it
+	// filled in
+	backfilled bool
+}
+
+func New(cfg *config.Config, client chain.Backend, store *repository.Store) (*Indexer, error) {
+	i := &Indexer{
+		config: cfg,
+		client: client,
+		store:  store,
+	}
+
+	var err error
+	if i.token, err = contracts.NewVyraToken(common.HexToAddress(cfg.VyraToken), client); err != nil {
+		return nil, err
+	}
+	if i.pos, err = contracts.NewVyraPOS(common.HexToAddress(cfg.POS), client); err != nil {
+		return nil, err
+	}
+	if i.bridge, err = contracts.NewVyraBridge(common.HexToAddress(cfg.Bridge), client); err != nil {
+		return nil, err
+	}
+	if i.paymaster, err = contracts.NewVyraPaymaster(common.HexToAddress(cfg.Paymaster), client); err != nil {
+		return nil, err
+	}
+	if i.posABI, err = contracts.VyraPOSMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	if i.bridgeABI, err = contracts.VyraBridgeMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+
+	watches := []struct {
+		name     string
+		address  string
+		metadata *bind.MetaData
+		handlers map[string]handlerFunc
+	}{
+		{"VyraToken", cfg.VyraToken, contracts.VyraTokenMetaData, map[string]handlerFunc{
+			"Transfer": i.onTransfer,
+		}},
+		{"VyraPOS", cfg.POS, contracts.VyraPOSMetaData, map[string]handlerFunc{
+			"InvoiceCreated":        i.onInvoiceCreated,
+			"PaymentProcessed":      i.onPaymentProcessed,
+			"PaymentRefunded":       i.onPaymentRefunded,
+			"SplitPaymentProcessed": i.onSplitPaymentProcessed,
+		}},
+		{"VyraBridge", cfg.Bridge, contracts.VyraBridgeMetaData, map[string]handlerFunc{
+			"DepositInitiated":    i.onDepositInitiated,
+			"WithdrawalProcessed": i.onWithdrawalProcessed,
+		}},
+		{"VyraPaymaster", cfg.Paymaster, contracts.VyraPaymasterMetaData, map[string]handlerFunc{
+			"GasSponsored":      i.onGasSponsored,
+			"SessionKeyCreated": i.onSessionKeyCreated,
+			"SessionKeyRevoked": i.onSessionKeyRevoked,
+		}},
+	}
+
+	for _, w := range watches {
+		parsed, err := w.metadata.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s ABI: %v", w.name, err)
+		}
+
+		contract := &watchedContract{
+			name:     w.name,
+			address:  common.HexToAddress(w.address),
+			handlers: make(map[common.Hash]handlerFunc),
+		}
+		for name, handler := range w.handlers {
+			event, ok := parsed.Events[name]
+			if !ok {
+				return nil, fmt.Errorf("%s has no %s event", w.name, name)
+			}
+			contract.handlers[event.ID] = handler
+		}
+		i.contracts = append(i.contracts, contract)
+	}
+
+	return i, nil
+}
+
+// Run indexes until ctx is cancelled, polling for new blocks every
+// IndexerPollInterval.
+func (i *Indexer) Run(ctx context.Context) {
+	logrus.Infof("Indexer started for %d contracts", len(i.contracts))
+
+	ticker := time.NewTicker(i.config.IndexerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.poll(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Indexer poll failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			logrus.Info("Indexer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (i *Indexer) poll(ctx context.Context) error {
+	head, err := i.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get chain head: %v", err)
+	}
+
+	if err := i.detectReorg(ctx); err != nil {
+		return err
+	}
+	if !i.backfilled {
+		if err := i.backfillBlockTimes(ctx); err != nil {
+			return err
+		}
+		i.backfilled = true
+	}
+
+	for _, contract := range i.contracts {
+		if err := i.catchUp(ctx, contract, head.Number.Int64()); err != nil {
+			return fmt.Errorf("%s: %v", contract.name, err)
+		}
+	}
+
+	return i.store.PruneBlocks(ctx, head.Number.Int64()-maxReorgDepth)
+}
+
+// catchUp indexes a contract from its checkpoint up to head in batches.
+func (i *Indexer) catchUp(ctx context.Context, contract *watchedContract, head int64) error {
+	next := i.config.IndexerStartBlock
+	last, err := i.store.GetCheckpoint(ctx, contract.address.Hex())
+	switch {
+	case err == nil:
+		next = last + 1
+	case !errors.Is(err, repository.ErrNotFound):
+		return err
+	}
+
+	metrics.ObserveIndexer(contract.name, head, next-1)
+	for next <= head {
+		to := next + i.config.IndexerBatchSize - 1
+		if to > head {
+			to = head
+		}
+		if err := i.indexRange(ctx, contract, next, to); err != nil {
+			return err
+		}
+		next = to + 1
+		metrics.ObserveIndexer(contract.name, head, to)
+	}
+	return nil
+}
+
+func (i *Indexer) indexRange(ctx context.Context, contract *watchedContract, from, to int64) error {
+	topics := make([]common.Hash, 0, len(contract.handlers))
+	for topic := range contract.handlers {
+		topics = append(topics, topic)
+	}
+
+	logs, err := i.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(from),
+		ToBlock:   big.NewInt(to),
+		Addresses: []common.Address{contract.address},
+		Topics:    [][]common.Hash{topics},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get logs for blocks %d-%d: %v", from, to, err)
+	}
+
+	// Remember the hash of every block written from, so a later reorg can be
+	// detected. Check them against the chain first in case it reorganised
+	// while the logs were being fetched. The headers also give each row the
+	// time its block was mined.
+	blocks := make(map[int64]string)
+	for _, log := range logs {
+		blocks[int64(log.BlockNumber)] = log.BlockHash.Hex()
+	}
+	header, err := i.client.HeaderByNumber(ctx, big.NewInt(to))
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %v", to, err)
+	}
+	if hash, ok := blocks[to]; ok && hash != header.Hash().Hex() {
+		return fmt.Errorf("block %d changed while indexing", to)
+	}
+	blocks[to] = header.Hash().Hex()
+	times := map[int64]time.Time{to: blockTime(header)}
+	for number, hash := range blocks {
+		if number == to {
+			continue
+		}
+		header, err := i.client.HeaderByNumber(ctx, big.NewInt(number))
+		if err != nil {
+			return fmt.Errorf("failed to get block %d: %v", number, err)
+		}
+		if header.Hash().Hex() != hash {
+			return fmt.Errorf("block %d changed while indexing", number)
+		}
+		times[number] = blockTime(header)
+	}
+
+	return i.store.WithTx(ctx, func(tx *repository.Tx) error {
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			handler, ok := contract.handlers[log.Topics[0]]
+			if !ok {
+				continue
+			}
+
+			ref := repository.EventRef{
+				TxHash:      log.TxHash.Hex(),
+				BlockNumber: int64(log.BlockNumber),
+				BlockHash:   log.BlockHash.Hex(),
+				LogIndex:    int(log.Index),
+				BlockTime:   times[int64(log.BlockNumber)],
+			}
+			if err := handler(ctx, tx, log, ref); err != nil {
+				return fmt.Errorf("failed to index log %d of %s: %v", log.Index, ref.TxHash, err)
+			}
+		}
+
+		for number, hash := range blocks {
+			if err := tx.RecordBlock(ctx, repository.IndexedBlock{Number: number, Hash: hash}); err != nil {
+				return err
+			}
+		}
+		return tx.SaveCheckpoint(ctx, contract.address.Hex(), contract.name, to)
+	})
+}
+
+// backfillBlockTimes fills in the block time of rows indexed without one,
+// such as rows indexed before block times were stored. The indexer stamps
+// every row it writes from then on, so this runs once, on the first poll.
+func (i *Indexer) backfillBlockTimes(ctx context.Context) error {
+	for {
+		blocks, err := i.store.BlocksWithoutTime(ctx, backfillBatchSize)
+		if err != nil || len(blocks) == 0 {
+			return err
+		}
+		for _, number := range blocks {
+			header, err := i.client.HeaderByNumber(ctx, big.NewInt(number))
+			if err != nil {
+				return fmt.Errorf("failed to get block %d: %v", number, err)
+			}
+			if err := i.store.SetBlockTime(ctx, number, blockTime(header)); err != nil {
+				return err
+			}
+		}
+		logrus.WithField("blocks", len(blocks)).Info("Backfilled block times")
+	}
+}
+
+// blockTime is when the block with header was mined.
+func blockTime(header *types.Header) time.Time {
+	return time.Unix(int64(header.Time), 0).UTC()
+}
+
+// detectReorg compares the newest remembered block hash with the chain. On a
+// mismatch it walks back to the newest block that still matches and rolls
+// back everything indexed after it.
+func (i *Indexer) detectReorg(ctx context.Context) error {
+	blocks, err := i.store.RecentBlocks(ctx, maxReorgDepth)
+	if err != nil || len(blocks) == 0 {
+		return err
+	}
+
+	for n, block := range blocks {
+		header, err := i.client.HeaderByNumber(ctx, big.NewInt(block.Number))
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return fmt.Errorf("failed to get block %d: %v", block.Number, err)
+		}
+		if err == nil && header.Hash().Hex() == block.Hash {
+			if n == 0 {
+				return nil
+			}
+			return i.rollback(ctx, block.Number)
+		}
+	}
+
+	// The reorg is deeper than the remembered window; re-index all of it
+	oldest := blocks[len(blocks)-1].Number - 1
+	logrus.Errorf("Reorg deeper than %d blocks, rolling back to block %d", maxReorgDepth, oldest)
+	return i.rollback(ctx, oldest)
+}
+
+func (i *Indexer) rollback(ctx context.Context, ancestor int64) error {
+	err := i.store.WithTx(ctx, func(tx *repository.Tx) error {
+		return tx.RollbackAfter(ctx, ancestor)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to roll back to block %d: %v", ancestor, err)
+	}
+
+	logrus.WithField("block", ancestor).Warn("Chain reorganised, rolled back indexed data")
+	return nil
+}
@@ -0,0 +1,486 @@
+package indexer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/testdb"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+var (
+	tokenAddress     = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	posAddress       = common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512")
+	bridgeAddress    = common.HexToAddress("0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0")
+	paymasterAddress = common.HexToAddress("0xCf7Ed3AccA5a467e9e704C703E8D87F634fB0Fc9")
+)
+
+const (
+	// emitterBase is where the emitter's LOGn blocks start, and emitterBlock
+	// the length each is padded to so the jump to one can be computed
+	emitterBase  = 28
+	emitterBlock = 18
+)
+
+// emitterCode is the runtime code of a contract that emits whatever log its
+// calldata describes: a word holding the number of topics, the topics, then
+// the log data. It stands in for the Vyra contracts, whose bytecode the
+// bindings do not carry.
+func emitterCode() []byte {
+	code := []byte{
+		0x60, 0x00, 0x35, // n := calldataload(0)
+		0x80, 0x60, 0x05, 0x1b, 0x60, 0x20, 0x01, // offset := 32 + n*32
+		0x80, 0x36, 0x03, // size := calldatasize - offset
+		0x80, 0x82, 0x60, 0x00, 0x37, // calldatacopy(0, offset, size)
+		0x90, 0x50, // drop offset
+		0x81, 0x60, emitterBlock, 0x02, 0x60, emitterBase, 0x01, 0x56, // jump to the block for n
+	}
+	for n := 0; n <= 4; n++ {
+		block := []byte{0x5b}
+		for k := n - 1; k >= 0; k-- {
+			block = append(block, 0x60, byte(32+32*k), 0x35) // topic k
+		}
+		// logn(0, size, topics...), then stop
+		block = append(block, 0x80+byte(n), 0x60, 0x00, 0xa0+byte(n), 0x00)
+		code = append(code, block...)
+		code = append(code, make([]byte, emitterBlock-len(block))...)
+	}
+	return code
+}
+
+// posPayment is a payment as VyraPOS stores it.
+type posPayment struct {
+	customer    common.Address
+	merchant    common.Address
+	amount      *big.Int
+	merchantFee *big.Int
+	platformFee *big.Int
+	invoiceID   [32]byte
+}
+
+// testChain is a simulated chain with emitters at the token and VyraPOS
+// addresses. It answers the VyraPOS views the indexer reads, and reports
+// blocks it does not have as a node does.
+type testChain struct {
+	*backends.SimulatedBackend
+	key      *ecdsa.PrivateKey
+	posABI   *abi.ABI
+	tokenABI *abi.ABI
+	payments map[[32]byte]*posPayment
+
+	// afterFilterLogs, when set, runs once logs have been fetched
+	afterFilterLogs func()
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	posABI, err := contracts.VyraPOSMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenABI, err := contracts.VyraTokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	funds, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: funds},
+		tokenAddress:                          {Code: emitterCode()},
+		posAddress:                            {Code: emitterCode()},
+	}, 30_000_000)
+	t.Cleanup(func() { backend.Close() })
+
+	return &testChain{
+		SimulatedBackend: backend,
+		key:              key,
+		posABI:           posABI,
+		tokenABI:         tokenABI,
+		payments:         make(map[[32]byte]*posPayment),
+	}
+}
+
+func (c *testChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != posAddress {
+		return c.SimulatedBackend.CallContract(ctx, call, blockNumber)
+	}
+	method, err := c.posABI.MethodById(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "invoices":
+		return method.Outputs.Pack(common.Address{}, new(big.Int), "", new(big.Int), false, [32]byte{})
+	case "payments":
+		p := c.payments[args[0].([32]byte)]
+		if p == nil {
+			p = &posPayment{amount: new(big.Int), merchantFee: new(big.Int), platformFee: new(big.Int)}
+		}
+		return method.Outputs.Pack(p.customer, p.merchant, p.amount, p.merchantFee, p.platformFee,
+			new(big.Int), false, p.invoiceID)
+	}
+	return nil, fmt.Errorf("test VyraPOS does not implement %s", method.Name)
+}
+
+func (c *testChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	header, err := c.SimulatedBackend.HeaderByNumber(ctx, number)
+	if err == nil && header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, err
+}
+
+func (c *testChain) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := c.SimulatedBackend.FilterLogs(ctx, query)
+	if c.afterFilterLogs != nil {
+		c.afterFilterLogs()
+		c.afterFilterLogs = nil
+	}
+	return logs, err
+}
+
+// emit sends a transaction that makes the emitter at contract log event with
+// args, to be mined by the next Commit, and returns its hash.
+func (c *testChain) emit(t *testing.T, contract common.Address, event abi.Event, args ...interface{}) common.Hash {
+	t.Helper()
+	ctx := context.Background()
+
+	topics := []common.Hash{event.ID}
+	var values []interface{}
+	for n, input := range event.Inputs {
+		if !input.Indexed {
+			values = append(values, args[n])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[n]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		topics = append(topics, topic[0][0])
+	}
+	logData, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := common.LeftPadBytes(big.NewInt(int64(len(topics))).Bytes(), 32)
+	for _, topic := range topics {
+		data = append(data, topic.Bytes()...)
+	}
+	data = append(data, logData...)
+
+	from := crypto.PubkeyToAddress(c.key.PublicKey)
+	nonce, err := c.PendingNonceAt(ctx, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gasPrice, err := c.SuggestGasPrice(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		To:       &contract,
+		Gas:      200_000,
+		GasPrice: gasPrice,
+		Data:     data,
+	}), types.LatestSignerForChainID(big.NewInt(1337)), c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	return tx.Hash()
+}
+
+func (c *testChain) transfer(t *testing.T, from, to common.Address, amount int64) common.Hash {
+	t.Helper()
+	return c.emit(t, tokenAddress, c.tokenABI.Events["Transfer"], from, to, vyr(amount))
+}
+
+// reorg replaces every block after ancestor with the given number of empty
+// blocks, which must outnumber those replaced for the new chain to win.
+func (c *testChain) reorg(t *testing.T, ancestor common.Hash, blocks int) {
+	t.Helper()
+	if err := c.Fork(context.Background(), ancestor); err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n < blocks; n++ {
+		c.Commit()
+	}
+}
+
+func (c *testChain) hashOf(t *testing.T, number int64) string {
+	t.Helper()
+	header, err := c.HeaderByNumber(context.Background(), big.NewInt(number))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return header.Hash().Hex()
+}
+
+func vyr(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func newAccount(t *testing.T) common.Address {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// newTestIndexer returns an indexer following chain into a database of its
+// own; rolling back a reorg changes every row above the ancestor.
+func newTestIndexer(t *testing.T, chain *testChain) (*Indexer, *repository.Store) {
+	t.Helper()
+	store := testdb.Fresh(t)
+	i, err := New(&config.Config{
+		VyraToken:         tokenAddress.Hex(),
+		POS:               posAddress.Hex(),
+		Bridge:            bridgeAddress.Hex(),
+		Paymaster:         paymasterAddress.Hex(),
+		IndexerStartBlock: 1,
+		IndexerBatchSize:  2,
+	}, chain, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return i, store
+}
+
+func checkCheckpoints(t *testing.T, store *repository.Store, want int64) {
+	t.Helper()
+	checkpoints, err := store.ListCheckpoints(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 4 {
+		t.Fatalf("%d checkpoints, want one per contract", len(checkpoints))
+	}
+	for _, c := range checkpoints {
+		if c.Block != want {
+			t.Errorf("%s checkpoint = %d, want %d", c.Name, c.Block, want)
+		}
+	}
+}
+
+// checkBlocks checks the remembered block hashes against the chain.
+func checkBlocks(t *testing.T, store *repository.Store, chain *testChain, want ...int64) {
+	t.Helper()
+	blocks, err := store.RecentBlocks(context.Background(), maxReorgDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var numbers []int64
+	for _, b := range blocks {
+		numbers = append(numbers, b.Number)
+		if hash := chain.hashOf(t, b.Number); b.Hash != hash {
+			t.Errorf("block %d remembered as %s, chain has %s", b.Number, b.Hash, hash)
+		}
+	}
+	if fmt.Sprint(numbers) != fmt.Sprint(want) {
+		t.Errorf("remembered blocks %v, want %v", numbers, want)
+	}
+}
+
+func TestReorg(t *testing.T) {
+	chain := newTestChain(t)
+	i, store := newTestIndexer(t, chain)
+	ctx := context.Background()
+	pos := chain.posABI.Events
+
+	alice, bob, merchant, customer := newAccount(t), newAccount(t), newAccount(t), newAccount(t)
+	invoiceID, paymentID := [32]byte{1}, [32]byte{2}
+	chain.payments[paymentID] = &posPayment{
+		customer: customer, merchant: merchant, amount: vyr(10),
+		merchantFee: big.NewInt(0), platformFee: big.NewInt(0), invoiceID: invoiceID,
+	}
+
+	// Block 1 survives the reorg; blocks 2 and 3 are orphaned by it
+	kept := chain.transfer(t, alice, bob, 1)
+	chain.emit(t, posAddress, pos["InvoiceCreated"], invoiceID, merchant, vyr(10))
+	ancestor := chain.Commit()
+
+	sent := chain.transfer(t, alice, bob, 2)
+	if err := store.CreateTransaction(ctx, &repository.Transaction{
+		Hash: sent.Hex(), FromAddress: alice.Hex(), ToAddress: bob.Hex(),
+		Amount: "2", Fee: "0", Status: repository.StatusPending, Type: repository.TypeTransfer,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	chain.emit(t, posAddress, pos["PaymentProcessed"], paymentID, invoiceID, customer, vyr(10))
+	chain.Commit()
+
+	orphaned := chain.transfer(t, bob, alice, 1)
+	chain.emit(t, posAddress, pos["PaymentRefunded"], paymentID, vyr(10))
+	chain.Commit()
+
+	if err := i.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoints(t, store, 3)
+	checkBlocks(t, store, chain, 3, 2, 1)
+	if invoice, err := store.GetInvoice(ctx, onchainID(invoiceID)); err != nil || invoice.Status != repository.StatusRefunded {
+		t.Fatalf("invoice = %+v, %v; want refunded", invoice, err)
+	}
+	if tx, err := store.GetTransactionByHash(ctx, sent.Hex()); err != nil || tx.Status != repository.StatusConfirmed {
+		t.Fatalf("API transfer = %+v, %v; want confirmed", tx, err)
+	}
+
+	chain.reorg(t, ancestor, 3)
+	if err := i.detectReorg(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Everything above the ancestor is undone and the checkpoints rewound
+	checkCheckpoints(t, store, 1)
+	checkBlocks(t, store, chain, 1)
+	if _, err := store.GetTransactionByHash(ctx, kept.Hex()); err != nil {
+		t.Errorf("transfer in the kept block: %v", err)
+	}
+	if _, err := store.GetTransactionByHash(ctx, orphaned.Hex()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("orphaned transfer: err = %v, want ErrNotFound", err)
+	}
+	tx, err := store.GetTransactionByHash(ctx, sent.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Status != repository.StatusPending || tx.BlockNumber != nil {
+		t.Errorf("API transfer = %s at block %v, want pending with no block", tx.Status, tx.BlockNumber)
+	}
+	if _, err := store.GetPayment(ctx, onchainID(paymentID)); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("orphaned payment: err = %v, want ErrNotFound", err)
+	}
+
+	invoice, err := store.GetInvoice(ctx, onchainID(invoiceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Status != repository.StatusPendingPayment {
+		t.Errorf("invoice status = %s, want %s", invoice.Status, repository.StatusPendingPayment)
+	}
+	transitions, err := store.ListInvoiceTransitions(ctx, onchainID(invoiceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path []string
+	for _, tr := range transitions {
+		path = append(path, tr.To+"/"+tr.Reason)
+	}
+	want := "pending_payment/chain paid/chain refunded/chain paid/reorg pending_payment/reorg"
+	if strings.Join(path, " ") != want {
+		t.Errorf("transitions = %v, want %s", path, want)
+	}
+
+	// The next poll indexes the new chain from the ancestor
+	if err := i.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoints(t, store, 4)
+	checkBlocks(t, store, chain, 4, 3, 1)
+	if _, err := store.GetTransactionByHash(ctx, orphaned.Hex()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("orphaned transfer re-indexed: err = %v", err)
+	}
+}
+
+func TestReorgPastRememberedBlocks(t *testing.T) {
+	chain := newTestChain(t)
+	i, store := newTestIndexer(t, chain)
+	ctx := context.Background()
+	alice, bob := newAccount(t), newAccount(t)
+
+	genesis := chain.hashOf(t, 0)
+	var transfers []common.Hash
+	for n := 0; n < 2; n++ {
+		transfers = append(transfers, chain.transfer(t, alice, bob, 1))
+		chain.Commit()
+	}
+	if err := i.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkBlocks(t, store, chain, 2, 1)
+
+	// No remembered block is left on the chain, so all of them are undone
+	chain.reorg(t, common.HexToHash(genesis), 3)
+	if err := i.detectReorg(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoints(t, store, 0)
+	checkBlocks(t, store, chain)
+	for _, hash := range transfers {
+		if _, err := store.GetTransactionByHash(ctx, hash.Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("orphaned transfer %s: err = %v, want ErrNotFound", hash.Hex(), err)
+		}
+	}
+
+	if err := i.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoints(t, store, 3)
+	checkBlocks(t, store, chain, 3, 2)
+}
+
+func TestIndexRangeReorgedWhileIndexing(t *testing.T) {
+	chain := newTestChain(t)
+	i, store := newTestIndexer(t, chain)
+	ctx := context.Background()
+	alice, bob := newAccount(t), newAccount(t)
+
+	ancestor := chain.Commit()
+	hash := chain.transfer(t, alice, bob, 1)
+	chain.Commit()
+
+	// The chain reorganises between reading the logs and their headers
+	chain.afterFilterLogs = func() { chain.reorg(t, ancestor, 2) }
+	token := i.contracts[0]
+	err := i.indexRange(ctx, token, 1, 2)
+	if err == nil || !strings.Contains(err.Error(), "block 2 changed while indexing") {
+		t.Fatalf("indexRange err = %v, want block 2 changed", err)
+	}
+	if _, err := store.GetCheckpoint(ctx, token.address.Hex()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("checkpoint saved after a failed range: err = %v", err)
+	}
+	if _, err := store.GetTransactionByHash(ctx, hash.Hex()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("transfer from the orphaned block indexed: err = %v", err)
+	}
+	checkBlocks(t, store, chain)
+
+	// Retried, the range is read from the new chain
+	if err := i.indexRange(ctx, token, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if block, err := store.GetCheckpoint(ctx, token.address.Hex()); err != nil || block != 2 {
+		t.Errorf("checkpoint = %d, %v; want 2", block, err)
+	}
+	checkBlocks(t, store, chain, 2)
+}
@@ -0,0 +1,146 @@
+// Package invoices runs the invoice lifecycle in the background. The
+// Worker expires invoices left unpaid past their expiry, and hands every
+// invoice transition, whatever made it, to the subscribed handlers in the
+// order the transitions were recorded.
+//
+// Transitions are read from the invoice_transitions table and marked
+// published in the same database transaction, after the handlers return.
+// A transition is redelivered if a handler fails or the process stops
+// halfway, so handlers must be idempotent.
+package invoices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// sweepBatchSize is how many invoices one expiry query moves
+	sweepBatchSize = 500
+
+	// publishBatchSize is how many transitions one publish reads
+	publishBatchSize = 100
+)
+
+// Handler is called with each invoice transition.
+type Handler func(ctx context.Context, t *repository.InvoiceTransition) error
+
+type Worker struct {
+	config   *config.Config
+	store    *repository.Store
+	handlers []Handler
+}
+
+func New(cfg *config.Config, store *repository.Store) *Worker {
+	return &Worker{
+		config: cfg,
+		store:  store,
+	}
+}
+
+// Subscribe adds a handler for invoice transitions. Call it before Run.
+func (w *Worker) Subscribe(h Handler) {
+	w.handlers = append(w.handlers, h)
+}
+
+// Run expires overdue invoices and publishes transitions until ctx is
+// cancelled, every InvoiceSweepInterval.
+func (w *Worker) Run(ctx context.Context) {
+	logrus.Info("Invoice worker started")
+
+	ticker := time.NewTicker(w.config.InvoiceSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.sweep(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Invoice expiry sweep failed")
+		}
+		if err := w.publish(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Invoice transition publishing failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			logrus.Info("Invoice worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep expires every overdue invoice.
+func (w *Worker) sweep(ctx context.Context) error {
+	for ctx.Err() == nil {
+		expired, err := w.store.ExpireInvoices(ctx, sweepBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to expire invoices: %v", err)
+		}
+		if expired > 0 {
+			logrus.WithField("count", expired).Info("Expired overdue invoices")
+		}
+		if expired < sweepBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// publish hands unpublished transitions to the handlers until none are
+// left or a handler fails.
+func (w *Worker) publish(ctx context.Context) error {
+	for ctx.Err() == nil {
+		more := false
+		err := w.store.WithTx(ctx, func(tx *repository.Tx) error {
+			transitions, err := tx.ListUnpublishedInvoiceTransitions(ctx, publishBatchSize)
+			if err != nil {
+				return fmt.Errorf("failed to list invoice transitions: %v", err)
+			}
+
+			// Mark what was handled even if a later transition fails, so
+			// that it is not redelivered
+			var published []int64
+			var handlerErr error
+			for _, t := range transitions {
+				if handlerErr = w.dispatch(ctx, t); handlerErr != nil {
+					break
+				}
+				published = append(published, t.ID)
+			}
+			if len(published) > 0 {
+				if err := tx.MarkInvoiceTransitionsPublished(ctx, published); err != nil {
+					return fmt.Errorf("failed to mark invoice transitions published: %v", err)
+				}
+			}
+			more = handlerErr == nil && len(transitions) == publishBatchSize
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+	}
+	return nil
+}
+
+// dispatch calls every handler with t.
+func (w *Worker) dispatch(ctx context.Context, t *repository.InvoiceTransition) error {
+	for _, h := range w.handlers {
+		if err := h(ctx, t); err != nil {
+			logrus.WithError(err).WithFields(logrus.Fields{
+				"invoiceId":  t.InvoiceID,
+				"transition": t.ID,
+				"to":         t.To,
+			}).Warn("Invoice transition handler failed, will retry")
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Package lifecycle starts the server's components in the order they were
+// added and stops them in reverse, so a component is stopped before anything
+// it depends on. The HTTP server is added last and drained first; the
+// background workers are stopped next, each finishing or rolling back the
+// database transaction it is in; the stores they write to are closed last.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Component is one part of the server. Start must not block; Stop must
+// return by the deadline of its context. Either may be nil.
+type Component struct {
+	Name  string
+	Start func(ctx context.Context) error
+	Stop  func(ctx context.Context) error
+}
+
+type Manager struct {
+	components []Component
+	started    int
+	failed     chan error
+}
+
+func New() *Manager {
+	return &Manager{failed: make(chan error, 1)}
+}
+
+// Add registers a component. Call it before Start.
+func (m *Manager) Add(c Component) {
+	m.components = append(m.components, c)
+}
+
+// AddWorker registers a background worker that runs until its context is
+// cancelled. Stopping it cancels the context and waits for run to return.
+// The context is not derived from the one passed to Start, so a worker keeps
+// running until its turn to stop comes.
+func (m *Manager) AddWorker(name string, run func(ctx context.Context)) {
+	var cancel context.CancelFunc
+	done := make(chan struct{})
+
+	m.Add(Component{
+		Name: name,
+		Start: func(context.Context) error {
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			go func() {
+				defer close(done)
+				run(ctx)
+			}()
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+			}
+			select {
+			case <-done:
+				return nil
+			default:
+				return fmt.Errorf("did not stop in time: %w", ctx.Err())
+			}
+		},
+	})
+}
+
+// AddCloser registers a resource that is closed when the manager stops.
+func (m *Manager) AddCloser(name string, close func() error) {
+	m.Add(Component{
+		Name: name,
+		Stop: func(context.Context) error { return close() },
+	})
+}
+
+// Fail reports that a running component failed, which makes Run shut
+// everything down. Only the first failure is kept.
+func (m *Manager) Fail(name string, err error) {
+	select {
+	case m.failed <- fmt.Errorf("%s: %w", name, err):
+	default:
+	}
+}
+
+// Start starts every component in order. If one fails, those already
+// started are stopped again.
+func (m *Manager) Start(ctx context.Context) error {
+	for _, c := range m.components {
+		if c.Start != nil {
+			if err := c.Start(ctx); err != nil {
+				err = fmt.Errorf("failed to start %s: %w", c.Name, err)
+				return errors.Join(err, m.Stop(ctx))
+			}
+		}
+		m.started++
+	}
+	return nil
+}
+
+// Stop stops the started components in reverse order. It carries on past
+// failures and returns them all.
+func (m *Manager) Stop(ctx context.Context) error {
+	var errs []error
+	for ; m.started > 0; m.started-- {
+		c := m.components[m.started-1]
+		if c.Stop == nil {
+			continue
+		}
+		logrus.Debugf("Stopping %s", c.Name)
+		if err := c.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", c.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run starts every component and blocks until ctx is cancelled or a
+// component fails, then stops them all, giving up on any still running
+// after shutdownTimeout.
+func (m *Manager) Run(ctx context.Context, shutdownTimeout time.Duration) error {
+	if err := m.Start(ctx); err != nil {
+		return err
+	}
+
+	var failure error
+	select {
+	case <-ctx.Done():
+		logrus.Info("Shutting down...")
+	case failure = <-m.failed:
+		logrus.WithError(failure).Error("Component failed, shutting down")
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return errors.Join(failure, m.Stop(stopCtx))
+}
@@ -0,0 +1,54 @@
+// Package logging carries request-scoped log fields, such as the request ID
+// and the authenticated address, in a context, so that services log them
+// without knowing about the HTTP request they are serving.
+package logging
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Log fields set by the HTTP middleware.
+const (
+	RequestIDField = "requestId"
+	SubjectField   = "subject"
+)
+
+type fieldsKey struct{}
+
+// With returns a copy of ctx that logs key=value in addition to the fields
+// already in ctx.
+func With(ctx context.Context, key string, value interface{}) context.Context {
+	parent, _ := ctx.Value(fieldsKey{}).(logrus.Fields)
+	fields := make(logrus.Fields, len(parent)+1)
+	for k, v := range parent {
+		fields[k] = v
+	}
+	fields[key] = value
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// WithRequestID returns a copy of ctx that logs the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return With(ctx, RequestIDField, id)
+}
+
+// WithSubject returns a copy of ctx that logs the authenticated address.
+func WithSubject(ctx context.Context, subject string) context.Context {
+	return With(ctx, SubjectField, subject)
+}
+
+// RequestID returns the request ID in ctx, or "" if it has none.
+func RequestID(ctx context.Context) string {
+	fields, _ := ctx.Value(fieldsKey{}).(logrus.Fields)
+	id, _ := fields[RequestIDField].(string)
+	return id
+}
+
+// FromContext returns a log entry with ctx's fields. The entry carries ctx,
+// so logrus hooks, such as one exporting to a tracer, can read it too.
+func FromContext(ctx context.Context) *logrus.Entry {
+	fields, _ := ctx.Value(fieldsKey{}).(logrus.Fields)
+	return logrus.WithContext(ctx).WithFields(fields)
+}
@@ -0,0 +1,19 @@
+package logging
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	if got := RequestID(context.Background()); got != "" {
+		t.Errorf("RequestID(no fields) = %q, want empty", got)
+	}
+	if got := RequestID(WithSubject(context.Background(), "0xabc")); got != "" {
+		t.Errorf("RequestID(no request ID) = %q, want empty", got)
+	}
+	ctx := WithSubject(WithRequestID(context.Background(), "req-1"), "0xabc")
+	if got := RequestID(ctx); got != "req-1" {
+		t.Errorf("RequestID = %q, want req-1", got)
+	}
+}
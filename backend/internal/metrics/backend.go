@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"vyra-backend/internal/chain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend is a chain.Backend that times every call and counts the failed
+// ones, labelled with the JSON-RPC method the call makes.
+type Backend struct {
+	backend chain.Backend
+}
+
+// errNoChainID is returned by ChainID when the wrapped backend cannot report
+// it.
+var errNoChainID = errors.New("backend does not report its chain ID")
+
+var (
+	_ chain.Backend       = (*Backend)(nil)
+	_ chain.ChainIDReader = (*Backend)(nil)
+)
+
+// InstrumentBackend wraps backend to record RPC metrics.
+func InstrumentBackend(backend chain.Backend) *Backend {
+	return &Backend{backend: backend}
+}
+
+// observe records a call started at start. A missing block, transaction or
+// receipt and a cancelled call are not counted as errors.
+func observe(method string, start time.Time, err error) {
+	rpcRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil && !errors.Is(err, ethereum.NotFound) && !errors.Is(err, context.Canceled) {
+		rpcErrors.WithLabelValues(method).Inc()
+	}
+}
+
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	defer func(start time.Time) { observe("eth_getCode", start, err) }(time.Now())
+	return b.backend.CodeAt(ctx, contract, blockNumber)
+}
+
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	defer func(start time.Time) { observe("eth_call", start, err) }(time.Now())
+	return b.backend.CallContract(ctx, call, blockNumber)
+}
+
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	defer func(start time.Time) { observe("eth_getBlockByNumber", start, err) }(time.Now())
+	return b.backend.HeaderByNumber(ctx, number)
+}
+
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	defer func(start time.Time) { observe("eth_getCode", start, err) }(time.Now())
+	return b.backend.PendingCodeAt(ctx, account)
+}
+
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	defer func(start time.Time) { observe("eth_getTransactionCount", start, err) }(time.Now())
+	return b.backend.PendingNonceAt(ctx, account)
+}
+
+func (b *Backend) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	defer func(start time.Time) { observe("eth_gasPrice", start, err) }(time.Now())
+	return b.backend.SuggestGasPrice(ctx)
+}
+
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	defer func(start time.Time) { observe("eth_maxPriorityFeePerGas", start, err) }(time.Now())
+	return b.backend.SuggestGasTipCap(ctx)
+}
+
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	defer func(start time.Time) { observe("eth_estimateGas", start, err) }(time.Now())
+	return b.backend.EstimateGas(ctx, call)
+}
+
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	defer func(start time.Time) { observe("eth_sendRawTransaction", start, err) }(time.Now())
+	return b.backend.SendTransaction(ctx, tx)
+}
+
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	defer func(start time.Time) { observe("eth_getLogs", start, err) }(time.Now())
+	return b.backend.FilterLogs(ctx, query)
+}
+
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	defer func(start time.Time) { observe("eth_subscribe", start, err) }(time.Now())
+	return b.backend.SubscribeFilterLogs(ctx, query, ch)
+}
+
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	defer func(start time.Time) { observe("eth_getTransactionReceipt", start, err) }(time.Now())
+	return b.backend.TransactionReceipt(ctx, txHash)
+}
+
+func (b *Backend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	defer func(start time.Time) { observe("eth_getBalance", start, err) }(time.Now())
+	return b.backend.BalanceAt(ctx, account, blockNumber)
+}
+
+func (b *Backend) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	defer func(start time.Time) { observe("eth_getTransactionByHash", start, err) }(time.Now())
+	return b.backend.TransactionByHash(ctx, hash)
+}
+
+func (b *Backend) ChainID(ctx context.Context) (id *big.Int, err error) {
+	reader, ok := b.backend.(chain.ChainIDReader)
+	if !ok {
+		return nil, errNoChainID
+	}
+	defer func(start time.Time) { observe("eth_chainId", start, err) }(time.Now())
+	return reader.ChainID(ctx)
+}
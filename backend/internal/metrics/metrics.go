@@ -0,0 +1,149 @@
+// Package metrics defines the Prometheus metrics the backend exports on
+// /metrics: request latency by route, Ethereum RPC calls, indexer progress,
+// invoice transitions, and totals read from the database on each scrape.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"vyra-backend/internal/repository"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+const namespace = "vyra"
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	rpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_duration_seconds",
+		Help:      "Latency of Ethereum JSON-RPC calls by method.",
+		Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method"})
+
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_errors_total",
+		Help:      "Failed Ethereum JSON-RPC calls by method.",
+	}, []string{"method"})
+
+	indexerHeadBlock = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "indexer_head_block",
+		Help:      "Latest block number the indexer has seen on the chain.",
+	})
+
+	indexerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "indexer_lag_blocks",
+		Help:      "Blocks between the chain head and the last block indexed, by contract.",
+	}, []string{"contract"})
+
+	invoiceTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "invoice_transitions_total",
+		Help:      "Invoice status changes by new status and reason.",
+	}, []string{"status", "reason"})
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHTTPRequest records a request served by route, the pattern it
+// matched rather than its path, so each route is one series.
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// ObserveIndexer records the chain head and how far behind it a contract's
+// checkpoint is.
+func ObserveIndexer(contract string, head, indexed int64) {
+	indexerHeadBlock.Set(float64(head))
+	lag := head - indexed
+	if lag < 0 {
+		lag = 0
+	}
+	indexerLag.WithLabelValues(contract).Set(float64(lag))
+}
+
+// InvoiceTransition counts an invoice transition. It subscribes to the
+// invoices worker, so a transition redelivered after a failed publish may
+// be counted twice.
+func InvoiceTransition(ctx context.Context, t *repository.InvoiceTransition) error {
+	invoiceTransitions.WithLabelValues(t.To, t.Reason).Inc()
+	return nil
+}
+
+// RegisterStore exports the invoice, refund, bridge and paymaster totals in
+// store, read on every scrape.
+func RegisterStore(store *repository.Store) {
+	prometheus.MustRegister(&storeCollector{store: store})
+}
+
+// storeCollector reads DomainStats when scraped. A failed read is logged
+// and leaves the totals out rather than failing the scrape.
+type storeCollector struct {
+	store *repository.Store
+}
+
+var (
+	invoicesDesc = prometheus.NewDesc(namespace+"_invoices",
+		"Invoices by status.", []string{"status"}, nil)
+	refundsDesc = prometheus.NewDesc(namespace+"_refunds",
+		"Refunds by status.", []string{"status"}, nil)
+	bridgeTransfersDesc = prometheus.NewDesc(namespace+"_bridge_transfers",
+		"Bridge deposits and withdrawals by direction and status.", []string{"direction", "status"}, nil)
+	sponsoredGasDesc = prometheus.NewDesc(namespace+"_paymaster_sponsored_gas_total",
+		"Gas sponsored by the paymaster.", nil, nil)
+	vyrSpentDesc = prometheus.NewDesc(namespace+"_paymaster_vyr_spent_total",
+		"VYR spent by the paymaster on sponsored gas.", nil, nil)
+)
+
+// scrapeTimeout bounds the database queries of one scrape.
+const scrapeTimeout = 5 * time.Second
+
+func (c *storeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- invoicesDesc
+	ch <- refundsDesc
+	ch <- bridgeTransfersDesc
+	ch <- sponsoredGasDesc
+	ch <- vyrSpentDesc
+}
+
+func (c *storeCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	stats, err := c.store.GetDomainStats(ctx)
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to read domain metrics")
+		return
+	}
+
+	for status, count := range stats.Invoices {
+		ch <- prometheus.MustNewConstMetric(invoicesDesc, prometheus.GaugeValue, float64(count), status)
+	}
+	for status, count := range stats.Refunds {
+		ch <- prometheus.MustNewConstMetric(refundsDesc, prometheus.GaugeValue, float64(count), status)
+	}
+	for _, b := range stats.Bridge {
+		ch <- prometheus.MustNewConstMetric(bridgeTransfersDesc, prometheus.GaugeValue, float64(b.Count), b.Direction, b.Status)
+	}
+	ch <- prometheus.MustNewConstMetric(sponsoredGasDesc, prometheus.CounterValue, float64(stats.SponsoredGas))
+	ch <- prometheus.MustNewConstMetric(vyrSpentDesc, prometheus.CounterValue, stats.VYRSpent)
+}
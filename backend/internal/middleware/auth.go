@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/auth"
+	"vyra-backend/internal/logging"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Gin context keys set by Authenticate
+const (
+	// subjectKey holds the authenticated address
+	subjectKey = "auth.subject"
+
+	// scopesKey holds the scopes of the API key used, and is unset for a
+	// Sign-In with Ethereum session
+	scopesKey = "auth.scopes"
+
+	// failureKey holds the reason credentials were rejected
+	failureKey = "auth.failure"
+
+	// apiKeyIDKey holds a non-secret identifier of the API key used
+	apiKeyIDKey = "auth.apiKey"
+)
+
+// APIKeyHeader carries an API key. Keys are also accepted as bearer tokens.
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator verifies an access token and returns the address it was
+// issued to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// KeyAuthenticator verifies an API key and returns the merchant address it
+// acts for and its scopes.
+type KeyAuthenticator interface {
+	AuthenticateKey(ctx context.Context, key string) (string, []string, error)
+}
+
+// Authenticate identifies the caller from an "Authorization: Bearer" access
+// token or API key, or an X-API-Key header, for Subject and the Require
+// middleware. Requests without credentials, or with rejected ones, go on
+// unauthenticated, so public routes ignore a stale token; the Require
+// middleware turns them away with the reason. The authenticated address is
+// added to the request context's log fields.
+func Authenticate(sessions Authenticator, keys KeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		credential := c.GetHeader(APIKeyHeader)
+		if credential == "" {
+			credential, _ = bearerToken(c.GetHeader("Authorization"))
+		}
+		if credential == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		var subject string
+		var scopes []string
+		var err error
+		if auth.IsAPIKey(credential) {
+			subject, scopes, err = keys.AuthenticateKey(ctx, credential)
+		} else {
+			subject, err = sessions.Authenticate(ctx, credential)
+		}
+
+		switch {
+		case errors.Is(err, auth.ErrInvalidAPIKey):
+			c.Set(failureKey, "Invalid or revoked API key")
+		case err != nil && !errors.Is(err, auth.ErrInvalidToken):
+			apierror.Respond(c, err, "Failed to authenticate request")
+			return
+		case err != nil:
+			c.Set(failureKey, "Invalid or expired access token")
+		default:
+			c.Set(subjectKey, subject)
+			c.Request = c.Request.WithContext(logging.WithSubject(ctx, subject))
+			if scopes != nil {
+				c.Set(scopesKey, scopes)
+				c.Set(apiKeyIDKey, auth.HashSecret(credential)[:16])
+			}
+		}
+		c.Next()
+	}
+}
+
+// RequireSession rejects requests not signed in with Sign-In with Ethereum.
+// API keys are not accepted.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !authenticated(c) {
+			return
+		}
+		if _, ok := c.Get(scopesKey); ok {
+			apierror.Abort(c, apierror.Forbidden("API keys cannot be used here; sign in instead"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireScope rejects requests that are neither signed in nor made with an
+// API key holding scope.
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !authenticated(c) {
+			return
+		}
+		if !HasScope(c, scope) {
+			apierror.Abort(c, apierror.Forbidden("API key lacks the "+scope+" scope"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAddress rejects requests whose path parameter param is not the
+// authenticated address. It must run after RequireSession or RequireScope.
+func RequireAddress(param string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsSubject(c, c.Param(param)) {
+			apierror.Abort(c, apierror.Forbidden("Not authorized for this address"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// Subject returns the authenticated address, or "" if the request was not
+// authenticated.
+func Subject(c *gin.Context) string {
+	return c.GetString(subjectKey)
+}
+
+// IsSubject reports whether address is the authenticated address. Addresses
+// are compared case-insensitively, as checksumming only changes case.
+func IsSubject(c *gin.Context, address string) bool {
+	subject := Subject(c)
+	return subject != "" && strings.EqualFold(subject, address)
+}
+
+// HasScope reports whether the request may perform operations in scope: it
+// is signed in, or made with an API key holding scope.
+func HasScope(c *gin.Context, scope string) bool {
+	if Subject(c) == "" {
+		return false
+	}
+	scopes, ok := c.Get(scopesKey)
+	if !ok {
+		return true
+	}
+	for _, s := range scopes.([]string) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticated responds 401 and returns false if Authenticate did not
+// identify the caller.
+func authenticated(c *gin.Context) bool {
+	if Subject(c) != "" {
+		return true
+	}
+	message := "Missing bearer token or API key"
+	if failure := c.GetString(failureKey); failure != "" {
+		message = failure
+	}
+	c.Header("WWW-Authenticate", `Bearer realm="vyra"`)
+	apierror.Abort(c, apierror.Unauthorized(message))
+	return false
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
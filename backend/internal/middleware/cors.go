@@ -2,21 +2,158 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/config"
 
 	"github.com/gin-gonic/gin"
 )
 
-func CORS() gin.HandlerFunc {
+// CORSRule applies Policy to requests whose path starts with PathPrefix.
+type CORSRule struct {
+	PathPrefix string
+	Policy     config.CORSPolicy
+}
+
+// CORS answers cross-origin requests by the policy of the longest matching
+// rule, or policy if none matches. Requests without an Origin header are
+// not cross-origin and pass through. A request from an origin the policy
+// does not allow is refused with 403, preflight or not, so that a page
+// elsewhere cannot make even a simple request; an allowed preflight is
+// answered with 204. It runs on the router rather than on route groups so
+// that it sees preflights for every route.
+func CORS(policy config.CORSPolicy, rules ...CORSRule) gin.HandlerFunc {
+	fallback := newCORSPolicy(policy)
+	type rule struct {
+		prefix string
+		policy *corsPolicy
+	}
+	compiled := make([]rule, len(rules))
+	for i, r := range rules {
+		compiled[i] = rule{r.PathPrefix, newCORSPolicy(r.Policy)}
+	}
+
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		p, longest := fallback, -1
+		for _, r := range compiled {
+			if strings.HasPrefix(c.Request.URL.Path, r.prefix) && len(r.prefix) > longest {
+				p, longest = r.policy, len(r.prefix)
+			}
+		}
 
-		if c.Request.Method == "OPTIONS" {
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+		c.Writer.Header().Add("Vary", "Origin")
+		if preflight {
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Method")
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		if !p.allows(origin) {
+			apierror.Abort(c, apierror.New(http.StatusForbidden, apierror.CodeOriginNotAllowed, "Origin not allowed"))
+			return
+		}
+
+		if p.anyOrigin && !p.credentials {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+		if p.credentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			c.Header("Access-Control-Allow-Methods", p.methods)
+			c.Header("Access-Control-Allow-Headers", p.headers)
+			if p.maxAge != "" {
+				c.Header("Access-Control-Max-Age", p.maxAge)
+			}
 			c.AbortWithStatus(http.StatusNoContent)
 			return
 		}
 
+		if p.exposed != "" {
+			c.Header("Access-Control-Expose-Headers", p.exposed)
+		}
 		c.Next()
 	}
 }
+
+// corsPolicy is a config.CORSPolicy prepared for matching and headers.
+type corsPolicy struct {
+	anyOrigin   bool
+	exact       map[string]bool
+	patterns    [][2]string
+	credentials bool
+	methods     string
+	headers     string
+	exposed     string
+	maxAge      string
+}
+
+func newCORSPolicy(policy config.CORSPolicy) *corsPolicy {
+	p := &corsPolicy{
+		exact:       make(map[string]bool),
+		credentials: policy.AllowCredentials,
+		methods:     strings.Join(policy.AllowedMethods, ", "),
+		headers:     strings.Join(policy.AllowedHeaders, ", "),
+		exposed:     strings.Join(policy.ExposedHeaders, ", "),
+	}
+	if policy.MaxAge > 0 {
+		p.maxAge = strconv.Itoa(int(policy.MaxAge.Seconds()))
+	}
+	for _, origin := range policy.AllowedOrigins {
+		origin = strings.ToLower(strings.TrimSuffix(origin, "/"))
+		switch {
+		case origin == "*":
+			p.anyOrigin = true
+		case strings.Contains(origin, "*"):
+			prefix, suffix, _ := strings.Cut(origin, "*")
+			p.patterns = append(p.patterns, [2]string{prefix, suffix})
+		default:
+			p.exact[origin] = true
+		}
+	}
+	return p
+}
+
+// allows reports whether origin is allowed. A pattern's "*" matches one or
+// more characters that can appear in a host name, so "https://*.vyra.app"
+// matches "https://pay.vyra.app" but not "https://evil.com/.vyra.app".
+func (p *corsPolicy) allows(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	if p.exact[origin] {
+		return true
+	}
+	for _, pattern := range p.patterns {
+		prefix, suffix := pattern[0], pattern[1]
+		if len(origin) <= len(prefix)+len(suffix) ||
+			!strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+			continue
+		}
+		if isHostRun(origin[len(prefix) : len(origin)-len(suffix)]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isHostRun(s string) bool {
+	for _, c := range s {
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.') {
+			return false
+		}
+	}
+	return true
+}
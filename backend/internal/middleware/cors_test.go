@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCORSPolicyAllows(t *testing.T) {
+	p := newCORSPolicy(config.CORSPolicy{AllowedOrigins: []string{
+		"https://app.vyra.io/",
+		"https://*.vyra.app",
+		"http://localhost:*",
+	}})
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"https://app.vyra.io", true},
+		{"HTTPS://APP.VYRA.IO", true},
+		{"http://app.vyra.io", false},
+		{"https://app.vyra.io.evil.com", false},
+		{"https://pay.vyra.app", true},
+		{"https://a.b.vyra.app", true},
+		{"https://Pay.Vyra.App", true},
+		{"https://.vyra.app", false},
+		{"https://vyra.app", false},
+		{"https://evil.com/.vyra.app", false},
+		{"https://evil.com?.vyra.app", false},
+		{"https://evil_com.vyra.app", false},
+		{"https://pay.vyra.app.evil.com", false},
+		{"http://localhost:3000", true},
+		{"http://localhost:", false},
+		{"https://example.com", false},
+		{"null", false},
+	}
+	for _, tt := range tests {
+		if got := p.allows(tt.origin); got != tt.want {
+			t.Errorf("allows(%q) = %v, want %v", tt.origin, got, tt.want)
+		}
+	}
+
+	if !newCORSPolicy(config.CORSPolicy{AllowedOrigins: []string{"*"}}).allows("https://anything.example") {
+		t.Error(`"*" does not allow every origin`)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CORS(
+		config.CORSPolicy{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"GET", "POST"},
+			AllowedHeaders: []string{"Content-Type"},
+			ExposedHeaders: []string{"X-Request-ID"},
+			MaxAge:         10 * time.Minute,
+		},
+		CORSRule{PathPrefix: "/admin", Policy: config.CORSPolicy{
+			AllowedOrigins:   []string{"https://admin.vyra.io"},
+			AllowCredentials: true,
+			AllowedMethods:   []string{"GET"},
+		}},
+	))
+	router.GET("/pay", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/admin/users", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		origin    string
+		preflight bool
+		status    int
+		headers   map[string]string
+	}{
+		{
+			name: "same origin", method: "GET", path: "/pay",
+			status:  http.StatusOK,
+			headers: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "any origin", method: "GET", path: "/pay", origin: "https://shop.example",
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin":   "*",
+				"Access-Control-Expose-Headers": "X-Request-ID",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			name: "preflight", method: "OPTIONS", path: "/pay", origin: "https://shop.example", preflight: true,
+			status: http.StatusNoContent,
+			headers: map[string]string{
+				"Access-Control-Allow-Methods": "GET, POST",
+				"Access-Control-Allow-Headers": "Content-Type",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			name: "credentialed rule echoes the origin", method: "GET", path: "/admin/users", origin: "https://admin.vyra.io",
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin":      "https://admin.vyra.io",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		{
+			name: "rule refuses other origins", method: "GET", path: "/admin/users", origin: "https://shop.example",
+			status:  http.StatusForbidden,
+			headers: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name: "rule refuses other origins' preflights", method: "OPTIONS", path: "/admin/users", origin: "https://shop.example", preflight: true,
+			status: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.origin != "" {
+			req.Header.Set("Origin", tt.origin)
+		}
+		if tt.preflight {
+			req.Header.Set("Access-Control-Request-Method", "GET")
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.status)
+		}
+		for name, want := range tt.headers {
+			if got := w.Header().Get(name); got != want {
+				t.Errorf("%s: %s = %q, want %q", tt.name, name, got, want)
+			}
+		}
+	}
+}
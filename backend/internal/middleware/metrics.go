@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"time"
+
+	"vyra-backend/internal/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute is the route and method label of requests that matched no
+// route, so unknown paths and methods do not each become a series.
+const unmatchedRoute = "unmatched"
+
+// Metrics records the latency and status of every request by route.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		method := c.Request.Method
+		if route == "" {
+			route, method = unmatchedRoute, unmatchedRoute
+		}
+		metrics.ObserveHTTPRequest(method, route, c.Writer.Status(), time.Since(start))
+	}
+}
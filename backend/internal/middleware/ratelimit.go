@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit refuses requests with 429 once the caller has used up limit.
+// Callers are told apart by API key, signed-in address or, failing those,
+// client IP, so it must run after Authenticate. name keeps limits apart, so
+// a route can have its own limit on top of its group's. Every response
+// carries X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset,
+// the seconds until the caller's allowance is full again. A nil store
+// disables limiting, and a store that fails lets the request through.
+func RateLimit(store ratelimit.Store, name string, limit ratelimit.Limit) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if store == nil {
+			c.Next()
+			return
+		}
+
+		result, err := store.Take(c.Request.Context(), name+":"+caller(c), limit)
+		if err != nil {
+			logging.FromContext(c.Request.Context()).WithError(err).WithField("limit", name).Warn("Rate limit check failed, allowing request")
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(seconds(result.Reset)))
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(seconds(result.RetryAfter)))
+			apierror.Abort(c, apierror.New(http.StatusTooManyRequests, apierror.CodeRateLimited, "Rate limit exceeded"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// caller identifies who a request counts against.
+func caller(c *gin.Context) string {
+	if key := c.GetString(apiKeyIDKey); key != "" {
+		return "key:" + key
+	}
+	if subject := Subject(c); subject != "" {
+		return "address:" + subject
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// seconds rounds d up to whole seconds.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
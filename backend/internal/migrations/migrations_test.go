@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Fatalf("migration %04d_%s: want version %d, versions must be contiguous from 1", m.Version, m.Name, i+1)
+		}
+		if strings.TrimSpace(m.up) == "" || strings.TrimSpace(m.down) == "" {
+			t.Errorf("migration %04d_%s has an empty up or down script", m.Version, m.Name)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	file := &fstest.MapFile{Data: []byte("SELECT 1;")}
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+		err   string
+	}{
+		{"sorted by version", fstest.MapFS{
+			"sql/0002_b.up.sql": file, "sql/0002_b.down.sql": file,
+			"sql/0001_a.up.sql": file, "sql/0001_a.down.sql": file,
+		}, ""},
+		{"missing down", fstest.MapFS{"sql/0001_a.up.sql": file}, "needs both up and down"},
+		{"conflicting names", fstest.MapFS{
+			"sql/0001_a.up.sql": file, "sql/0001_b.down.sql": file,
+		}, "conflicting names"},
+		{"unexpected file", fstest.MapFS{"sql/README.md": file}, "unexpected migration file"},
+	}
+	for _, tt := range tests {
+		migrations, err := load(tt.files)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			} else if len(migrations) != 2 || migrations[0].Version != 1 || migrations[1].Name != "b" {
+				t.Errorf("%s: load = %+v", tt.name, migrations)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: error = %v, want one containing %q", tt.name, err, tt.err)
+		}
+	}
+}
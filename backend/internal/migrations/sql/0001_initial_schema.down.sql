@@ -0,0 +1,13 @@
+DROP VIEW IF EXISTS merchant_stats;
+DROP VIEW IF EXISTS user_balances;
+
+DROP TABLE IF EXISTS paymaster_sponsorships;
+DROP TABLE IF EXISTS session_keys;
+DROP TABLE IF EXISTS bridge_transactions;
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS wallets;
+DROP TABLE IF EXISTS users;
+
+DROP FUNCTION IF EXISTS update_updated_at_column();
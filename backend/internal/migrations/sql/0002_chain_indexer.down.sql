@@ -0,0 +1,57 @@
+DROP INDEX IF EXISTS idx_paymaster_sponsorships_block_number;
+DROP INDEX IF EXISTS idx_session_keys_block_number;
+DROP INDEX IF EXISTS idx_bridge_transactions_block_number;
+DROP INDEX IF EXISTS idx_payments_block_number;
+DROP INDEX IF EXISTS idx_invoices_block_number;
+DROP INDEX IF EXISTS idx_transactions_block_number;
+
+DROP INDEX IF EXISTS idx_paymaster_sponsorships_log;
+ALTER TABLE paymaster_sponsorships
+    DROP COLUMN block_number,
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN source;
+
+ALTER TABLE session_keys
+    DROP COLUMN block_number,
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN revoked_block,
+    DROP COLUMN source;
+
+DROP INDEX IF EXISTS idx_bridge_transactions_onchain_id;
+ALTER TABLE bridge_transactions
+    DROP COLUMN onchain_id,
+    DROP COLUMN block_number,
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN source;
+
+DROP TABLE IF EXISTS payment_splits;
+
+ALTER TABLE payments
+    DROP COLUMN block_number,
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN refunded_block,
+    DROP COLUMN refund_amount,
+    DROP COLUMN source;
+
+ALTER TABLE invoices
+    DROP COLUMN block_number,
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN paid_block,
+    DROP COLUMN source;
+
+-- Restoring UNIQUE(hash) fails if a transaction has several indexed
+-- transfers; delete the extra rows before rolling back.
+DROP INDEX IF EXISTS idx_transactions_hash_log_index;
+ALTER TABLE transactions
+    DROP COLUMN block_hash,
+    DROP COLUMN log_index,
+    DROP COLUMN source,
+    ADD CONSTRAINT transactions_hash_key UNIQUE (hash);
+
+DROP TABLE IF EXISTS indexer_blocks;
+DROP TABLE IF EXISTS indexer_checkpoints;
@@ -0,0 +1,82 @@
+-- Chain indexer: per-contract checkpoints, recently indexed block hashes for
+-- reorg detection, and the block position of every row written from a log.
+-- source is 'api' for rows created through the API and 'chain' for rows the
+-- indexer discovered on its own.
+
+CREATE TABLE indexer_checkpoints (
+    contract_address VARCHAR(42) PRIMARY KEY,
+    name VARCHAR(32) NOT NULL,
+    block_number BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE indexer_blocks (
+    block_number BIGINT PRIMARY KEY,
+    block_hash VARCHAR(66) NOT NULL
+);
+
+ALTER TABLE transactions
+    DROP CONSTRAINT transactions_hash_key,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+-- A transaction emits one Transfer per token movement
+CREATE UNIQUE INDEX idx_transactions_hash_log_index ON transactions(hash, COALESCE(log_index, -1));
+
+ALTER TABLE invoices
+    ADD COLUMN block_number BIGINT,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN paid_block BIGINT,
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+ALTER TABLE payments
+    ADD COLUMN block_number BIGINT,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN refunded_block BIGINT,
+    ADD COLUMN refund_amount DECIMAL(36, 18),
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+CREATE TABLE payment_splits (
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    recipient_address VARCHAR(42) NOT NULL,
+    amount DECIMAL(36, 18) NOT NULL,
+    PRIMARY KEY (payment_id, position)
+);
+
+ALTER TABLE bridge_transactions
+    ADD COLUMN onchain_id VARCHAR(66),
+    ADD COLUMN block_number BIGINT,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+CREATE UNIQUE INDEX idx_bridge_transactions_onchain_id ON bridge_transactions(onchain_id);
+
+ALTER TABLE session_keys
+    ADD COLUMN block_number BIGINT,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN revoked_block BIGINT,
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+ALTER TABLE paymaster_sponsorships
+    ADD COLUMN block_number BIGINT,
+    ADD COLUMN block_hash VARCHAR(66),
+    ADD COLUMN log_index INTEGER,
+    ADD COLUMN source VARCHAR(10) NOT NULL DEFAULT 'api';
+
+CREATE UNIQUE INDEX idx_paymaster_sponsorships_log ON paymaster_sponsorships(transaction_hash, log_index);
+
+CREATE INDEX idx_transactions_block_number ON transactions(block_number);
+CREATE INDEX idx_invoices_block_number ON invoices(block_number);
+CREATE INDEX idx_payments_block_number ON payments(block_number);
+CREATE INDEX idx_bridge_transactions_block_number ON bridge_transactions(block_number);
+CREATE INDEX idx_session_keys_block_number ON session_keys(block_number);
+CREATE INDEX idx_paymaster_sponsorships_block_number ON paymaster_sponsorships(block_number);
+
+CREATE TRIGGER update_indexer_checkpoints_updated_at BEFORE UPDATE ON indexer_checkpoints
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
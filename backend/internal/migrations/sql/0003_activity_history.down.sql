@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_bridge_transactions_created_at;
+DROP INDEX IF EXISTS idx_payments_created_at;
+DROP INDEX IF EXISTS idx_paymaster_sponsorships_created_at;
+DROP INDEX IF EXISTS idx_payment_splits_recipient_address;
+
+ALTER TABLE payments
+    DROP COLUMN tx_hash,
+    DROP COLUMN refund_tx_hash;
@@ -0,0 +1,11 @@
+-- Transaction hashes for indexed payments and refunds, and indexes for the
+-- per-address activity history.
+
+ALTER TABLE payments
+    ADD COLUMN tx_hash VARCHAR(66),
+    ADD COLUMN refund_tx_hash VARCHAR(66);
+
+CREATE INDEX idx_payment_splits_recipient_address ON payment_splits(recipient_address);
+CREATE INDEX idx_paymaster_sponsorships_created_at ON paymaster_sponsorships(created_at);
+CREATE INDEX idx_payments_created_at ON payments(created_at);
+CREATE INDEX idx_bridge_transactions_created_at ON bridge_transactions(created_at);
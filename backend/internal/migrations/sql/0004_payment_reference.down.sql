@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS payment_references;
+
+DROP INDEX IF EXISTS idx_payments_customer_reference;
+DROP INDEX IF EXISTS idx_payments_reference;
+
+ALTER TABLE payments DROP COLUMN reference;
@@ -0,0 +1,32 @@
+-- Client references, such as a marketplace order ID, for payments created
+-- through the API.
+
+ALTER TABLE payments ADD COLUMN reference VARCHAR(255);
+
+CREATE INDEX idx_payments_reference ON payments(reference);
+
+-- A customer's reference names one payment; a retried request finds it
+-- instead of charging the customer again.
+CREATE UNIQUE INDEX idx_payments_customer_reference ON payments(customer_address, reference);
+
+-- Split payment requests, claimed by customer and reference before the
+-- relayer submits them. VyraPOS split payments carry no nonce, so this is
+-- what stops a retry from paying twice. request_hash is the authorization
+-- hash the customer signed, so a reference reused for a different payment is
+-- refused.
+CREATE TABLE payment_references (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    customer_address VARCHAR(42) NOT NULL,
+    reference VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(66) NOT NULL,
+    status VARCHAR(20) DEFAULT 'pending',
+    error TEXT,
+    tx_hash VARCHAR(66),
+    payment_id VARCHAR(64),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (customer_address, reference)
+);
+
+CREATE TRIGGER update_payment_references_updated_at BEFORE UPDATE ON payment_references
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
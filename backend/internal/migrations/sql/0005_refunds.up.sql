@@ -0,0 +1,30 @@
+-- Refunds of POS payments. API rows are refund requests keyed by the
+-- client's idempotency key, so a retried request finds the refund it already
+-- started; chain rows are refunds the indexer found that were not made
+-- through the API. payment_id is the on-chain payment ID, which may not have
+-- been indexed yet.
+
+CREATE TABLE refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id VARCHAR(64) NOT NULL,
+    idempotency_key VARCHAR(255) UNIQUE,
+    amount DECIMAL(36, 18) NOT NULL,
+    reason TEXT,
+    status VARCHAR(20) DEFAULT 'pending',
+    error TEXT,
+    tx_hash VARCHAR(66),
+    block_number BIGINT,
+    block_hash VARCHAR(66),
+    log_index INTEGER,
+    source VARCHAR(10) NOT NULL DEFAULT 'api',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_refunds_payment_id ON refunds(payment_id);
+CREATE INDEX idx_refunds_block_number ON refunds(block_number);
+
+CREATE TRIGGER update_refunds_updated_at BEFORE UPDATE ON refunds
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE UNIQUE INDEX idx_refunds_tx_hash_log_index ON refunds(tx_hash, log_index);
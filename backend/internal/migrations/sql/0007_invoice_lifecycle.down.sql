@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS invoice_transitions;
+
+DROP INDEX IF EXISTS idx_invoices_open_expiry;
+DROP INDEX IF EXISTS idx_invoices_tx_hash;
+
+ALTER TABLE invoices
+    DROP CONSTRAINT IF EXISTS invoices_status_check,
+    ALTER COLUMN status DROP NOT NULL,
+    ALTER COLUMN status SET DEFAULT 'pending';
+
+UPDATE invoices SET status = 'paid' WHERE status = 'refunded';
+UPDATE invoices SET status = 'pending' WHERE status IN ('created', 'pending_payment', 'expired', 'cancelled');
+
+ALTER TABLE invoices DROP COLUMN tx_hash;
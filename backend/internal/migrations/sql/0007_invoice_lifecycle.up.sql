@@ -0,0 +1,41 @@
+-- Invoice lifecycle. An invoice is 'created' when its createInvoice
+-- transaction is broadcast through the API, 'pending_payment' once its
+-- InvoiceCreated log is seen, then 'paid' and possibly 'refunded', or
+-- 'expired' or 'cancelled' if it is never paid. tx_hash is the createInvoice
+-- transaction, which lets the indexer find a 'created' row before its
+-- on-chain invoice ID is known.
+--
+-- Every status change is recorded in invoice_transitions. published_at is
+-- set once the change has been handed to the backend's subscribers.
+
+ALTER TABLE invoices ADD COLUMN tx_hash VARCHAR(66);
+
+UPDATE invoices SET status = 'pending_payment' WHERE status = 'pending' OR status IS NULL;
+UPDATE invoices SET status = 'refunded'
+    FROM payments p
+    WHERE p.invoice_id = invoices.id AND p.status = 'refunded' AND invoices.status = 'paid';
+
+ALTER TABLE invoices
+    ALTER COLUMN status SET DEFAULT 'created',
+    ALTER COLUMN status SET NOT NULL,
+    ADD CONSTRAINT invoices_status_check
+        CHECK (status IN ('created', 'pending_payment', 'paid', 'refunded', 'expired', 'cancelled'));
+
+CREATE INDEX idx_invoices_tx_hash ON invoices(tx_hash);
+CREATE INDEX idx_invoices_open_expiry ON invoices(expiry)
+    WHERE status IN ('created', 'pending_payment');
+
+CREATE TABLE invoice_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    from_status VARCHAR(20), -- NULL when the invoice is first recorded
+    to_status VARCHAR(20) NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    block_number BIGINT,
+    published_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_invoice_transitions_invoice_id ON invoice_transitions(invoice_id);
+CREATE INDEX idx_invoice_transitions_unpublished ON invoice_transitions(id)
+    WHERE published_at IS NULL;
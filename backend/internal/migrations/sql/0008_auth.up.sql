@@ -0,0 +1,28 @@
+-- Sign-In with Ethereum. auth_nonces holds the nonces handed out for
+-- sign-in messages; each is consumed by the login that uses it. A login
+-- issues a short-lived JWT access token and a refresh token, stored here
+-- only as a SHA-256 hash. Refreshing rotates the token: the old one is
+-- revoked and points at its replacement, and every token descended from one
+-- login shares a family_id so that reuse of a rotated token revokes them all.
+
+CREATE TABLE auth_nonces (
+    nonce VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_auth_nonces_expires_at ON auth_nonces(expires_at);
+
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    family_id UUID NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    replaced_by UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+CREATE INDEX idx_refresh_tokens_address ON refresh_tokens(address);
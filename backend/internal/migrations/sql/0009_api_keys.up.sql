@@ -0,0 +1,19 @@
+-- API keys for merchant backends. Each key acts for one merchant address
+-- within its scopes. Only a SHA-256 hash of the key is stored, with the
+-- start of it (prefix) to tell keys apart. Rotating a key revokes it and
+-- points replaced_by at the key issued in its place.
+
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_address VARCHAR(42) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(32) NOT NULL,
+    key_hash VARCHAR(64) UNIQUE NOT NULL,
+    scopes TEXT[] NOT NULL,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    replaced_by UUID REFERENCES api_keys(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_keys_merchant_address ON api_keys(merchant_address);
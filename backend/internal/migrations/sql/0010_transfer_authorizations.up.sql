@@ -0,0 +1,13 @@
+-- EIP-712 transfer authorizations the relayer has submitted. A signature
+-- stays valid until its deadline, so each (from_address, nonce) is claimed
+-- here before transferFrom is sent, and the claim outlives restarts and is
+-- shared between replicas.
+
+CREATE TABLE transfer_authorizations (
+    from_address VARCHAR(42) NOT NULL,
+    nonce VARCHAR(78) NOT NULL,
+    deadline TIMESTAMP NOT NULL,
+    tx_hash VARCHAR(66),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (from_address, nonce)
+);
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_paymaster_sponsorships_occurred_at;
+DROP INDEX IF EXISTS idx_bridge_transactions_occurred_at;
+DROP INDEX IF EXISTS idx_payments_occurred_at;
+DROP INDEX IF EXISTS idx_invoices_occurred_at;
+DROP INDEX IF EXISTS idx_transactions_occurred_at;
+
+ALTER TABLE refunds DROP COLUMN block_timestamp;
+ALTER TABLE paymaster_sponsorships DROP COLUMN block_timestamp;
+ALTER TABLE session_keys DROP COLUMN block_timestamp;
+ALTER TABLE bridge_transactions DROP COLUMN block_timestamp;
+ALTER TABLE payments
+    DROP COLUMN block_timestamp,
+    DROP COLUMN refunded_at;
+ALTER TABLE invoices DROP COLUMN block_timestamp;
+ALTER TABLE transactions DROP COLUMN block_timestamp;
@@ -0,0 +1,20 @@
+-- When the block each indexed row came from was mined, from its header.
+-- Activity, merchant stats and settlement reports order, filter and bucket
+-- on it, falling back to created_at for rows not yet mined. Rows indexed
+-- before this migration are filled in by the indexer when it starts.
+
+ALTER TABLE transactions ADD COLUMN block_timestamp TIMESTAMP;
+ALTER TABLE invoices ADD COLUMN block_timestamp TIMESTAMP;
+ALTER TABLE payments
+    ADD COLUMN block_timestamp TIMESTAMP,
+    ADD COLUMN refunded_at TIMESTAMP;
+ALTER TABLE bridge_transactions ADD COLUMN block_timestamp TIMESTAMP;
+ALTER TABLE session_keys ADD COLUMN block_timestamp TIMESTAMP;
+ALTER TABLE paymaster_sponsorships ADD COLUMN block_timestamp TIMESTAMP;
+ALTER TABLE refunds ADD COLUMN block_timestamp TIMESTAMP;
+
+CREATE INDEX idx_transactions_occurred_at ON transactions((COALESCE(block_timestamp, created_at)));
+CREATE INDEX idx_invoices_occurred_at ON invoices((COALESCE(block_timestamp, created_at)));
+CREATE INDEX idx_payments_occurred_at ON payments((COALESCE(block_timestamp, created_at)));
+CREATE INDEX idx_bridge_transactions_occurred_at ON bridge_transactions((COALESCE(block_timestamp, created_at)));
+CREATE INDEX idx_paymaster_sponsorships_occurred_at ON paymaster_sponsorships((COALESCE(block_timestamp, created_at)));
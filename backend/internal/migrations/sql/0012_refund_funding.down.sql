@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_refunds_pending_payment;
+
+ALTER TABLE refunds DROP COLUMN funding_tx_hash;
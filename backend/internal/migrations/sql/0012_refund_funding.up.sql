@@ -0,0 +1,12 @@
+-- Merchants fund their refunds: the relayer moves the amount from the
+-- merchant into VyraPOS before refundPayment pays it out of the contract's
+-- balance. funding_tx_hash is that transfer, kept so a retried refund uses
+-- the funds already moved rather than taking them again.
+
+ALTER TABLE refunds ADD COLUMN funding_tx_hash VARCHAR(66);
+
+-- VyraPOS pays one refund per payment, so only one API refund of a payment
+-- may be pending at a time; otherwise two requests under different
+-- idempotency keys could both take the merchant's funds.
+CREATE UNIQUE INDEX idx_refunds_pending_payment ON refunds(payment_id)
+    WHERE source = 'api' AND status = 'pending';
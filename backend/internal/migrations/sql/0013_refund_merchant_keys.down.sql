@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_refunds_merchant_idempotency_key;
+ALTER TABLE refunds ADD CONSTRAINT refunds_idempotency_key_key UNIQUE (idempotency_key);
+
+ALTER TABLE refunds DROP COLUMN merchant_address;
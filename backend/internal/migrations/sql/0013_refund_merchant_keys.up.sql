@@ -0,0 +1,12 @@
+-- Idempotency keys belong to the merchant that chose them, so two merchants
+-- may use the same key. API refunds record their merchant; refunds the
+-- indexer finds on-chain have none.
+
+ALTER TABLE refunds ADD COLUMN merchant_address VARCHAR(42);
+
+UPDATE refunds r SET merchant_address = p.merchant_address
+FROM payments p
+WHERE p.payment_id = r.payment_id AND r.source = 'api';
+
+ALTER TABLE refunds DROP CONSTRAINT refunds_idempotency_key_key;
+CREATE UNIQUE INDEX idx_refunds_merchant_idempotency_key ON refunds(merchant_address, idempotency_key);
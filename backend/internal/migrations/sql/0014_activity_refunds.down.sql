@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_refunds_created_at;
+DROP INDEX IF EXISTS idx_refunds_funding_tx_hash;
+DROP INDEX IF EXISTS idx_payments_tx_hash;
+
+-- The copied refunds are the chain rows without a log position
+DELETE FROM refunds WHERE source = 'chain' AND block_hash IS NULL;
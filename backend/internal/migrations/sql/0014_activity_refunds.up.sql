@@ -0,0 +1,15 @@
+-- Activity lists refunds from the refunds table, and leaves out the token
+-- Transfers moved by a payment or refund, found by transaction hash. Refunds
+-- indexed before the refunds table existed are only recorded on their
+-- payment, so they are copied into it.
+
+INSERT INTO refunds (payment_id, amount, status, tx_hash, block_number, block_timestamp, source, created_at)
+SELECT p.payment_id, p.refund_amount, 'confirmed', p.refund_tx_hash, p.refunded_block, p.refunded_at, 'chain',
+    p.updated_at
+FROM payments p
+WHERE p.refunded_block IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM refunds r WHERE r.payment_id = p.payment_id AND r.block_number IS NOT NULL);
+
+CREATE INDEX idx_payments_tx_hash ON payments(tx_hash);
+CREATE INDEX idx_refunds_funding_tx_hash ON refunds(funding_tx_hash);
+CREATE INDEX idx_refunds_created_at ON refunds(created_at);
@@ -0,0 +1,215 @@
+// Package paylink encodes VyraPOS invoices as payment request URIs for QR
+// codes and deep links, and decodes them back. Two forms are supported:
+//
+//   - vyra://pay?invoiceId=<id>&amount=<VYR>&chainId=<id>&pos=<address>,
+//     which the Vyra wallet opens to sign and relay the invoice payment;
+//   - an EIP-681 ethereum: URI for any wallet, requesting the VYR allowance
+//     VyraPOS needs to collect the payment:
+//     ethereum:<token>@<chainId>/approve?address=<pos>&uint256=<amount>.
+//
+// EIP-681 has no field for the invoice ID, since the payment itself is
+// relayed rather than sent by the wallet, so only the vyra:// form carries it.
+package paylink
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"vyra-backend/internal/chain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	SchemeVyra     = "vyra"
+	SchemeEthereum = "ethereum"
+)
+
+// ErrInvalidURI is returned when a URI is not a well-formed payment request.
+var ErrInvalidURI = errors.New("invalid payment URI")
+
+// Invoice is the payment request a URI encodes. InvoiceID is 64 hex
+// characters without 0x and is empty when decoded from an EIP-681 URI, as
+// Token is when decoded from a vyra:// URI. Amount is in token units.
+type Invoice struct {
+	InvoiceID string
+	Amount    *big.Int
+	ChainID   int64
+	POS       common.Address
+	Token     common.Address
+}
+
+// Links are an invoice's payment URIs.
+type Links struct {
+	URI    string `json:"uri"`
+	EIP681 string `json:"eip681"`
+}
+
+// NewLinks returns both URIs for inv.
+func NewLinks(inv *Invoice) *Links {
+	return &Links{
+		URI:    VyraURI(inv),
+		EIP681: EIP681(inv),
+	}
+}
+
+// VyraURI encodes inv as a vyra://pay deep link. The amount is in VYR.
+func VyraURI(inv *Invoice) string {
+	query := url.Values{}
+	query.Set("invoiceId", inv.InvoiceID)
+	query.Set("amount", chain.FormatUnits(inv.Amount, chain.VYRDecimals))
+	query.Set("chainId", strconv.FormatInt(inv.ChainID, 10))
+	query.Set("pos", inv.POS.Hex())
+
+	u := url.URL{Scheme: SchemeVyra, Host: "pay", RawQuery: query.Encode()}
+	return u.String()
+}
+
+// EIP681 encodes inv as an EIP-681 request to approve VyraPOS for the
+// invoice amount on the VYR token.
+func EIP681(inv *Invoice) string {
+	return fmt.Sprintf("%s:%s@%d/approve?address=%s&uint256=%s",
+		SchemeEthereum, inv.Token.Hex(), inv.ChainID, inv.POS.Hex(), inv.Amount.String())
+}
+
+// Decode parses a vyra:// or EIP-681 URI produced by VyraURI or EIP681.
+func Decode(uri string) (*Invoice, error) {
+	scheme, _, ok := strings.Cut(uri, ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing scheme", ErrInvalidURI)
+	}
+	switch strings.ToLower(scheme) {
+	case SchemeVyra:
+		return decodeVyra(uri)
+	case SchemeEthereum:
+		return decodeEIP681(uri)
+	default:
+		return nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidURI, scheme)
+	}
+}
+
+func decodeVyra(uri string) (*Invoice, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURI, err)
+	}
+	if u.Host != "pay" {
+		return nil, fmt.Errorf("%w: unsupported action %q", ErrInvalidURI, u.Host)
+	}
+	query := u.Query()
+
+	invoiceID := strings.TrimPrefix(query.Get("invoiceId"), "0x")
+	if len(invoiceID) != 64 || !isHex(invoiceID) {
+		return nil, fmt.Errorf("%w: invalid invoiceId", ErrInvalidURI)
+	}
+	amount, err := chain.ParseUnits(query.Get("amount"), chain.VYRDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURI, err)
+	}
+	chainID, err := parseChainID(query.Get("chainId"))
+	if err != nil {
+		return nil, err
+	}
+	pos, err := parseAddress(query.Get("pos"), "pos")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Invoice{
+		InvoiceID: strings.ToLower(invoiceID),
+		Amount:    amount,
+		ChainID:   chainID,
+		POS:       pos,
+	}, nil
+}
+
+// decodeEIP681 parses ethereum:<token>[@<chainId>]/approve?address=<pos>&uint256=<amount>.
+func decodeEIP681(uri string) (*Invoice, error) {
+	_, rest, _ := strings.Cut(uri, ":")
+	rest = strings.TrimPrefix(rest, "pay-")
+	target, rawQuery, _ := strings.Cut(rest, "?")
+	target, function, _ := strings.Cut(target, "/")
+	if function != "approve" {
+		return nil, fmt.Errorf("%w: expected an approve call, got %q", ErrInvalidURI, function)
+	}
+
+	// EIP-681 defaults to mainnet when the chain ID is omitted
+	chainID := int64(1)
+	target, rawChainID, hasChainID := strings.Cut(target, "@")
+	if hasChainID {
+		var err error
+		if chainID, err = parseChainID(rawChainID); err != nil {
+			return nil, err
+		}
+	}
+	token, err := parseAddress(target, "token")
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURI, err)
+	}
+	pos, err := parseAddress(query.Get("address"), "address")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseNumber(query.Get("uint256"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Invoice{
+		Amount:  amount,
+		ChainID: chainID,
+		POS:     pos,
+		Token:   token,
+	}, nil
+}
+
+// parseNumber parses an EIP-681 number: an integer, optionally in
+// scientific notation such as 2.5e18.
+func parseNumber(value string) (*big.Int, error) {
+	mantissa, exponent, scientific := strings.Cut(strings.ToLower(value), "e")
+	decimals := 0
+	if scientific {
+		var err error
+		if decimals, err = strconv.Atoi(exponent); err != nil || decimals < 0 || decimals > 77 {
+			return nil, fmt.Errorf("%w: invalid amount %q", ErrInvalidURI, value)
+		}
+	}
+	amount, err := chain.ParseUnits(mantissa, uint8(decimals))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURI, err)
+	}
+	return amount, nil
+}
+
+func parseChainID(value string) (int64, error) {
+	chainID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || chainID <= 0 {
+		return 0, fmt.Errorf("%w: invalid chain ID %q", ErrInvalidURI, value)
+	}
+	return chainID, nil
+}
+
+func parseAddress(value, name string) (common.Address, error) {
+	if !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("%w: invalid %s %q", ErrInvalidURI, name, value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
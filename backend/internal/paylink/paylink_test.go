@@ -0,0 +1,117 @@
+package paylink
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var testInvoice = &Invoice{
+	InvoiceID: strings.Repeat("ab", 32),
+	Amount:    new(big.Int).Mul(big.NewInt(15), big.NewInt(1e17)), // 1.5 VYR
+	ChainID:   31337,
+	POS:       common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+	Token:     common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"),
+}
+
+func TestVyraURIRoundTrip(t *testing.T) {
+	uri := VyraURI(testInvoice)
+	want := "vyra://pay?amount=1.5&chainId=31337&invoiceId=" + testInvoice.InvoiceID +
+		"&pos=0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	if uri != want {
+		t.Errorf("VyraURI = %s, want %s", uri, want)
+	}
+
+	got, err := Decode(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := *testInvoice
+	decoded.Token = common.Address{}
+	if !reflect.DeepEqual(got, &decoded) {
+		t.Errorf("Decode(VyraURI) = %+v, want %+v", got, &decoded)
+	}
+}
+
+func TestEIP681RoundTrip(t *testing.T) {
+	uri := EIP681(testInvoice)
+	want := "ethereum:0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512@31337/approve?" +
+		"address=0x5FbDB2315678afecb367f032d93F642f64180aa3&uint256=1500000000000000000"
+	if uri != want {
+		t.Errorf("EIP681 = %s, want %s", uri, want)
+	}
+
+	got, err := Decode(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := *testInvoice
+	decoded.InvoiceID = ""
+	if !reflect.DeepEqual(got, &decoded) {
+		t.Errorf("Decode(EIP681) = %+v, want %+v", got, &decoded)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	pos := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	token := "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"
+	id := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name    string
+		uri     string
+		amount  string
+		chainID int64
+	}{
+		{"0x-prefixed invoice ID", "vyra://pay?invoiceId=0x" + id + "&amount=2&chainId=1&pos=" + pos, "2000000000000000000", 1},
+		{"EIP-681 without a chain ID", "ethereum:" + token + "/approve?address=" + pos + "&uint256=1", "1", 1},
+		{"EIP-681 pay- prefix", "ethereum:pay-" + token + "@5/approve?address=" + pos + "&uint256=1", "1", 5},
+		{"EIP-681 scientific notation", "ethereum:" + token + "@1/approve?address=" + pos + "&uint256=2.5e18", "2500000000000000000", 1},
+		{"upper-case scheme", "ETHEREUM:" + token + "@1/approve?address=" + pos + "&uint256=1", "1", 1},
+	}
+	for _, tt := range tests {
+		got, err := Decode(tt.uri)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got.Amount.String() != tt.amount || got.ChainID != tt.chainID {
+			t.Errorf("%s: Decode = %+v, want amount %s on chain %d", tt.name, got, tt.amount, tt.chainID)
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	pos := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	token := "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"
+	id := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{"no scheme", "pay?invoiceId=" + id},
+		{"other scheme", "https://pay?invoiceId=" + id},
+		{"other action", "vyra://send?invoiceId=" + id + "&amount=1&chainId=1&pos=" + pos},
+		{"short invoice ID", "vyra://pay?invoiceId=abcd&amount=1&chainId=1&pos=" + pos},
+		{"invoice ID not hex", "vyra://pay?invoiceId=" + strings.Repeat("zz", 32) + "&amount=1&chainId=1&pos=" + pos},
+		{"bad amount", "vyra://pay?invoiceId=" + id + "&amount=one&chainId=1&pos=" + pos},
+		{"zero chain ID", "vyra://pay?invoiceId=" + id + "&amount=1&chainId=0&pos=" + pos},
+		{"bad POS", "vyra://pay?invoiceId=" + id + "&amount=1&chainId=1&pos=0x1234"},
+		{"EIP-681 transfer", "ethereum:" + token + "@1/transfer?address=" + pos + "&uint256=1"},
+		{"EIP-681 bad token", "ethereum:0x1234@1/approve?address=" + pos + "&uint256=1"},
+		{"EIP-681 bad chain ID", "ethereum:" + token + "@x/approve?address=" + pos + "&uint256=1"},
+		{"EIP-681 missing spender", "ethereum:" + token + "@1/approve?uint256=1"},
+		{"EIP-681 negative exponent", "ethereum:" + token + "@1/approve?address=" + pos + "&uint256=1e-3"},
+		{"EIP-681 fractional amount", "ethereum:" + token + "@1/approve?address=" + pos + "&uint256=1.5"},
+	}
+	for _, tt := range tests {
+		if got, err := Decode(tt.uri); !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("%s: Decode = %+v, %v; want ErrInvalidURI", tt.name, got, err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package ratelimit implements token-bucket rate limiting. Each key, such
+// as a client IP or API key, has a bucket holding up to Burst tokens that
+// refills at Requests per Per; a request takes a token and is refused when
+// the bucket is empty. MemoryStore keeps buckets in process for a single
+// node; RedisStore shares them between nodes.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token-bucket rate: Requests per Per on average, in bursts of
+// up to Burst.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// ParseLimit parses a limit written "<requests>/<duration>", such as
+// "100/1m", optionally followed by ",burst=<n>". The burst defaults to the
+// request count.
+func ParseLimit(s string) (Limit, error) {
+	rate, burst, hasBurst := strings.Cut(s, ",")
+	requests, per, ok := strings.Cut(strings.TrimSpace(rate), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate limit %q must be <requests>/<duration>", s)
+	}
+
+	var l Limit
+	var err error
+	if l.Requests, err = strconv.Atoi(requests); err != nil || l.Requests < 1 {
+		return Limit{}, fmt.Errorf("rate limit %q: requests must be a positive integer", s)
+	}
+	if l.Per, err = time.ParseDuration(per); err != nil || l.Per <= 0 {
+		return Limit{}, fmt.Errorf("rate limit %q: invalid duration %q", s, per)
+	}
+	l.Burst = l.Requests
+	if hasBurst {
+		value, ok := strings.CutPrefix(strings.TrimSpace(burst), "burst=")
+		if l.Burst, err = strconv.Atoi(value); !ok || err != nil || l.Burst < 1 {
+			return Limit{}, fmt.Errorf("rate limit %q: burst must be a positive integer", s)
+		}
+	}
+	return l, nil
+}
+
+// interval is how long the bucket takes to regain one token.
+func (l Limit) interval() time.Duration {
+	return l.Per / time.Duration(l.Requests)
+}
+
+// Result is the outcome of taking a token. Remaining is the whole tokens
+// left; Reset is how long until the bucket is full again, and RetryAfter,
+// for a refused request, how long until a token is available.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// Store holds the buckets.
+type Store interface {
+	// Take takes a token from key's bucket, creating a full bucket for a key
+	// not seen before.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// take applies a request to a bucket that held tokens at last and returns
+// the tokens it holds now and the result. It is the algorithm shared by the
+// stores; RedisStore runs the same steps in Lua.
+func take(tokens float64, last, now time.Time, limit Limit) (float64, Result) {
+	interval := limit.interval()
+	if elapsed := now.Sub(last); elapsed > 0 {
+		tokens = math.Min(float64(limit.Burst), tokens+float64(elapsed)/float64(interval))
+	}
+
+	result := Result{Limit: limit.Burst}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(interval))
+	}
+	result.Remaining = int(tokens)
+	result.Reset = time.Duration((float64(limit.Burst) - tokens) * float64(interval))
+	return tokens, result
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Limit
+		ok   bool
+	}{
+		{"100/1m", Limit{Requests: 100, Per: time.Minute, Burst: 100}, true},
+		{" 10/1s , burst=25", Limit{Requests: 10, Per: time.Second, Burst: 25}, true},
+		{"5/1h,burst=1", Limit{Requests: 5, Per: time.Hour, Burst: 1}, true},
+		{"100", Limit{}, false},
+		{"0/1m", Limit{}, false},
+		{"-1/1m", Limit{}, false},
+		{"x/1m", Limit{}, false},
+		{"10/0s", Limit{}, false},
+		{"10/minute", Limit{}, false},
+		{"10/1m,burst=0", Limit{}, false},
+		{"10/1m,bust=5", Limit{}, false},
+	}
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.in)
+		if tt.ok && (err != nil || got != tt.want) {
+			t.Errorf("ParseLimit(%q) = %+v, %v; want %+v", tt.in, got, err, tt.want)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("ParseLimit(%q) = %+v, want an error", tt.in, got)
+		}
+	}
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	// One token a second, up to three at once
+	limit := Limit{Requests: 60, Per: time.Minute, Burst: 3}
+	steps := []struct {
+		advance time.Duration
+		want    Result
+	}{
+		{0, Result{Allowed: true, Limit: 3, Remaining: 2, Reset: time.Second}},
+		{0, Result{Allowed: true, Limit: 3, Remaining: 1, Reset: 2 * time.Second}},
+		{0, Result{Allowed: true, Limit: 3, Remaining: 0, Reset: 3 * time.Second}},
+		{0, Result{Limit: 3, Remaining: 0, Reset: 3 * time.Second, RetryAfter: time.Second}},
+		{500 * time.Millisecond, Result{Limit: 3, Remaining: 0, Reset: 2500 * time.Millisecond, RetryAfter: 500 * time.Millisecond}},
+		{500 * time.Millisecond, Result{Allowed: true, Limit: 3, Remaining: 0, Reset: 3 * time.Second}},
+		// A long wait refills the bucket only up to the burst
+		{time.Hour, Result{Allowed: true, Limit: 3, Remaining: 2, Reset: time.Second}},
+	}
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		got, err := store.Take(context.Background(), "client", limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != step.want {
+			t.Errorf("step %d: Take = %+v, want %+v", i, got, step.want)
+		}
+	}
+
+	// Keys have their own buckets
+	if got, _ := store.Take(context.Background(), "other", limit); got.Remaining != 2 {
+		t.Errorf("Take(other) = %+v, want a full bucket", got)
+	}
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	fast := Limit{Requests: 10, Per: time.Second, Burst: 10}
+	slow := Limit{Requests: 1, Per: time.Hour, Burst: 1}
+	store.Take(ctx, "fast", fast)
+	store.Take(ctx, "slow", slow)
+
+	// After a minute the fast bucket is full again and is dropped; the slow
+	// one is still refilling and must be kept
+	now = now.Add(sweepInterval)
+	store.Take(ctx, "new", fast)
+	if _, ok := store.buckets["fast"]; ok {
+		t.Error("refilled bucket was not swept")
+	}
+	if _, ok := store.buckets["slow"]; !ok {
+		t.Error("refilling bucket was swept")
+	}
+	if got, _ := store.Take(ctx, "slow", slow); got.Allowed {
+		t.Errorf("Take(slow) = %+v, want it still limited", got)
+	}
+}
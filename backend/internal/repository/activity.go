@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Activity types returned by ListActivity.
+const (
+	ActivitySend             = "send"
+	ActivityReceive          = "receive"
+	ActivityPayment          = "payment"
+	ActivityRefund           = "refund"
+	ActivityBridgeDeposit    = "bridge_deposit"
+	ActivityBridgeWithdrawal = "bridge_withdrawal"
+	ActivityGasSponsorship   = "gas_sponsorship"
+)
+
+// ActivityTypes lists every activity type.
+var ActivityTypes = []string{
+	ActivitySend, ActivityReceive, ActivityPayment, ActivityRefund,
+	ActivityBridgeDeposit, ActivityBridgeWithdrawal, ActivityGasSponsorship,
+}
+
+// ActivityFilter selects a page of an address's activity. Address must be
+// checksummed, as the indexer stores it.
+type ActivityFilter struct {
+	Address  string
+	Types    []string
+	Statuses []string
+	From     *time.Time
+	To       *time.Time
+
+	// After is the position of the last entry of the previous page
+	After *ActivityCursor
+	Limit int
+}
+
+// ActivityCursor is the sort position of a listed entry: the time the list
+// is ordered by and the entry's ID. Activity is ordered by when each entry
+// was recorded.
+type ActivityCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// listedTransfer matches a transactions row t that a payment or refund
+// moved: a Transfer in a payment's transaction, or in the refundPayment or
+// funding transaction of an indexed refund. Those are listed as the payment
+// or refund, so listing the transfer too would count the amount twice.
+const listedTransfer = `(EXISTS (SELECT 1 FROM payments p WHERE p.tx_hash = t.hash)
+		OR EXISTS (SELECT 1 FROM refunds r WHERE r.tx_hash = t.hash AND r.block_number IS NOT NULL)
+		OR EXISTS (SELECT 1 FROM refunds r WHERE r.funding_tx_hash = t.hash AND r.block_number IS NOT NULL))`
+
+// activityQuery unions every table that records activity for address $1
+// into one shape. Split payments are listed per recipient from
+// payment_splits rather than under the first recipient; refunds are listed
+// once indexed. An entry occurred when its block was mined, or, until that
+// is known, when it was recorded. Entries are ordered by when they were
+// recorded, which unlike the block time never changes, so a page boundary
+// stays put while block times are filled in.
+const activityQuery = `
+	SELECT 'send:' || t.id, 'send', t.status, t.amount, COALESCE(t.fee, 0),
+		t.to_address, t.hash, t.block_number, NULL::text, COALESCE(t.block_timestamp, t.created_at), t.created_at
+	FROM transactions t WHERE t.from_address = $1 AND NOT ` + listedTransfer + `
+	UNION ALL
+	SELECT 'receive:' || t.id, 'receive', t.status, t.amount, 0,
+		t.from_address, t.hash, t.block_number, NULL, COALESCE(t.block_timestamp, t.created_at), t.created_at
+	FROM transactions t WHERE t.to_address = $1 AND NOT ` + listedTransfer + `
+	UNION ALL
+	SELECT 'payment:' || p.id, 'payment', p.status, p.amount, p.merchant_fee + p.platform_fee,
+		CASE WHEN p.customer_address = $1 THEN p.merchant_address ELSE p.customer_address END,
+		p.tx_hash, p.block_number, p.payment_id, COALESCE(p.block_timestamp, p.created_at), p.created_at
+	FROM payments p
+	WHERE p.customer_address = $1
+		OR (p.merchant_address = $1 AND NOT EXISTS (SELECT 1 FROM payment_splits s WHERE s.payment_id = p.id))
+	UNION ALL
+	SELECT 'payment:' || p.id || ':' || s.position, 'payment', p.status, s.amount, 0,
+		p.customer_address, p.tx_hash, p.block_number, p.payment_id, COALESCE(p.block_timestamp, p.created_at),
+		p.created_at
+	FROM payment_splits s JOIN payments p ON p.id = s.payment_id
+	WHERE s.recipient_address = $1 AND p.customer_address <> $1
+	UNION ALL
+	SELECT 'refund:' || r.id, 'refund', r.status, r.amount, 0,
+		CASE WHEN p.customer_address = $1 THEN p.merchant_address ELSE p.customer_address END,
+		r.tx_hash, r.block_number, r.payment_id, COALESCE(r.block_timestamp, r.created_at), r.created_at
+	FROM refunds r JOIN payments p ON p.payment_id = r.payment_id
+	WHERE r.block_number IS NOT NULL AND (p.customer_address = $1 OR p.merchant_address = $1)
+	UNION ALL
+	SELECT 'bridge:' || id, 'bridge_' || direction, status, amount, 0,
+		NULL, COALESCE(l1_tx_hash, l2_tx_hash), block_number, transaction_id, COALESCE(block_timestamp, created_at),
+		created_at
+	FROM bridge_transactions WHERE user_address = $1
+	UNION ALL
+	SELECT 'sponsorship:' || id, 'gas_sponsorship', 'confirmed', vyr_cost, 0,
+		NULL, transaction_hash, block_number, NULL, COALESCE(block_timestamp, created_at), created_at
+	FROM paymaster_sponsorships WHERE user_address = $1`
+
+// ListActivity returns an address's activity, most recently recorded first.
+func (q *Queries) ListActivity(ctx context.Context, f ActivityFilter) ([]*Activity, error) {
+	args := []interface{}{f.Address}
+	var where []string
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(f.Types) > 0 {
+		where = append(where, "type = ANY("+arg(pq.Array(f.Types))+")")
+	}
+	if len(f.Statuses) > 0 {
+		where = append(where, "status = ANY("+arg(pq.Array(f.Statuses))+")")
+	}
+	if f.From != nil {
+		where = append(where, "occurred_at >= "+arg(*f.From))
+	}
+	if f.To != nil {
+		where = append(where, "occurred_at < "+arg(*f.To))
+	}
+	if f.After != nil {
+		where = append(where, "(recorded_at, id) < ("+arg(f.After.Timestamp)+", "+arg(f.After.ID)+")")
+	}
+
+	query := `
+		SELECT id, type, status, amount, fee, counterparty, tx_hash, block_number, reference, occurred_at, recorded_at
+		FROM (` + activityQuery + `) AS activity
+			(id, type, status, amount, fee, counterparty, tx_hash, block_number, reference, occurred_at, recorded_at)`
+	if len(where) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(where, " AND ")
+	}
+	query += "\n\t\tORDER BY recorded_at DESC, id DESC\n\t\tLIMIT " + arg(f.Limit)
+
+	rows, err := q.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activity []*Activity
+	for rows.Next() {
+		var a Activity
+		if err := rows.Scan(&a.ID, &a.Type, &a.Status, &a.Amount, &a.Fee, &a.Counterparty,
+			&a.TxHash, &a.BlockNumber, &a.Reference, &a.Timestamp, &a.RecordedAt); err != nil {
+			return nil, err
+		}
+		activity = append(activity, &a)
+	}
+	return activity, rows.Err()
+}
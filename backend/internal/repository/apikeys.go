@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const apiKeyColumns = `id, merchant_address, name, prefix, key_hash, scopes, last_used_at, revoked_at,
+	replaced_by, created_at`
+
+func scanAPIKey(row scanner) (*APIKey, error) {
+	var k APIKey
+	err := row.Scan(&k.ID, &k.MerchantAddress, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&k.Scopes), &k.LastUsedAt,
+		&k.RevokedAt, &k.ReplacedBy, &k.CreatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &k, nil
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, k *APIKey) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO api_keys (merchant_address, name, prefix, key_hash, scopes)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`,
+		k.MerchantAddress, k.Name, k.Prefix, k.KeyHash, pq.Array(k.Scopes),
+	).Scan(&k.ID, &k.CreatedAt)
+}
+
+// GetAPIKey returns a merchant's key, revoked or not, and locks it until the
+// transaction ends.
+func (q *Queries) GetAPIKey(ctx context.Context, merchant, id string) (*APIKey, error) {
+	return scanAPIKey(q.q.QueryRowContext(ctx, `
+		SELECT `+apiKeyColumns+` FROM api_keys
+		WHERE id = $1 AND merchant_address = $2
+		FOR UPDATE`, id, merchant))
+}
+
+// ListAPIKeys returns a merchant's keys, newest first, including revoked
+// ones.
+func (q *Queries) ListAPIKeys(ctx context.Context, merchant string) ([]*APIKey, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT `+apiKeyColumns+` FROM api_keys
+		WHERE merchant_address = $1
+		ORDER BY created_at DESC, id`, merchant)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// UseAPIKey returns the unrevoked key with the given hash and records that
+// it was used. The last-used time is only written once a minute so that a
+// busy key does not update its row on every request.
+func (q *Queries) UseAPIKey(ctx context.Context, keyHash string) (*APIKey, error) {
+	return scanAPIKey(q.q.QueryRowContext(ctx, `
+		WITH key AS (
+			SELECT `+apiKeyColumns+` FROM api_keys
+			WHERE key_hash = $1 AND revoked_at IS NULL
+		), used AS (
+			UPDATE api_keys SET last_used_at = CURRENT_TIMESTAMP
+			WHERE id IN (SELECT id FROM key)
+				AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - interval '1 minute')
+		)
+		SELECT `+apiKeyColumns+` FROM key`, keyHash))
+}
+
+// RevokeAPIKey revokes a key, recording the key issued in its place if it
+// was rotated. It returns ErrNotFound if the key is already revoked.
+func (q *Queries) RevokeAPIKey(ctx context.Context, id string, replacedBy *string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP, replaced_by = $2
+		WHERE id = $1 AND revoked_at IS NULL`, id, replacedBy)
+	return expectRow(res, err)
+}
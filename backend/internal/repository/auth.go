@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// CreateAuthNonce stores a sign-in nonce valid for ttl, clearing out expired
+// ones on the way.
+func (q *Queries) CreateAuthNonce(ctx context.Context, nonce string, ttl time.Duration) error {
+	if _, err := q.q.ExecContext(ctx, `
+		DELETE FROM auth_nonces WHERE expires_at <= CURRENT_TIMESTAMP`); err != nil {
+		return err
+	}
+	_, err := q.q.ExecContext(ctx, `
+		INSERT INTO auth_nonces (nonce, expires_at)
+		VALUES ($1, CURRENT_TIMESTAMP + $2 * interval '1 millisecond')`,
+		nonce, ttl.Milliseconds())
+	return err
+}
+
+// ConsumeAuthNonce deletes an unexpired nonce so that it cannot be used
+// again. It returns ErrNotFound if the nonce was never issued, has expired
+// or has already been used.
+func (q *Queries) ConsumeAuthNonce(ctx context.Context, nonce string) error {
+	res, err := q.q.ExecContext(ctx, `
+		DELETE FROM auth_nonces WHERE nonce = $1 AND expires_at > CURRENT_TIMESTAMP`, nonce)
+	return expectRow(res, err)
+}
+
+// CreateRefreshToken stores a refresh token valid for ttl. An empty FamilyID
+// starts a new family.
+func (q *Queries) CreateRefreshToken(ctx context.Context, t *RefreshToken, ttl time.Duration) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO refresh_tokens (token_hash, address, family_id, expires_at)
+		VALUES ($1, $2, COALESCE(NULLIF($3, '')::uuid, gen_random_uuid()), CURRENT_TIMESTAMP + $4 * interval '1 millisecond')
+		RETURNING id, family_id, expires_at, created_at`,
+		t.TokenHash, t.Address, t.FamilyID, ttl.Milliseconds(),
+	).Scan(&t.ID, &t.FamilyID, &t.ExpiresAt, &t.CreatedAt)
+}
+
+// GetRefreshToken looks a refresh token up by hash, whether or not it is
+// still usable, and locks it until the transaction ends so that it is
+// rotated at most once. Run it inside WithTx.
+func (q *Queries) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	var t RefreshToken
+	err := q.q.QueryRowContext(ctx, `
+		SELECT id, token_hash, address, family_id, expires_at, expires_at <= CURRENT_TIMESTAMP,
+			revoked_at, replaced_by, created_at
+		FROM refresh_tokens WHERE token_hash = $1
+		FOR UPDATE`, tokenHash,
+	).Scan(&t.ID, &t.TokenHash, &t.Address, &t.FamilyID, &t.ExpiresAt, &t.Expired,
+		&t.RevokedAt, &t.ReplacedBy, &t.CreatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &t, nil
+}
+
+// RevokeRefreshToken revokes a token, recording the token it was exchanged
+// for if it was rotated. Revoking a revoked token does nothing.
+func (q *Queries) RevokeRefreshToken(ctx context.Context, id string, replacedBy *string) error {
+	_, err := q.q.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = CURRENT_TIMESTAMP, replaced_by = $2
+		WHERE id = $1 AND revoked_at IS NULL`, id, replacedBy)
+	return err
+}
+
+// RevokeRefreshTokenFamily revokes every unrevoked token descended from the
+// same login.
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	_, err := q.q.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = CURRENT_TIMESTAMP
+		WHERE family_id = $1 AND revoked_at IS NULL`, familyID)
+	return err
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const bridgeColumns = `id, transaction_id, user_address, amount, direction, status,
+	l1_tx_hash, l2_tx_hash, signatures, created_at, updated_at`
+
+func scanBridgeTransaction(row scanner) (*BridgeTransaction, error) {
+	var b BridgeTransaction
+	err := row.Scan(&b.ID, &b.TransactionID, &b.UserAddress, &b.Amount, &b.Direction, &b.Status,
+		&b.L1TxHash, &b.L2TxHash, pq.Array(&b.Signatures), &b.CreatedAt, &b.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &b, nil
+}
+
+// CreateBridgeTransaction inserts a bridge transfer. When TransactionID is
+// empty the database assigns one and it is written back to b.
+func (q *Queries) CreateBridgeTransaction(ctx context.Context, b *BridgeTransaction) error {
+	err := q.q.QueryRowContext(ctx, `
+		INSERT INTO bridge_transactions (transaction_id, user_address, amount, direction, status,
+			l1_tx_hash, l2_tx_hash, signatures)
+		VALUES (COALESCE(NULLIF($1, ''), replace(gen_random_uuid()::text, '-', '')), $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, transaction_id, created_at, updated_at`,
+		b.TransactionID, b.UserAddress, b.Amount, b.Direction, b.Status,
+		b.L1TxHash, b.L2TxHash, pq.Array(b.Signatures),
+	).Scan(&b.ID, &b.TransactionID, &b.CreatedAt, &b.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return q.recordBridgeEvent(ctx, b.TransactionID)
+}
+
+func (q *Queries) GetBridgeTransaction(ctx context.Context, transactionID string) (*BridgeTransaction, error) {
+	return scanBridgeTransaction(q.q.QueryRowContext(ctx, `
+		SELECT `+bridgeColumns+` FROM bridge_transactions WHERE transaction_id = $1`, transactionID))
+}
+
+func (q *Queries) UpdateBridgeTransactionStatus(ctx context.Context, transactionID, status string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE bridge_transactions SET status = $2 WHERE transaction_id = $1`, transactionID, status)
+	if err := expectRow(res, err); err != nil {
+		return err
+	}
+	return q.recordBridgeEvent(ctx, transactionID)
+}
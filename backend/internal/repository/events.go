@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Webhook event types.
+const (
+	EventPaymentCompleted  = "payment.completed"
+	EventPaymentRefunded   = "payment.refunded"
+	EventBridgeUpdated     = "bridge.updated"
+	EventSessionKeyCreated = "session_key.created"
+	EventSessionKeyRevoked = "session_key.revoked"
+	EventInvoiceUpdated    = "invoice.updated"
+)
+
+// EventTypes lists every webhook event type.
+var EventTypes = []string{
+	EventPaymentCompleted, EventPaymentRefunded, EventBridgeUpdated,
+	EventSessionKeyCreated, EventSessionKeyRevoked, EventInvoiceUpdated,
+}
+
+// PaymentEvent is the data of payment.completed and payment.refunded
+// events. InvoiceID is the on-chain invoice ID of an invoice payment; Splits
+// lists the recipients of a split payment, whose first recipient is given as
+// the merchant.
+type PaymentEvent struct {
+	PaymentID    string         `json:"paymentId"`
+	InvoiceID    string         `json:"invoiceId,omitempty"`
+	Merchant     string         `json:"merchant"`
+	Customer     string         `json:"customer"`
+	Amount       string         `json:"amount"`
+	MerchantFee  string         `json:"merchantFee"`
+	PlatformFee  string         `json:"platformFee"`
+	RefundAmount string         `json:"refundAmount,omitempty"`
+	Reference    *string        `json:"reference,omitempty"`
+	Splits       []PaymentSplit `json:"splits,omitempty"`
+	TxHash       string         `json:"txHash,omitempty"`
+	BlockNumber  int64          `json:"blockNumber,omitempty"`
+}
+
+// SessionKeyEvent is the data of session_key.created and
+// session_key.revoked events.
+type SessionKeyEvent struct {
+	User        string    `json:"user"`
+	SessionKey  string    `json:"sessionKey"`
+	Expiry      time.Time `json:"expiry"`
+	BlockNumber int64     `json:"blockNumber,omitempty"`
+}
+
+// recordEvent stores an event for the webhook dispatcher and queues a
+// delivery to every active endpoint of the given addresses subscribed to
+// its type. The key identifies the change the event describes, so the same
+// change recorded twice, by the API and then by the indexer, is sent once.
+func (q *Queries) recordEvent(ctx context.Context, eventType, key string, addresses []string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.q.ExecContext(ctx, `
+		WITH event AS (
+			INSERT INTO webhook_events (event_key, type, addresses, payload)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (event_key) DO NOTHING
+			RETURNING id, type, addresses
+		)
+		INSERT INTO webhook_deliveries (event_id, endpoint_id)
+		SELECT event.id, e.id
+		FROM event JOIN webhook_endpoints e ON e.owner_address = ANY(event.addresses)
+		WHERE e.is_active AND (cardinality(e.event_types) = 0 OR event.type = ANY(e.event_types))`,
+		eventType, eventType+":"+key, pq.Array(addresses), payload)
+	return err
+}
+
+// recordPaymentEvent records event for the stored payment, addressed to its
+// merchant or split recipients and its customer. ref is the log that
+// completed or refunded it.
+func (q *Queries) recordPaymentEvent(ctx context.Context, eventType, paymentID string, ref EventRef) error {
+	e := PaymentEvent{TxHash: ref.TxHash, BlockNumber: ref.BlockNumber}
+	var refundAmount *string
+	err := q.q.QueryRowContext(ctx, `
+		SELECT p.payment_id, COALESCE(i.invoice_id, ''), p.merchant_address, p.customer_address,
+			p.amount, p.merchant_fee, p.platform_fee, p.refund_amount, p.reference
+		FROM payments p LEFT JOIN invoices i ON i.id = p.invoice_id
+		WHERE p.payment_id = $1`, paymentID,
+	).Scan(&e.PaymentID, &e.InvoiceID, &e.Merchant, &e.Customer,
+		&e.Amount, &e.MerchantFee, &e.PlatformFee, &refundAmount, &e.Reference)
+	if err != nil {
+		return notFound(err)
+	}
+	if eventType == EventPaymentRefunded && refundAmount != nil {
+		e.RefundAmount = *refundAmount
+	}
+
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT s.recipient_address, s.amount
+		FROM payment_splits s JOIN payments p ON p.id = s.payment_id
+		WHERE p.payment_id = $1
+		ORDER BY s.position`, paymentID)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var split PaymentSplit
+		if err := rows.Scan(&split.RecipientAddress, &split.Amount); err != nil {
+			rows.Close()
+			return err
+		}
+		e.Splits = append(e.Splits, split)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	addresses := []string{e.Customer}
+	if len(e.Splits) == 0 {
+		addresses = append(addresses, e.Merchant)
+	}
+	for _, split := range e.Splits {
+		addresses = append(addresses, split.RecipientAddress)
+	}
+	return q.recordEvent(ctx, eventType, e.PaymentID, addresses, e)
+}
+
+// recordBridgeEvent records a bridge.updated event for the bridge transfer's
+// current status.
+func (q *Queries) recordBridgeEvent(ctx context.Context, transactionID string) error {
+	b, err := q.GetBridgeTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+	return q.recordEvent(ctx, EventBridgeUpdated, b.ID+":"+b.Status, []string{b.UserAddress}, b)
+}
+
+// recordSessionKeyEvent records event for the session key with the given
+// row ID, which identifies one creation of a key.
+func (q *Queries) recordSessionKeyEvent(ctx context.Context, eventType, id string, e *SessionKeyEvent) error {
+	return q.recordEvent(ctx, eventType, id, []string{e.User}, e)
+}
+
+// RecordInvoiceEvent records an invoice.updated event for an invoice
+// transition, addressed to the invoice's merchant. Unlike the other events
+// it is recorded after the change, by a subscriber to invoice transitions;
+// recording the same transition again does nothing.
+func (q *Queries) RecordInvoiceEvent(ctx context.Context, t *InvoiceTransition) error {
+	return q.recordEvent(ctx, EventInvoiceUpdated, strconv.FormatInt(t.ID, 10), []string{t.MerchantAddress}, t)
+}
@@ -0,0 +1,538 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// EventRef locates the log a row was indexed from. The indexer stores it on
+// every row it writes so the row can be rolled back if its block is
+// orphaned by a reorg. BlockTime is when the block was mined, from its
+// header. It is zero if the caller did not read the header, in which case a
+// block time already stored is kept.
+type EventRef struct {
+	TxHash      string
+	BlockNumber int64
+	BlockHash   string
+	LogIndex    int
+	BlockTime   time.Time
+}
+
+// blockTime returns ref's block time for a nullable block_timestamp column.
+func (ref EventRef) blockTime() *time.Time {
+	if ref.BlockTime.IsZero() {
+		return nil
+	}
+	t := ref.BlockTime.UTC()
+	return &t
+}
+
+// IndexedBlock is the hash the indexer saw for a block number.
+type IndexedBlock struct {
+	Number int64
+	Hash   string
+}
+
+// GetCheckpoint returns the last block indexed for a contract.
+func (q *Queries) GetCheckpoint(ctx context.Context, contract string) (int64, error) {
+	var block int64
+	err := q.q.QueryRowContext(ctx, `
+		SELECT block_number FROM indexer_checkpoints WHERE contract_address = $1`, contract,
+	).Scan(&block)
+	return block, notFound(err)
+}
+
+// Checkpoint is the last block indexed for a contract.
+type Checkpoint struct {
+	Contract string
+	Name     string
+	Block    int64
+}
+
+// ListCheckpoints returns the checkpoint of every indexed contract.
+func (q *Queries) ListCheckpoints(ctx context.Context) ([]Checkpoint, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT contract_address, name, block_number FROM indexer_checkpoints ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var checkpoints []Checkpoint
+	for rows.Next() {
+		var c Checkpoint
+		if err := rows.Scan(&c.Contract, &c.Name, &c.Block); err != nil {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, c)
+	}
+	return checkpoints, rows.Err()
+}
+
+func (q *Queries) SaveCheckpoint(ctx context.Context, contract, name string, block int64) error {
+	_, err := q.q.ExecContext(ctx, `
+		INSERT INTO indexer_checkpoints (contract_address, name, block_number)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (contract_address) DO UPDATE SET name = EXCLUDED.name, block_number = EXCLUDED.block_number`,
+		contract, name, block)
+	return err
+}
+
+func (q *Queries) RecordBlock(ctx context.Context, block IndexedBlock) error {
+	_, err := q.q.ExecContext(ctx, `
+		INSERT INTO indexer_blocks (block_number, block_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (block_number) DO UPDATE SET block_hash = EXCLUDED.block_hash`,
+		block.Number, block.Hash)
+	return err
+}
+
+// RecentBlocks returns the most recently indexed blocks, newest first.
+func (q *Queries) RecentBlocks(ctx context.Context, limit int) ([]IndexedBlock, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT block_number, block_hash FROM indexer_blocks
+		ORDER BY block_number DESC
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []IndexedBlock
+	for rows.Next() {
+		var b IndexedBlock
+		if err := rows.Scan(&b.Number, &b.Hash); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, rows.Err()
+}
+
+// PruneBlocks forgets block hashes below block, which are too deep to be
+// reorganised.
+func (q *Queries) PruneBlocks(ctx context.Context, block int64) error {
+	_, err := q.q.ExecContext(ctx, `DELETE FROM indexer_blocks WHERE block_number < $1`, block)
+	return err
+}
+
+// BlocksWithoutTime returns up to limit numbers, lowest first, of blocks
+// that rows were indexed from without a block time: rows indexed before
+// block times were stored, and rows the API recorded from a receipt.
+func (q *Queries) BlocksWithoutTime(ctx context.Context, limit int) ([]int64, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT block_number FROM transactions WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT block_number FROM invoices WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT block_number FROM payments WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT refunded_block FROM payments WHERE refunded_block IS NOT NULL AND refunded_at IS NULL
+		UNION SELECT block_number FROM bridge_transactions WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT block_number FROM session_keys WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT block_number FROM paymaster_sponsorships WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		UNION SELECT block_number FROM refunds WHERE block_number IS NOT NULL AND block_timestamp IS NULL
+		ORDER BY 1
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []int64
+	for rows.Next() {
+		var number int64
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, number)
+	}
+	return blocks, rows.Err()
+}
+
+// blockTimeStatements fill in the block time of rows indexed from block $1
+// that have none; they clear every row BlocksWithoutTime finds.
+var blockTimeStatements = []string{
+	`UPDATE transactions SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE invoices SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE payments SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE payments SET refunded_at = $2 WHERE refunded_block = $1 AND refunded_at IS NULL`,
+	`UPDATE bridge_transactions SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE session_keys SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE paymaster_sponsorships SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+	`UPDATE refunds SET block_timestamp = $2 WHERE block_number = $1 AND block_timestamp IS NULL`,
+}
+
+// SetBlockTime records when block was mined on the rows indexed from it
+// that have no block time.
+func (q *Queries) SetBlockTime(ctx context.Context, block int64, t time.Time) error {
+	for _, statement := range blockTimeStatements {
+		if _, err := q.q.ExecContext(ctx, statement, block, t.UTC()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollbackStatements undo everything indexed after a block. Rows the indexer
+// created are deleted; API rows it confirmed go back to their pre-chain
+// state. Invoice status changes are undone, and recorded, before the
+// payments they depend on.
+var rollbackStatements = []string{
+	`WITH changed AS (
+		UPDATE invoices i SET status = 'paid'
+		FROM payments p
+		WHERE p.invoice_id = i.id AND p.refunded_block > $1 AND i.status = 'refunded'
+		RETURNING i.id
+	)
+	INSERT INTO invoice_transitions (invoice_id, from_status, to_status, reason, block_number)
+	SELECT id, 'refunded', 'paid', 'reorg', $1 FROM changed`,
+	`DELETE FROM payments WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE payments SET status = 'pending', tx_hash = NULL,
+		block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_number > $1`,
+	`UPDATE payments SET status = 'confirmed', refunded_block = NULL, refund_amount = NULL, refund_tx_hash = NULL,
+		refunded_at = NULL
+		WHERE refunded_block > $1`,
+
+	`WITH changed AS (
+		UPDATE invoices i SET status = 'pending_payment', paid_block = NULL
+		FROM invoices old
+		WHERE old.id = i.id AND i.paid_block > $1
+		RETURNING i.id, old.status
+	)
+	INSERT INTO invoice_transitions (invoice_id, from_status, to_status, reason, block_number)
+	SELECT id, status, 'pending_payment', 'reorg', $1 FROM changed`,
+	`WITH changed AS (
+		UPDATE invoices SET status = 'created'
+		WHERE source = 'api' AND block_number > $1 AND status = 'pending_payment'
+		RETURNING id
+	)
+	INSERT INTO invoice_transitions (invoice_id, from_status, to_status, reason, block_number)
+	SELECT id, 'pending_payment', 'created', 'reorg', $1 FROM changed`,
+	`DELETE FROM invoices WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE invoices SET block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_number > $1`,
+
+	`DELETE FROM transactions WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE transactions SET status = 'pending',
+		block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_hash IS NOT NULL AND block_number > $1`,
+
+	`DELETE FROM bridge_transactions WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE bridge_transactions SET status = 'pending', onchain_id = NULL,
+		block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_number > $1`,
+
+	`DELETE FROM session_keys WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE session_keys SET block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_number > $1`,
+	`UPDATE session_keys SET is_active = true, revoked_block = NULL WHERE revoked_block > $1`,
+
+	`DELETE FROM paymaster_sponsorships WHERE source = 'chain' AND block_number > $1`,
+
+	`DELETE FROM refunds WHERE source = 'chain' AND block_number > $1`,
+	`UPDATE refunds SET status = 'pending',
+		block_number = NULL, block_hash = NULL, log_index = NULL, block_timestamp = NULL
+		WHERE source = 'api' AND block_number > $1`,
+
+	`DELETE FROM indexer_blocks WHERE block_number > $1`,
+	`UPDATE indexer_checkpoints SET block_number = $1 WHERE block_number > $1`,
+}
+
+// RollbackAfter undoes all indexed data from blocks above block and rewinds
+// the checkpoints to it. Run it inside WithTx.
+func (q *Queries) RollbackAfter(ctx context.Context, block int64) error {
+	for _, statement := range rollbackStatements {
+		if _, err := q.q.ExecContext(ctx, statement, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexTransfer records an ERC-20 Transfer log, confirming a pending API row
+// for the same transaction if there is one.
+func (q *Queries) IndexTransfer(ctx context.Context, ref EventRef, t *Transaction) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE transactions SET status = $4, block_number = $5, block_hash = $6, log_index = $7, block_timestamp = $8
+		WHERE hash = $1 AND from_address = $2 AND to_address = $3 AND source = 'api' AND log_index IS NULL`,
+		ref.TxHash, t.FromAddress, t.ToAddress, StatusConfirmed, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime())
+	if err := expectRow(res, err); !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	_, err = q.q.ExecContext(ctx, `
+		INSERT INTO transactions (hash, from_address, to_address, amount, status, type,
+			block_number, block_hash, log_index, block_timestamp, source)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 'chain')
+		ON CONFLICT (hash, COALESCE(log_index, -1)) DO NOTHING`,
+		ref.TxHash, t.FromAddress, t.ToAddress, t.Amount, StatusConfirmed, t.Type,
+		ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime())
+	return err
+}
+
+// IndexInvoice records an InvoiceCreated log. An invoice created through
+// the API is matched by its createInvoice transaction, takes its on-chain
+// invoice ID and awaits payment; one already indexed only has its block
+// position filled in.
+func (q *Queries) IndexInvoice(ctx context.Context, ref EventRef, i *Invoice) error {
+	var id, status string
+	err := q.q.QueryRowContext(ctx, `
+		UPDATE invoices SET invoice_id = $3, block_number = $4, block_hash = $5, log_index = $6, block_timestamp = $7
+		WHERE lower(tx_hash) = lower($1) AND merchant_address = $2 AND source = 'api' AND block_number IS NULL
+		RETURNING id, status`,
+		ref.TxHash, i.MerchantAddress, i.InvoiceID, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+	).Scan(&id, &status)
+	if err == nil {
+		return q.openInvoice(ctx, ref, id, status)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	var inserted bool
+	err = q.q.QueryRowContext(ctx, `
+		INSERT INTO invoices (invoice_id, merchant_address, amount, description, expiry, status,
+			block_number, block_hash, log_index, block_timestamp, source)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 'chain')
+		ON CONFLICT (invoice_id) DO UPDATE SET
+			block_number = EXCLUDED.block_number,
+			block_hash = EXCLUDED.block_hash,
+			log_index = EXCLUDED.log_index,
+			block_timestamp = COALESCE(EXCLUDED.block_timestamp, invoices.block_timestamp)
+		RETURNING id, status, xmax = 0`,
+		i.InvoiceID, i.MerchantAddress, i.Amount, i.Description, i.Expiry, StatusPendingPayment,
+		ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+	).Scan(&id, &status, &inserted)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return q.openInvoice(ctx, ref, id, status)
+	}
+
+	_, err = q.q.ExecContext(ctx, `
+		INSERT INTO invoice_transitions (invoice_id, to_status, reason, block_number)
+		VALUES ($1, $2, $3, $4)`,
+		id, StatusPendingPayment, TransitionChain, ref.BlockNumber)
+	return err
+}
+
+// openInvoice moves an invoice created through the API to
+// StatusPendingPayment now that VyraPOS has it. One already expired or
+// cancelled while its creation was being mined stays so.
+func (q *Queries) openInvoice(ctx context.Context, ref EventRef, id, status string) error {
+	if status != StatusCreated {
+		return nil
+	}
+	return q.transitionInvoice(ctx, id, status, StatusPendingPayment, TransitionChain, &ref.BlockNumber)
+}
+
+// IndexPayment records a PaymentProcessed or SplitPaymentProcessed log and
+// marks the invoice it settles, if any, as paid. A reference set on p is
+// kept; one already stored is not cleared by a p without.
+func (q *Queries) IndexPayment(ctx context.Context, ref EventRef, p *Payment, invoiceID string, splits []PaymentSplit) error {
+	err := q.q.QueryRowContext(ctx, `
+		INSERT INTO payments (payment_id, invoice_id, customer_address, merchant_address, amount,
+			merchant_fee, platform_fee, status, tx_hash, reference, block_number, block_hash, log_index,
+			block_timestamp, source)
+		VALUES ($1, (SELECT id FROM invoices WHERE invoice_id = $2), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+			$14, 'chain')
+		ON CONFLICT (payment_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			tx_hash = EXCLUDED.tx_hash,
+			reference = COALESCE(EXCLUDED.reference, payments.reference),
+			block_number = EXCLUDED.block_number,
+			block_hash = EXCLUDED.block_hash,
+			log_index = EXCLUDED.log_index,
+			block_timestamp = COALESCE(EXCLUDED.block_timestamp, payments.block_timestamp)
+		RETURNING id`,
+		p.PaymentID, invoiceID, p.CustomerAddress, p.MerchantAddress, p.Amount,
+		p.MerchantFee, p.PlatformFee, StatusConfirmed, ref.TxHash, p.Reference, ref.BlockNumber, ref.BlockHash, ref.LogIndex,
+		ref.blockTime(),
+	).Scan(&p.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(splits) > 0 {
+		if _, err := q.q.ExecContext(ctx, `DELETE FROM payment_splits WHERE payment_id = $1`, p.ID); err != nil {
+			return err
+		}
+		for position, split := range splits {
+			if _, err := q.q.ExecContext(ctx, `
+				INSERT INTO payment_splits (payment_id, position, recipient_address, amount)
+				VALUES ($1, $2, $3, $4)`,
+				p.ID, position, split.RecipientAddress, split.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if invoiceID != "" {
+		err := q.TransitionInvoice(ctx, invoiceID, StatusPaid, TransitionChain, &ref.BlockNumber)
+		if errors.Is(err, ErrNotFound) {
+			err = nil
+		} else if err == nil {
+			_, err = q.q.ExecContext(ctx, `
+				UPDATE invoices SET paid_block = $2 WHERE invoice_id = $1`, invoiceID, ref.BlockNumber)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.recordPaymentEvent(ctx, EventPaymentCompleted, p.PaymentID, ref)
+}
+
+// IndexRefund records a PaymentRefunded log, confirming the API refund sent
+// in the same transaction or recording one made outside the API. It returns
+// ErrNotFound if the payment was never indexed; the refund is still recorded.
+func (q *Queries) IndexRefund(ctx context.Context, ref EventRef, paymentID, amount string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE refunds SET status = $3, amount = $4, block_number = $5, block_hash = $6, log_index = $7,
+			block_timestamp = COALESCE($8, block_timestamp)
+		WHERE payment_id = $1 AND lower(tx_hash) = lower($2) AND source = 'api'`,
+		paymentID, ref.TxHash, StatusConfirmed, amount, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime())
+	if err := expectRow(res, err); errors.Is(err, ErrNotFound) {
+		_, err = q.q.ExecContext(ctx, `
+			INSERT INTO refunds (payment_id, amount, status, tx_hash, block_number, block_hash, log_index,
+				block_timestamp, source)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 'chain')
+			ON CONFLICT (tx_hash, log_index) DO NOTHING`,
+			paymentID, amount, StatusConfirmed, ref.TxHash, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime())
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	var invoiceID sql.NullString
+	err = q.q.QueryRowContext(ctx, `
+		UPDATE payments p SET status = $2, refund_amount = $3, refund_tx_hash = $4, refunded_block = $5,
+			refunded_at = COALESCE($6, p.refunded_at)
+		WHERE p.payment_id = $1
+		RETURNING (SELECT i.invoice_id FROM invoices i WHERE i.id = p.invoice_id)`,
+		paymentID, StatusRefunded, amount, ref.TxHash, ref.BlockNumber, ref.blockTime(),
+	).Scan(&invoiceID)
+	if err != nil {
+		return notFound(err)
+	}
+	if invoiceID.Valid {
+		err := q.TransitionInvoice(ctx, invoiceID.String, StatusRefunded, TransitionChain, &ref.BlockNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.recordPaymentEvent(ctx, EventPaymentRefunded, paymentID, ref)
+}
+
+// IndexBridgeTransaction records a DepositInitiated or WithdrawalProcessed
+// log. A pending API row for the same L1 (deposit) or L2 (withdrawal)
+// transaction is confirmed instead of inserting a new one.
+func (q *Queries) IndexBridgeTransaction(ctx context.Context, ref EventRef, b *BridgeTransaction, onchainID string) error {
+	matchColumn, matchHash := "l1_tx_hash", b.L1TxHash
+	if b.Direction == DirectionWithdrawal {
+		matchColumn, matchHash = "l2_tx_hash", b.L2TxHash
+	}
+
+	var transactionID string
+	if matchHash != nil {
+		err := q.q.QueryRowContext(ctx, `
+			UPDATE bridge_transactions SET status = $3, onchain_id = $4,
+				block_number = $5, block_hash = $6, log_index = $7, block_timestamp = $8
+			WHERE direction = $1 AND lower(`+matchColumn+`) = lower($2)
+				AND source = 'api' AND onchain_id IS NULL
+			RETURNING transaction_id`,
+			b.Direction, *matchHash, StatusConfirmed, onchainID, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+		).Scan(&transactionID)
+		if err == nil {
+			return q.recordBridgeEvent(ctx, transactionID)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	err := q.q.QueryRowContext(ctx, `
+		INSERT INTO bridge_transactions (transaction_id, onchain_id, user_address, amount, direction, status,
+			l1_tx_hash, l2_tx_hash, block_number, block_hash, log_index, block_timestamp, source)
+		VALUES ($1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, 'chain')
+		ON CONFLICT (onchain_id) DO NOTHING
+		RETURNING transaction_id`,
+		onchainID, b.UserAddress, b.Amount, b.Direction, StatusConfirmed,
+		b.L1TxHash, b.L2TxHash, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+	).Scan(&transactionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Already indexed
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return q.recordBridgeEvent(ctx, transactionID)
+}
+
+// IndexSessionKey records a SessionKeyCreated log. The contract keeps one
+// key per user, so the user's other active keys are deactivated.
+func (q *Queries) IndexSessionKey(ctx context.Context, ref EventRef, user, key string, expiry time.Time) error {
+	if _, err := q.revokeSessionKeys(ctx, ref.BlockNumber, `
+		UPDATE session_keys SET is_active = false, revoked_block = $3
+		WHERE user_address = $1 AND session_key <> $2 AND is_active
+		RETURNING id, user_address, session_key, expiry`,
+		user, key, ref.BlockNumber); err != nil {
+		return err
+	}
+
+	var id string
+	err := q.q.QueryRowContext(ctx, `
+		UPDATE session_keys SET expiry = $3, block_number = $4, block_hash = $5, log_index = $6, block_timestamp = $7
+		WHERE user_address = $1 AND session_key = $2 AND source = 'api' AND block_number IS NULL
+		RETURNING id`,
+		user, key, expiry, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = q.q.QueryRowContext(ctx, `
+			INSERT INTO session_keys (user_address, session_key, expiry, is_active,
+				block_number, block_hash, log_index, block_timestamp, source)
+			VALUES ($1, $2, $3, true, $4, $5, $6, $7, 'chain')
+			RETURNING id`,
+			user, key, expiry, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+		).Scan(&id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return q.recordSessionKeyEvent(ctx, EventSessionKeyCreated, id, &SessionKeyEvent{
+		User:        user,
+		SessionKey:  key,
+		Expiry:      expiry,
+		BlockNumber: ref.BlockNumber,
+	})
+}
+
+// IndexSessionKeyRevoked records a SessionKeyRevoked log.
+func (q *Queries) IndexSessionKeyRevoked(ctx context.Context, ref EventRef, user, key string) error {
+	_, err := q.revokeSessionKeys(ctx, ref.BlockNumber, `
+		UPDATE session_keys SET is_active = false, revoked_block = $3
+		WHERE user_address = $1 AND session_key = $2 AND is_active
+		RETURNING id, user_address, session_key, expiry`,
+		user, key, ref.BlockNumber)
+	return err
+}
+
+// IndexSponsorship records a GasSponsored log. One the API already recorded
+// only has its block time filled in, if it was stored without one.
+func (q *Queries) IndexSponsorship(ctx context.Context, ref EventRef, s *PaymasterSponsorship) error {
+	_, err := q.q.ExecContext(ctx, `
+		INSERT INTO paymaster_sponsorships (user_address, gas_used, vyr_cost, transaction_hash,
+			block_number, block_hash, log_index, block_timestamp, source)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 'chain')
+		ON CONFLICT (transaction_hash, log_index) DO UPDATE SET
+			block_timestamp = COALESCE(paymaster_sponsorships.block_timestamp, EXCLUDED.block_timestamp)`,
+		s.UserAddress, s.GasUsed, s.VyrCost, ref.TxHash, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime())
+	return err
+}
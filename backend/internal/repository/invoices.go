@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// ErrInvalidTransition is returned when an invoice cannot move to the
+// requested status from its current one.
+var ErrInvalidTransition = errors.New("invalid invoice transition")
+
+// invoiceTransitions lists the statuses each invoice status can move to.
+// An invoice is created through the API, awaits payment once VyraPOS has
+// it, and is then paid, expired or cancelled. Expired and cancelled invoices
+// can still be paid: VyraPOS checks expiry against the block timestamp, not
+// the sweeper's clock, and knows nothing of cancellations. Reorgs undo
+// transitions outside these rules.
+var invoiceTransitions = map[string][]string{
+	StatusCreated:        {StatusPendingPayment, StatusExpired, StatusCancelled},
+	StatusPendingPayment: {StatusPaid, StatusExpired, StatusCancelled},
+	StatusPaid:           {StatusRefunded},
+	StatusExpired:        {StatusPaid},
+	StatusCancelled:      {StatusPaid},
+	StatusRefunded:       {},
+}
+
+// CanTransition reports whether an invoice can move from one status to
+// another.
+func CanTransition(from, to string) bool {
+	for _, next := range invoiceTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// path returns the statuses an invoice passes through on the shortest way
+// from from to to, ending with to, or nil if to cannot be reached.
+func path(from, to string) []string {
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+		for _, next := range invoiceTransitions[status] {
+			if _, seen := previous[next]; seen {
+				continue
+			}
+			previous[next] = status
+			if next == to {
+				var steps []string
+				for step := to; step != from; step = previous[step] {
+					steps = append([]string{step}, steps...)
+				}
+				return steps
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// sourcesOf lists the statuses that can move to status.
+func sourcesOf(status string) []string {
+	var sources []string
+	for from := range invoiceTransitions {
+		if CanTransition(from, status) {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
+
+const invoiceColumns = `id, invoice_id, merchant_address, amount, description, expiry, status,
+	payment_id, tx_hash, created_at, updated_at`
+
+func scanInvoice(row scanner) (*Invoice, error) {
+	var i Invoice
+	err := row.Scan(&i.ID, &i.InvoiceID, &i.MerchantAddress, &i.Amount, &i.Description, &i.Expiry, &i.Status,
+		&i.PaymentID, &i.TxHash, &i.CreatedAt, &i.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &i, nil
+}
+
+// CreateInvoice inserts an invoice and records its initial status. When
+// InvoiceID is empty the database assigns one and it is written back to i.
+func (q *Queries) CreateInvoice(ctx context.Context, i *Invoice) error {
+	return q.q.QueryRowContext(ctx, `
+		WITH invoice AS (
+			INSERT INTO invoices (invoice_id, merchant_address, amount, description, expiry, status, tx_hash)
+			VALUES (COALESCE(NULLIF($1, ''), replace(gen_random_uuid()::text, '-', '')), $2, $3, $4, $5, $6, $7)
+			RETURNING id, invoice_id, status, created_at, updated_at
+		), transition AS (
+			INSERT INTO invoice_transitions (invoice_id, to_status, reason)
+			SELECT id, status, $8 FROM invoice
+		)
+		SELECT id, invoice_id, created_at, updated_at FROM invoice`,
+		i.InvoiceID, i.MerchantAddress, i.Amount, i.Description, i.Expiry, i.Status, i.TxHash, TransitionAPI,
+	).Scan(&i.ID, &i.InvoiceID, &i.CreatedAt, &i.UpdatedAt)
+}
+
+// GetInvoice looks an invoice up by its public invoice ID.
+func (q *Queries) GetInvoice(ctx context.Context, invoiceID string) (*Invoice, error) {
+	return scanInvoice(q.q.QueryRowContext(ctx, `
+		SELECT `+invoiceColumns+` FROM invoices WHERE invoice_id = $1`, invoiceID))
+}
+
+func (q *Queries) ListInvoicesByMerchant(ctx context.Context, merchant string, limit int) ([]*Invoice, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT `+invoiceColumns+` FROM invoices
+		WHERE merchant_address = $1
+		ORDER BY created_at DESC
+		LIMIT $2`, merchant, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invoices []*Invoice
+	for rows.Next() {
+		i, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, i)
+	}
+	return invoices, rows.Err()
+}
+
+// TransitionInvoice moves an invoice to status to and records why. It
+// returns ErrInvalidTransition if the invoice cannot move there from its
+// current status, and does nothing if it is already there.
+//
+// The chain has the last word, so TransitionChain is applied more loosely:
+// an indexed event implies the statuses before it, which are recorded on the
+// way, and one seen twice, by the API and then by the indexer, is ignored if
+// the invoice has already moved past it.
+func (q *Queries) TransitionInvoice(ctx context.Context, invoiceID, to, reason string, block *int64) error {
+	var id, from string
+	err := q.q.QueryRowContext(ctx, `
+		SELECT id, status FROM invoices WHERE invoice_id = $1`, invoiceID,
+	).Scan(&id, &from)
+	if err != nil {
+		return notFound(err)
+	}
+
+	if reason == TransitionChain && from != to {
+		if path(to, from) != nil {
+			return nil
+		}
+		if steps := path(from, to); steps != nil {
+			for _, step := range steps {
+				if err := q.transitionInvoice(ctx, id, from, step, reason, block); err != nil {
+					return err
+				}
+				from = step
+			}
+			return nil
+		}
+	}
+	return q.transitionInvoice(ctx, id, from, to, reason, block)
+}
+
+// transitionInvoice moves the invoice with row ID id from status from to to.
+// It fails if the invoice is no longer in status from.
+func (q *Queries) transitionInvoice(ctx context.Context, id, from, to, reason string, block *int64) error {
+	if from == to {
+		return nil
+	}
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+
+	res, err := q.q.ExecContext(ctx, `
+		WITH changed AS (
+			UPDATE invoices SET status = $3 WHERE id = $1 AND status = $2
+			RETURNING id
+		)
+		INSERT INTO invoice_transitions (invoice_id, from_status, to_status, reason, block_number)
+		SELECT id, $2, $3, $4, $5 FROM changed`,
+		id, from, to, reason, block)
+	if err := expectRow(res, err); errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: invoice is no longer %s", ErrInvalidTransition, from)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExpireInvoices moves up to limit unpaid invoices past their expiry to
+// StatusExpired and returns how many it moved.
+func (q *Queries) ExpireInvoices(ctx context.Context, limit int) (int64, error) {
+	res, err := q.q.ExecContext(ctx, `
+		WITH overdue AS (
+			SELECT id, status FROM invoices
+			WHERE status = ANY($1) AND expiry <= CURRENT_TIMESTAMP
+			ORDER BY expiry
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		), changed AS (
+			UPDATE invoices i SET status = $3
+			FROM overdue
+			WHERE i.id = overdue.id
+			RETURNING i.id, overdue.status
+		)
+		INSERT INTO invoice_transitions (invoice_id, from_status, to_status, reason)
+		SELECT id, status, $3, $4 FROM changed`,
+		pq.Array(sourcesOf(StatusExpired)), limit, StatusExpired, TransitionExpiry)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+const invoiceTransitionColumns = `t.id, i.invoice_id, i.merchant_address, t.from_status, t.to_status,
+	t.reason, t.block_number, t.created_at`
+
+func scanInvoiceTransition(row scanner) (*InvoiceTransition, error) {
+	var t InvoiceTransition
+	err := row.Scan(&t.ID, &t.InvoiceID, &t.MerchantAddress, &t.From, &t.To, &t.Reason, &t.BlockNumber, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (q *Queries) listInvoiceTransitions(ctx context.Context, query string, args ...interface{}) ([]*InvoiceTransition, error) {
+	rows, err := q.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []*InvoiceTransition
+	for rows.Next() {
+		t, err := scanInvoiceTransition(rows)
+		if err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, t)
+	}
+	return transitions, rows.Err()
+}
+
+// ListInvoiceTransitions returns an invoice's status history, oldest first.
+func (q *Queries) ListInvoiceTransitions(ctx context.Context, invoiceID string) ([]*InvoiceTransition, error) {
+	return q.listInvoiceTransitions(ctx, `
+		SELECT `+invoiceTransitionColumns+`
+		FROM invoice_transitions t JOIN invoices i ON i.id = t.invoice_id
+		WHERE i.invoice_id = $1
+		ORDER BY t.id`, invoiceID)
+}
+
+// ListUnpublishedInvoiceTransitions returns up to limit transitions not yet
+// handed to subscribers, oldest first, and locks them against other
+// publishers. Run it inside WithTx and mark them published in the same
+// transaction.
+func (q *Queries) ListUnpublishedInvoiceTransitions(ctx context.Context, limit int) ([]*InvoiceTransition, error) {
+	return q.listInvoiceTransitions(ctx, `
+		SELECT `+invoiceTransitionColumns+`
+		FROM invoice_transitions t JOIN invoices i ON i.id = t.invoice_id
+		WHERE t.published_at IS NULL
+		ORDER BY t.id
+		LIMIT $1
+		FOR UPDATE OF t SKIP LOCKED`, limit)
+}
+
+func (q *Queries) MarkInvoiceTransitionsPublished(ctx context.Context, ids []int64) error {
+	_, err := q.q.ExecContext(ctx, `
+		UPDATE invoice_transitions SET published_at = CURRENT_TIMESTAMP WHERE id = ANY($1)`,
+		pq.Array(ids))
+	return err
+}
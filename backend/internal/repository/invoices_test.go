@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{StatusCreated, StatusPendingPayment, true},
+		{StatusCreated, StatusCancelled, true},
+		{StatusCreated, StatusPaid, false},
+		{StatusPendingPayment, StatusPaid, true},
+		{StatusPendingPayment, StatusCreated, false},
+		{StatusPaid, StatusRefunded, true},
+		{StatusPaid, StatusCancelled, false},
+		{StatusExpired, StatusPaid, true},
+		{StatusExpired, StatusCancelled, false},
+		{StatusCancelled, StatusPaid, true},
+		{StatusRefunded, StatusPaid, false},
+		{StatusPaid, StatusPaid, false},
+		{"unknown", StatusPaid, false},
+	}
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     []string
+	}{
+		{StatusCreated, StatusPendingPayment, []string{StatusPendingPayment}},
+		{StatusCreated, StatusPaid, []string{StatusPendingPayment, StatusPaid}},
+		{StatusCreated, StatusRefunded, []string{StatusPendingPayment, StatusPaid, StatusRefunded}},
+		{StatusExpired, StatusRefunded, []string{StatusPaid, StatusRefunded}},
+		{StatusPaid, StatusCreated, nil},
+		{StatusRefunded, StatusPaid, nil},
+		{StatusPaid, StatusExpired, nil},
+	}
+	for _, tt := range tests {
+		if got := path(tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("path(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestSourcesOf(t *testing.T) {
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{StatusExpired, []string{StatusCreated, StatusPendingPayment}},
+		{StatusPaid, []string{StatusCancelled, StatusExpired, StatusPendingPayment}},
+		{StatusCreated, nil},
+	}
+	for _, tt := range tests {
+		got := sourcesOf(tt.status)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sourcesOf(%s) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
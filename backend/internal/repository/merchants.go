@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MerchantRange selects a merchant's records by when their block was mined,
+// or when they were recorded if that is not known yet. From is inclusive
+// and To exclusive; either may be nil.
+type MerchantRange struct {
+	Merchant string
+	From     *time.Time
+	To       *time.Time
+}
+
+// MerchantTotals sums a merchant's settled payments. Direct payments are
+// VyraPOS invoice payments to the merchant; split payments are shares the
+// merchant received from processSplitPayment, which carry no fees.
+type MerchantTotals struct {
+	PaymentCount   int64
+	Volume         string
+	MerchantFees   string
+	PlatformFees   string
+	NetAmount      string
+	SplitCount     int64
+	SplitVolume    string
+	RefundCount    int64
+	RefundedAmount string
+}
+
+// InvoiceCounts counts a merchant's invoices by state. An invoice still
+// pending after its expiry counts as expired.
+type InvoiceCounts struct {
+	Paid      int64
+	Expired   int64
+	Pending   int64
+	Cancelled int64
+}
+
+// VolumeBucket is a merchant's payment volume over one day or week.
+type VolumeBucket struct {
+	Start        time.Time
+	PaymentCount int64
+	Volume       string
+	Fees         string
+}
+
+// SettlementRow is one line of a merchant's settlement report.
+type SettlementRow struct {
+	ID          string
+	Timestamp   time.Time
+	PaymentID   string
+	InvoiceID   *string
+	Customer    string
+	Gross       string
+	MerchantFee string
+	PlatformFee string
+	Net         string
+	Refunded    string
+	Status      string
+	TxHash      *string
+	Reference   *string
+}
+
+// settledStatuses are the payment statuses that moved funds.
+const settledStatuses = `('confirmed', 'refunded')`
+
+// paymentTime and invoiceTime are when a payment or invoice occurred: when
+// its block was mined, or when it was recorded until that is known. They
+// match the expression indexes on the tables.
+const (
+	paymentTime = `COALESCE(p.block_timestamp, p.created_at)`
+	invoiceTime = `COALESCE(block_timestamp, created_at)`
+)
+
+// rangeClause appends bounds on column for r to args and returns the SQL
+// condition, starting with AND, or "" for an open range.
+func rangeClause(column string, r MerchantRange, args *[]interface{}) string {
+	var clause string
+	if r.From != nil {
+		*args = append(*args, *r.From)
+		clause += fmt.Sprintf(" AND %s >= $%d", column, len(*args))
+	}
+	if r.To != nil {
+		*args = append(*args, *r.To)
+		clause += fmt.Sprintf(" AND %s < $%d", column, len(*args))
+	}
+	return clause
+}
+
+// MerchantTotals sums the merchant's payments and refunds in r.
+func (q *Queries) MerchantTotals(ctx context.Context, r MerchantRange) (*MerchantTotals, error) {
+	args := []interface{}{r.Merchant}
+	direct := rangeClause(paymentTime, r, &args)
+	split := rangeClause(paymentTime, r, &args)
+
+	var t MerchantTotals
+	err := q.q.QueryRowContext(ctx, `
+		SELECT d.count, d.volume, d.merchant_fees, d.platform_fees, d.net, d.refund_count, d.refunded,
+			s.count, s.volume
+		FROM (
+			SELECT COUNT(*) AS count,
+				COALESCE(SUM(p.amount), 0) AS volume,
+				COALESCE(SUM(p.merchant_fee), 0) AS merchant_fees,
+				COALESCE(SUM(p.platform_fee), 0) AS platform_fees,
+				COALESCE(SUM(p.amount - p.merchant_fee - p.platform_fee), 0) AS net,
+				COUNT(*) FILTER (WHERE p.refunded_block IS NOT NULL) AS refund_count,
+				COALESCE(SUM(p.refund_amount), 0) AS refunded
+			FROM payments p
+			WHERE p.merchant_address = $1 AND p.status IN `+settledStatuses+`
+				AND NOT EXISTS (SELECT 1 FROM payment_splits s WHERE s.payment_id = p.id)`+direct+`
+		) d, (
+			SELECT COUNT(*) AS count, COALESCE(SUM(s.amount), 0) AS volume
+			FROM payment_splits s JOIN payments p ON p.id = s.payment_id
+			WHERE s.recipient_address = $1 AND p.status IN `+settledStatuses+split+`
+		) s`, args...,
+	).Scan(&t.PaymentCount, &t.Volume, &t.MerchantFees, &t.PlatformFees, &t.NetAmount,
+		&t.RefundCount, &t.RefundedAmount, &t.SplitCount, &t.SplitVolume)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// MerchantEarningsThrough sums the net amount of every direct payment to the
+// merchant indexed up to and including block. It is the indexed counterpart
+// of VyraPOS.merchantEarnings, which refunds do not reduce.
+func (q *Queries) MerchantEarningsThrough(ctx context.Context, merchant string, block int64) (string, error) {
+	var earnings string
+	err := q.q.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(p.amount - p.merchant_fee - p.platform_fee), 0)
+		FROM payments p
+		WHERE p.merchant_address = $1 AND p.status IN `+settledStatuses+`
+			AND p.block_number <= $2
+			AND NOT EXISTS (SELECT 1 FROM payment_splits s WHERE s.payment_id = p.id)`,
+		merchant, block,
+	).Scan(&earnings)
+	return earnings, err
+}
+
+// MerchantInvoiceCounts counts the merchant's invoices created in r. Paid
+// includes invoices later refunded, and unpaid invoices past their expiry
+// count as expired before the sweeper gets to them.
+func (q *Queries) MerchantInvoiceCounts(ctx context.Context, r MerchantRange) (*InvoiceCounts, error) {
+	args := []interface{}{r.Merchant}
+	clause := rangeClause(invoiceTime, r, &args)
+
+	var c InvoiceCounts
+	err := q.q.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE status IN ('paid', 'refunded')),
+			COUNT(*) FILTER (WHERE status = 'expired'
+				OR (status IN ('created', 'pending_payment') AND expiry <= CURRENT_TIMESTAMP)),
+			COUNT(*) FILTER (WHERE status IN ('created', 'pending_payment')
+				AND (expiry IS NULL OR expiry > CURRENT_TIMESTAMP)),
+			COUNT(*) FILTER (WHERE status = 'cancelled')
+		FROM invoices
+		WHERE merchant_address = $1`+clause, args...,
+	).Scan(&c.Paid, &c.Expired, &c.Pending, &c.Cancelled)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// MerchantVolume returns the merchant's direct and split payment volume in
+// r, per day or week. Periods without payments are omitted.
+func (q *Queries) MerchantVolume(ctx context.Context, r MerchantRange, interval string) ([]*VolumeBucket, error) {
+	if interval != "day" && interval != "week" {
+		return nil, fmt.Errorf("unsupported interval %q", interval)
+	}
+
+	args := []interface{}{r.Merchant, interval}
+	clause := rangeClause("occurred_at", r, &args)
+
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT date_trunc($2, occurred_at) AS bucket, COUNT(*), SUM(amount), SUM(fees)
+		FROM (
+			SELECT `+paymentTime+` AS occurred_at, p.amount, p.merchant_fee + p.platform_fee AS fees
+			FROM payments p
+			WHERE p.merchant_address = $1 AND p.status IN `+settledStatuses+`
+				AND NOT EXISTS (SELECT 1 FROM payment_splits s WHERE s.payment_id = p.id)
+			UNION ALL
+			SELECT `+paymentTime+`, s.amount, 0
+			FROM payment_splits s JOIN payments p ON p.id = s.payment_id
+			WHERE s.recipient_address = $1 AND p.status IN `+settledStatuses+`
+		) AS volume
+		WHERE TRUE`+clause+`
+		GROUP BY bucket
+		ORDER BY bucket`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []*VolumeBucket
+	for rows.Next() {
+		var b VolumeBucket
+		if err := rows.Scan(&b.Start, &b.PaymentCount, &b.Volume, &b.Fees); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, &b)
+	}
+	return buckets, rows.Err()
+}
+
+// ListSettlements returns up to limit lines of the merchant's settlement
+// report in r, oldest first, starting after the given position.
+func (q *Queries) ListSettlements(ctx context.Context, r MerchantRange, after *ActivityCursor, limit int) ([]*SettlementRow, error) {
+	args := []interface{}{r.Merchant}
+	var where []string
+	if r.From != nil {
+		args = append(args, *r.From)
+		where = append(where, fmt.Sprintf("occurred_at >= $%d", len(args)))
+	}
+	if r.To != nil {
+		args = append(args, *r.To)
+		where = append(where, fmt.Sprintf("occurred_at < $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.Timestamp, after.ID)
+		where = append(where, fmt.Sprintf("(occurred_at, id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	query := `
+		SELECT id, occurred_at, payment_id, invoice_id, customer_address, gross, merchant_fee,
+			platform_fee, net, refunded, status, tx_hash, reference
+		FROM (
+			SELECT p.id::text AS id, ` + paymentTime + ` AS occurred_at, p.payment_id, i.invoice_id,
+				p.customer_address, p.amount AS gross, p.merchant_fee, p.platform_fee,
+				p.amount - p.merchant_fee - p.platform_fee AS net,
+				COALESCE(p.refund_amount, 0) AS refunded, p.status, p.tx_hash, p.reference
+			FROM payments p LEFT JOIN invoices i ON i.id = p.invoice_id
+			WHERE p.merchant_address = $1 AND p.status IN ` + settledStatuses + `
+				AND NOT EXISTS (SELECT 1 FROM payment_splits s WHERE s.payment_id = p.id)
+			UNION ALL
+			SELECT p.id::text || ':' || s.position, ` + paymentTime + `, p.payment_id, NULL,
+				p.customer_address, s.amount, 0, 0, s.amount, 0, p.status, p.tx_hash, p.reference
+			FROM payment_splits s JOIN payments p ON p.id = s.payment_id
+			WHERE s.recipient_address = $1 AND p.status IN ` + settledStatuses + `
+		) AS settlements`
+	if len(where) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(where, " AND ")
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf("\n\t\tORDER BY occurred_at, id\n\t\tLIMIT $%d", len(args))
+
+	rows, err := q.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var settlements []*SettlementRow
+	for rows.Next() {
+		var s SettlementRow
+		if err := rows.Scan(&s.ID, &s.Timestamp, &s.PaymentID, &s.InvoiceID, &s.Customer, &s.Gross,
+			&s.MerchantFee, &s.PlatformFee, &s.Net, &s.Refunded, &s.Status, &s.TxHash, &s.Reference); err != nil {
+			return nil, err
+		}
+		settlements = append(settlements, &s)
+	}
+	return settlements, rows.Err()
+}
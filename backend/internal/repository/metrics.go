@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+)
+
+// BridgeStatusCount is how many bridge transfers in one direction have a
+// status.
+type BridgeStatusCount struct {
+	Direction string
+	Status    string
+	Count     int64
+}
+
+// DomainStats are the totals exported as metrics: invoices and refunds by
+// status, bridge transfers by direction and status, and the gas sponsored
+// by the paymaster with the VYR it spent.
+type DomainStats struct {
+	Invoices     map[string]int64
+	Refunds      map[string]int64
+	Bridge       []BridgeStatusCount
+	SponsoredGas int64
+	VYRSpent     float64
+}
+
+// GetDomainStats counts the rows behind the domain metrics.
+func (q *Queries) GetDomainStats(ctx context.Context) (*DomainStats, error) {
+	stats := &DomainStats{}
+
+	var err error
+	if stats.Invoices, err = q.countByStatus(ctx, "invoices"); err != nil {
+		return nil, err
+	}
+	if stats.Refunds, err = q.countByStatus(ctx, "refunds"); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT direction, COALESCE(status, 'unknown'), COUNT(*)
+		FROM bridge_transactions
+		GROUP BY 1, 2`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c BridgeStatusCount
+		if err := rows.Scan(&c.Direction, &c.Status, &c.Count); err != nil {
+			return nil, err
+		}
+		stats.Bridge = append(stats.Bridge, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = q.q.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(gas_used), 0), COALESCE(SUM(vyr_cost), 0)
+		FROM paymaster_sponsorships`,
+	).Scan(&stats.SponsoredGas, &stats.VYRSpent)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// countByStatus counts the rows of table by status. table is never user
+// input.
+func (q *Queries) countByStatus(ctx context.Context, table string) (map[string]int64, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT COALESCE(status, 'unknown'), COUNT(*) FROM `+table+` GROUP BY 1`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var status string
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Status values shared by the invoices, payments, transactions and
+// bridge_transactions tables.
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusFailed    = "failed"
+	StatusPaid      = "paid"
+	StatusRefunded  = "refunded"
+)
+
+// Invoice statuses besides StatusPaid and StatusRefunded. See
+// invoiceTransitions for how an invoice moves between them.
+const (
+	StatusCreated        = "created"
+	StatusPendingPayment = "pending_payment"
+	StatusExpired        = "expired"
+	StatusCancelled      = "cancelled"
+)
+
+// Why an invoice changed status: a request through the API, an indexed
+// on-chain event, the expiry sweeper or a chain reorganisation.
+const (
+	TransitionAPI    = "api"
+	TransitionChain  = "chain"
+	TransitionExpiry = "expiry"
+	TransitionReorg  = "reorg"
+)
+
+// Row origins. API rows are written when a request is accepted; chain rows
+// are written by the indexer for activity it finds on-chain.
+const (
+	SourceAPI   = "api"
+	SourceChain = "chain"
+)
+
+// TypeTransfer marks a transactions row recorded from an ERC-20 Transfer log.
+const TypeTransfer = "transfer"
+
+// Bridge transfer directions.
+const (
+	DirectionDeposit    = "deposit"
+	DirectionWithdrawal = "withdrawal"
+)
+
+// Webhook delivery statuses besides StatusPending. A dead delivery has used
+// all its attempts and waits in the dead-letter list until it is replayed.
+const (
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// Amounts are DECIMAL(36, 18) columns and are carried as decimal strings to
+// avoid floating point rounding.
+
+type User struct {
+	ID        string    `json:"id"`
+	Address   string    `json:"address"`
+	IsActive  bool      `json:"isActive"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Wallet struct {
+	ID                  string    `json:"id"`
+	UserID              *string   `json:"userId,omitempty"`
+	Address             string    `json:"address"`
+	PrivateKeyEncrypted *string   `json:"-"`
+	MnemonicEncrypted   *string   `json:"-"`
+	CreatedAt           time.Time `json:"createdAt"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+type Transaction struct {
+	ID          string    `json:"id"`
+	Hash        string    `json:"hash"`
+	FromAddress string    `json:"fromAddress"`
+	ToAddress   string    `json:"toAddress"`
+	Amount      string    `json:"amount"`
+	Fee         string    `json:"fee"`
+	Status      string    `json:"status"`
+	Type        string    `json:"type"`
+	BlockNumber *int64    `json:"blockNumber,omitempty"`
+	GasUsed     *int64    `json:"gasUsed,omitempty"`
+	GasPrice    *int64    `json:"gasPrice,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type Invoice struct {
+	ID              string     `json:"id"`
+	InvoiceID       string     `json:"invoiceId"`
+	MerchantAddress string     `json:"merchantAddress"`
+	Amount          string     `json:"amount"`
+	Description     *string    `json:"description,omitempty"`
+	Expiry          *time.Time `json:"expiry,omitempty"`
+	Status          string     `json:"status"`
+	PaymentID       *string    `json:"paymentId,omitempty"`
+	TxHash          *string    `json:"txHash,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// InvoiceTransition is one status change of an invoice. From is nil for the
+// status the invoice was first recorded with; BlockNumber is set for changes
+// made by indexed events and reorgs.
+type InvoiceTransition struct {
+	ID              int64     `json:"id"`
+	InvoiceID       string    `json:"invoiceId"`
+	MerchantAddress string    `json:"merchantAddress"`
+	From            *string   `json:"from,omitempty"`
+	To              string    `json:"to"`
+	Reason          string    `json:"reason"`
+	BlockNumber     *int64    `json:"blockNumber,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type Payment struct {
+	ID              string    `json:"id"`
+	PaymentID       string    `json:"paymentId"`
+	InvoiceID       *string   `json:"invoiceId,omitempty"`
+	CustomerAddress string    `json:"customerAddress"`
+	MerchantAddress string    `json:"merchantAddress"`
+	Amount          string    `json:"amount"`
+	MerchantFee     string    `json:"merchantFee"`
+	PlatformFee     string    `json:"platformFee"`
+	Status          string    `json:"status"`
+	TransactionID   *string   `json:"transactionId,omitempty"`
+	TxHash          *string   `json:"txHash,omitempty"`
+	Reference       *string   `json:"reference,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type PaymentSplit struct {
+	RecipientAddress string `json:"recipientAddress"`
+	Amount           string `json:"amount"`
+}
+
+// Refund is a refund of a POS payment. IdempotencyKey is set on refunds
+// requested through the API; Error holds why a failed one failed.
+type Refund struct {
+	ID              string    `json:"id"`
+	PaymentID       string    `json:"paymentId"`
+	MerchantAddress *string   `json:"merchantAddress,omitempty"`
+	IdempotencyKey  *string   `json:"idempotencyKey,omitempty"`
+	Amount          string    `json:"amount"`
+	Reason          *string   `json:"reason,omitempty"`
+	Status          string    `json:"status"`
+	Error           *string   `json:"error,omitempty"`
+	FundingTxHash   *string   `json:"fundingTxHash,omitempty"`
+	TxHash          *string   `json:"txHash,omitempty"`
+	BlockNumber     *int64    `json:"blockNumber,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// PaymentReference is a customer's claim on a reference for a split payment
+// made through the API. RequestHash is the authorization the customer
+// signed; PaymentID is set once the payment is confirmed.
+type PaymentReference struct {
+	ID              string    `json:"id"`
+	CustomerAddress string    `json:"customerAddress"`
+	Reference       string    `json:"reference"`
+	RequestHash     string    `json:"requestHash"`
+	Status          string    `json:"status"`
+	Error           *string   `json:"error,omitempty"`
+	TxHash          *string   `json:"txHash,omitempty"`
+	PaymentID       *string   `json:"paymentId,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type BridgeTransaction struct {
+	ID            string    `json:"id"`
+	TransactionID string    `json:"transactionId"`
+	UserAddress   string    `json:"userAddress"`
+	Amount        string    `json:"amount"`
+	Direction     string    `json:"direction"`
+	Status        string    `json:"status"`
+	L1TxHash      *string   `json:"l1TxHash,omitempty"`
+	L2TxHash      *string   `json:"l2TxHash,omitempty"`
+	Signatures    []string  `json:"signatures,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type SessionKey struct {
+	ID          string    `json:"id"`
+	UserAddress string    `json:"userAddress"`
+	SessionKey  string    `json:"sessionKey"`
+	Nonce       int64     `json:"nonce"`
+	Expiry      time.Time `json:"expiry"`
+	IsActive    bool      `json:"isActive"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type PaymasterSponsorship struct {
+	ID              string    `json:"id"`
+	UserAddress     string    `json:"userAddress"`
+	GasUsed         int64     `json:"gasUsed"`
+	VyrCost         string    `json:"vyrCost"`
+	TransactionHash string    `json:"transactionHash"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// WebhookEndpoint is a URL that receives an address's events. EventTypes
+// limits which; empty means all. Secret signs every delivery and is only
+// returned when the endpoint is registered.
+type WebhookEndpoint struct {
+	ID           string    `json:"id"`
+	OwnerAddress string    `json:"ownerAddress"`
+	URL          string    `json:"url"`
+	Secret       string    `json:"secret,omitempty"`
+	EventTypes   []string  `json:"eventTypes"`
+	IsActive     bool      `json:"isActive"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// WebhookDelivery is one event sent, or to be sent, to one endpoint.
+type WebhookDelivery struct {
+	ID             string          `json:"id"`
+	EndpointID     string          `json:"endpointId"`
+	EventID        string          `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int            `json:"lastStatusCode,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+}
+
+// WebhookAttempt is one HTTP request made for a delivery. StatusCode is nil
+// when no response was received.
+type WebhookAttempt struct {
+	ID           string    `json:"id"`
+	DeliveryID   string    `json:"deliveryId"`
+	StatusCode   *int      `json:"statusCode,omitempty"`
+	Error        *string   `json:"error,omitempty"`
+	ResponseBody *string   `json:"responseBody,omitempty"`
+	DurationMs   int64     `json:"durationMs"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// Activity is one entry in an address's history. ID is unique across
+// activity types; Reference is the payment, invoice or bridge ID it
+// belongs to. Timestamp is when its block was mined, or when it was recorded
+// if it has not been mined or indexed yet.
+type Activity struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	Status       string    `json:"status"`
+	Amount       string    `json:"amount"`
+	Fee          string    `json:"fee"`
+	Counterparty *string   `json:"counterparty,omitempty"`
+	TxHash       *string   `json:"txHash,omitempty"`
+	BlockNumber  *int64    `json:"blockNumber,omitempty"`
+	Reference    *string   `json:"reference,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+
+	// RecordedAt is when the entry was recorded, which activity is
+	// ordered by
+	RecordedAt time.Time `json:"-"`
+}
+
+// RefreshToken is an issued refresh token, known only by the hash of its
+// value. Tokens rotated from one login share a FamilyID; ReplacedBy is the
+// token a rotated one was exchanged for. Expired is only set by
+// GetRefreshToken.
+type RefreshToken struct {
+	ID         string     `json:"id"`
+	TokenHash  string     `json:"-"`
+	Address    string     `json:"address"`
+	FamilyID   string     `json:"familyId"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Expired    bool       `json:"-"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	ReplacedBy *string    `json:"replacedBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// APIKey is a merchant's key for server-to-server calls, known only by the
+// hash of its value. Prefix is the start of the key, shown to tell keys
+// apart; Key is set only when the key is issued.
+type APIKey struct {
+	ID              string     `json:"id"`
+	MerchantAddress string     `json:"merchantAddress"`
+	Name            string     `json:"name"`
+	Prefix          string     `json:"prefix"`
+	Key             string     `json:"key,omitempty"`
+	KeyHash         string     `json:"-"`
+	Scopes          []string   `json:"scopes"`
+	LastUsedAt      *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt       *time.Time `json:"revokedAt,omitempty"`
+	ReplacedBy      *string    `json:"replacedBy,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,104 @@
+package repository
+
+import "context"
+
+const sessionKeyColumns = `id, user_address, session_key, nonce, expiry, is_active, created_at, updated_at`
+
+func scanSessionKey(row scanner) (*SessionKey, error) {
+	var k SessionKey
+	err := row.Scan(&k.ID, &k.UserAddress, &k.SessionKey, &k.Nonce, &k.Expiry, &k.IsActive, &k.CreatedAt, &k.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &k, nil
+}
+
+func (q *Queries) CreateSessionKey(ctx context.Context, k *SessionKey) error {
+	err := q.q.QueryRowContext(ctx, `
+		INSERT INTO session_keys (user_address, session_key, nonce, expiry, is_active)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`,
+		k.UserAddress, k.SessionKey, k.Nonce, k.Expiry, k.IsActive,
+	).Scan(&k.ID, &k.CreatedAt, &k.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return q.recordSessionKeyEvent(ctx, EventSessionKeyCreated, k.ID, &SessionKeyEvent{
+		User:       k.UserAddress,
+		SessionKey: k.SessionKey,
+		Expiry:     k.Expiry,
+	})
+}
+
+// GetActiveSessionKey returns the user's current unexpired session key.
+func (q *Queries) GetActiveSessionKey(ctx context.Context, user string) (*SessionKey, error) {
+	return scanSessionKey(q.q.QueryRowContext(ctx, `
+		SELECT `+sessionKeyColumns+` FROM session_keys
+		WHERE user_address = $1 AND is_active AND expiry > now()
+		ORDER BY created_at DESC
+		LIMIT 1`, user))
+}
+
+// DeactivateSessionKeys marks all of a user's session keys inactive and
+// returns how many were active.
+func (q *Queries) DeactivateSessionKeys(ctx context.Context, user string) (int64, error) {
+	return q.revokeSessionKeys(ctx, 0, `
+		UPDATE session_keys SET is_active = false
+		WHERE user_address = $1 AND is_active
+		RETURNING id, user_address, session_key, expiry`, user)
+}
+
+// revokeSessionKeys runs an UPDATE that deactivates session keys, returning
+// their id, user_address, session_key and expiry, and records a
+// session_key.revoked event for each. block is the block of the log that
+// revoked them, or 0 for a revocation through the API. It returns how many
+// keys were revoked.
+func (q *Queries) revokeSessionKeys(ctx context.Context, block int64, query string, args ...interface{}) (int64, error) {
+	rows, err := q.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	// Read every row before recording events; a transaction cannot run
+	// another statement while rows are open
+	type revokedKey struct {
+		id    string
+		event SessionKeyEvent
+	}
+	var revoked []revokedKey
+	for rows.Next() {
+		k := revokedKey{event: SessionKeyEvent{BlockNumber: block}}
+		if err := rows.Scan(&k.id, &k.event.User, &k.event.SessionKey, &k.event.Expiry); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		revoked = append(revoked, k)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, k := range revoked {
+		if err := q.recordSessionKeyEvent(ctx, EventSessionKeyRevoked, k.id, &k.event); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(revoked)), nil
+}
+
+// CreateSponsorship records a sponsorship the API relayed, from the
+// GasSponsored log at ref. If the indexer has already recorded the log, s
+// is filled in from its row.
+func (q *Queries) CreateSponsorship(ctx context.Context, ref EventRef, s *PaymasterSponsorship) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO paymaster_sponsorships (user_address, gas_used, vyr_cost, transaction_hash,
+			block_number, block_hash, log_index, block_timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (transaction_hash, log_index) DO UPDATE SET
+			block_timestamp = COALESCE(paymaster_sponsorships.block_timestamp, EXCLUDED.block_timestamp)
+		RETURNING id, created_at`,
+		s.UserAddress, s.GasUsed, s.VyrCost, ref.TxHash, ref.BlockNumber, ref.BlockHash, ref.LogIndex, ref.blockTime(),
+	).Scan(&s.ID, &s.CreatedAt)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import "context"
+
+const paymentColumns = `id, payment_id, invoice_id, customer_address, merchant_address, amount,
+	merchant_fee, platform_fee, status, transaction_id, tx_hash, reference, created_at, updated_at`
+
+func scanPayment(row scanner) (*Payment, error) {
+	var p Payment
+	err := row.Scan(&p.ID, &p.PaymentID, &p.InvoiceID, &p.CustomerAddress, &p.MerchantAddress, &p.Amount,
+		&p.MerchantFee, &p.PlatformFee, &p.Status, &p.TransactionID, &p.TxHash, &p.Reference, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &p, nil
+}
+
+func (q *Queries) CreatePayment(ctx context.Context, p *Payment) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO payments (payment_id, invoice_id, customer_address, merchant_address, amount,
+			merchant_fee, platform_fee, status, transaction_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at`,
+		p.PaymentID, p.InvoiceID, p.CustomerAddress, p.MerchantAddress, p.Amount,
+		p.MerchantFee, p.PlatformFee, p.Status, p.TransactionID,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+}
+
+// GetPayment looks a payment up by its public payment ID.
+func (q *Queries) GetPayment(ctx context.Context, paymentID string) (*Payment, error) {
+	return scanPayment(q.q.QueryRowContext(ctx, `
+		SELECT `+paymentColumns+` FROM payments WHERE payment_id = $1`, paymentID))
+}
+
+func (q *Queries) UpdatePaymentStatus(ctx context.Context, paymentID, status string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE payments SET status = $2 WHERE payment_id = $1`, paymentID, status)
+	return expectRow(res, err)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+const paymentReferenceColumns = `id, customer_address, reference, request_hash, status, error,
+	tx_hash, payment_id, created_at, updated_at`
+
+func scanPaymentReference(row scanner) (*PaymentReference, error) {
+	var r PaymentReference
+	err := row.Scan(&r.ID, &r.CustomerAddress, &r.Reference, &r.RequestHash, &r.Status, &r.Error,
+		&r.TxHash, &r.PaymentID, &r.CreatedAt, &r.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &r, nil
+}
+
+// ClaimPaymentReference inserts a pending claim on r's customer and
+// reference. It returns false, leaving r untouched, if the reference has
+// already been claimed.
+func (q *Queries) ClaimPaymentReference(ctx context.Context, r *PaymentReference) (bool, error) {
+	claimed, err := scanPaymentReference(q.q.QueryRowContext(ctx, `
+		INSERT INTO payment_references (customer_address, reference, request_hash, status)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (customer_address, reference) DO NOTHING
+		RETURNING `+paymentReferenceColumns,
+		r.CustomerAddress, r.Reference, r.RequestHash, StatusPending))
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	*r = *claimed
+	return true, nil
+}
+
+// RetryPaymentReference moves a failed claim back to pending for another
+// attempt. It returns ErrNotFound if the claim is no longer failed.
+func (q *Queries) RetryPaymentReference(ctx context.Context, id string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE payment_references SET status = $2, error = NULL WHERE id = $1 AND status = $3`,
+		id, StatusPending, StatusFailed)
+	return expectRow(res, err)
+}
+
+// SetPaymentReferenceSubmitted records the transaction a claimed payment was
+// sent in.
+func (q *Queries) SetPaymentReferenceSubmitted(ctx context.Context, id, txHash string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE payment_references SET tx_hash = $2 WHERE id = $1`, id, txHash)
+	return expectRow(res, err)
+}
+
+// ConfirmPaymentReference records the payment a claim resulted in.
+func (q *Queries) ConfirmPaymentReference(ctx context.Context, id, paymentID string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE payment_references SET status = $2, payment_id = $3 WHERE id = $1`,
+		id, StatusConfirmed, paymentID)
+	return expectRow(res, err)
+}
+
+// FailPaymentReference marks a claim failed with the reason, so the
+// reference can be tried again.
+func (q *Queries) FailPaymentReference(ctx context.Context, id, reason string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE payment_references SET status = $2, error = $3 WHERE id = $1`, id, StatusFailed, reason)
+	return expectRow(res, err)
+}
+
+func (q *Queries) GetPaymentReference(ctx context.Context, customer, reference string) (*PaymentReference, error) {
+	return scanPaymentReference(q.q.QueryRowContext(ctx, `
+		SELECT `+paymentReferenceColumns+` FROM payment_references
+		WHERE customer_address = $1 AND reference = $2`, customer, reference))
+}
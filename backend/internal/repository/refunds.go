@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+const refundColumns = `id, payment_id, merchant_address, idempotency_key, amount, reason, status, error,
+	funding_tx_hash, tx_hash, block_number, created_at, updated_at`
+
+func scanRefund(row scanner) (*Refund, error) {
+	var r Refund
+	err := row.Scan(&r.ID, &r.PaymentID, &r.MerchantAddress, &r.IdempotencyKey, &r.Amount, &r.Reason, &r.Status, &r.Error,
+		&r.FundingTxHash, &r.TxHash, &r.BlockNumber, &r.CreatedAt, &r.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &r, nil
+}
+
+// ClaimRefund inserts a pending API refund for r's merchant and idempotency
+// key. It returns false, leaving r untouched, if the merchant has already
+// used the key or another refund of the payment is pending.
+func (q *Queries) ClaimRefund(ctx context.Context, r *Refund) (bool, error) {
+	claimed, err := scanRefund(q.q.QueryRowContext(ctx, `
+		INSERT INTO refunds (payment_id, merchant_address, idempotency_key, amount, reason, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+		RETURNING `+refundColumns,
+		r.PaymentID, r.MerchantAddress, r.IdempotencyKey, r.Amount, r.Reason, StatusPending))
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	*r = *claimed
+	return true, nil
+}
+
+// RetryRefund moves a failed refund back to pending for another attempt. It
+// returns ErrNotFound if the refund is no longer failed or another refund of
+// the payment is pending.
+func (q *Queries) RetryRefund(ctx context.Context, id string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE refunds r SET status = $2, error = NULL
+		WHERE r.id = $1 AND r.status = $3 AND NOT EXISTS (
+			SELECT 1 FROM refunds o
+			WHERE o.payment_id = r.payment_id AND o.source = 'api' AND o.status = $2)`,
+		id, StatusPending, StatusFailed)
+	return expectRow(res, err)
+}
+
+// SetRefundFunding records the transfer that moved a refund's amount from
+// the merchant into VyraPOS.
+func (q *Queries) SetRefundFunding(ctx context.Context, id, txHash string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE refunds SET funding_tx_hash = $2 WHERE id = $1`, id, txHash)
+	return expectRow(res, err)
+}
+
+// SetRefundSubmitted records the transaction a pending refund was sent in.
+func (q *Queries) SetRefundSubmitted(ctx context.Context, id, txHash string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE refunds SET tx_hash = $2 WHERE id = $1`, id, txHash)
+	return expectRow(res, err)
+}
+
+// FailRefund marks a refund failed with the reason.
+func (q *Queries) FailRefund(ctx context.Context, id, reason string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE refunds SET status = $2, error = $3 WHERE id = $1`, id, StatusFailed, reason)
+	return expectRow(res, err)
+}
+
+func (q *Queries) GetRefund(ctx context.Context, id string) (*Refund, error) {
+	return scanRefund(q.q.QueryRowContext(ctx, `
+		SELECT `+refundColumns+` FROM refunds WHERE id = $1`, id))
+}
+
+// GetRefundByIdempotencyKey returns the refund merchant started under key.
+func (q *Queries) GetRefundByIdempotencyKey(ctx context.Context, merchant, key string) (*Refund, error) {
+	return scanRefund(q.q.QueryRowContext(ctx, `
+		SELECT `+refundColumns+` FROM refunds
+		WHERE merchant_address = $1 AND idempotency_key = $2`, merchant, key))
+}
+
+// ListRefunds returns a payment's refunds and refund attempts, newest first.
+func (q *Queries) ListRefunds(ctx context.Context, paymentID string) ([]*Refund, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT `+refundColumns+` FROM refunds WHERE payment_id = $1
+		ORDER BY created_at DESC`, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refunds []*Refund
+	for rows.Next() {
+		r, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, r)
+	}
+	return refunds, rows.Err()
+}
@@ -0,0 +1,130 @@
+// Package repository persists Vyra's off-chain records in Postgres. Store
+// runs queries directly against the pool; WithTx runs a group of writes in a
+// single database transaction.
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// ErrNotFound is returned when a lookup matches no rows.
+var ErrNotFound = errors.New("record not found")
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Queries holds every read and write the services need. It is embedded in
+// both Store and Tx so the same methods work inside and outside a
+// transaction.
+type Queries struct {
+	q querier
+}
+
+// Store is the entry point to the database.
+type Store struct {
+	*Queries
+	db *sql.DB
+}
+
+// Tx is a database transaction opened by Store.WithTx.
+type Tx struct {
+	*Queries
+	tx *sql.Tx
+}
+
+// Open connects to Postgres at databaseURL. The connection is established
+// lazily, so Open succeeds even while the database is still starting.
+func Open(databaseURL string) (*Store, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %v", err)
+	}
+
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(5)
+	db.SetConnMaxLifetime(30 * time.Minute)
+
+	return New(db), nil
+}
+
+// New wraps an existing connection pool.
+func New(db *sql.DB) *Store {
+	return &Store{
+		Queries: &Queries{q: db},
+		db:      db,
+	}
+}
+
+// DB returns the underlying connection pool.
+func (s *Store) DB() *sql.DB {
+	return s.db
+}
+
+// Ping checks that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// Close closes the connection pool.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// WithTx runs fn inside a transaction, committing if it returns nil and
+// rolling back otherwise.
+func (s *Store) WithTx(ctx context.Context, fn func(tx *Tx) error) error {
+	sqlTx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+
+	tx := &Tx{Queries: &Queries{q: sqlTx}, tx: sqlTx}
+	if err := fn(tx); err != nil {
+		if rbErr := sqlTx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := sqlTx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
+
+// notFound maps sql.ErrNoRows to ErrNotFound.
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// expectRow turns an update that touched no rows into ErrNotFound.
+func expectRow(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
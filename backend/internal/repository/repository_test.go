@@ -0,0 +1,594 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/testdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+// newAddress returns an address no other test uses.
+func newAddress(t *testing.T) string {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+// newHash returns a transaction hash no other test uses.
+func newHash() string {
+	return common.BytesToHash(crypto.Keccak256([]byte(uuid.NewString()))).Hex()
+}
+
+func TestBridgeTransaction(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+
+	l1TxHash := newHash()
+	deposit := &repository.BridgeTransaction{
+		UserAddress: newAddress(t),
+		Amount:      "10.5",
+		Direction:   repository.DirectionDeposit,
+		Status:      repository.StatusPending,
+		L1TxHash:    &l1TxHash,
+	}
+	if err := store.CreateBridgeTransaction(ctx, deposit); err != nil {
+		t.Fatal(err)
+	}
+	if deposit.TransactionID == "" {
+		t.Fatal("CreateBridgeTransaction did not assign a transaction ID")
+	}
+
+	got, err := store.GetBridgeTransaction(ctx, deposit.TransactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.UserAddress != deposit.UserAddress || got.Direction != repository.DirectionDeposit ||
+		got.L1TxHash == nil || *got.L1TxHash != l1TxHash {
+		t.Errorf("GetBridgeTransaction = %+v, want %+v", got, deposit)
+	}
+
+	if err := store.UpdateBridgeTransactionStatus(ctx, deposit.TransactionID, repository.StatusConfirmed); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = store.GetBridgeTransaction(ctx, deposit.TransactionID); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != repository.StatusConfirmed {
+		t.Errorf("status = %s, want %s", got.Status, repository.StatusConfirmed)
+	}
+
+	if _, err := store.GetBridgeTransaction(ctx, uuid.NewString()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetBridgeTransaction(unknown) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSessionKeys(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	user := newAddress(t)
+
+	if _, err := store.GetActiveSessionKey(ctx, user); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetActiveSessionKey before any key: error = %v, want ErrNotFound", err)
+	}
+
+	key := &repository.SessionKey{
+		UserAddress: user,
+		SessionKey:  newAddress(t),
+		Expiry:      time.Now().Add(24 * time.Hour).UTC(),
+		IsActive:    true,
+	}
+	if err := store.CreateSessionKey(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := store.GetActiveSessionKey(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active.ID != key.ID || active.SessionKey != key.SessionKey {
+		t.Errorf("GetActiveSessionKey = %+v, want %+v", active, key)
+	}
+
+	revoked, err := store.DeactivateSessionKeys(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked != 1 {
+		t.Errorf("DeactivateSessionKeys revoked %d keys, want 1", revoked)
+	}
+	if _, err := store.GetActiveSessionKey(ctx, user); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetActiveSessionKey after revoking: error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPaymentReferenceClaim(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	customer, reference := newAddress(t), uuid.NewString()
+
+	claim := &repository.PaymentReference{CustomerAddress: customer, Reference: reference, RequestHash: newHash()}
+	claimed, err := store.ClaimPaymentReference(ctx, claim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !claimed || claim.ID == "" || claim.Status != repository.StatusPending {
+		t.Fatalf("first claim = %v, %+v; want a pending claim", claimed, claim)
+	}
+
+	again := &repository.PaymentReference{CustomerAddress: customer, Reference: reference, RequestHash: newHash()}
+	if claimed, err = store.ClaimPaymentReference(ctx, again); err != nil {
+		t.Fatal(err)
+	}
+	if claimed || again.ID != "" {
+		t.Fatalf("second claim = %v, %+v; want it refused", claimed, again)
+	}
+
+	// Only a failed claim can be retried
+	if err := store.RetryPaymentReference(ctx, claim.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RetryPaymentReference(pending) error = %v, want ErrNotFound", err)
+	}
+	if err := store.FailPaymentReference(ctx, claim.ID, "reverted"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.RetryPaymentReference(ctx, claim.ID); err != nil {
+		t.Fatalf("RetryPaymentReference(failed) error = %v", err)
+	}
+
+	txHash, paymentID := newHash(), common.Bytes2Hex(crypto.Keccak256([]byte(reference)))
+	if err := store.SetPaymentReferenceSubmitted(ctx, claim.ID, txHash); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ConfirmPaymentReference(ctx, claim.ID, paymentID); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := store.GetPaymentReference(ctx, customer, reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != repository.StatusConfirmed || got.Error != nil ||
+		got.TxHash == nil || *got.TxHash != txHash || got.PaymentID == nil || *got.PaymentID != paymentID {
+		t.Errorf("GetPaymentReference = %+v", got)
+	}
+}
+
+func TestRefundClaim(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	merchant, key := newAddress(t), uuid.NewString()
+	newPaymentID := func() string { return common.Bytes2Hex(crypto.Keccak256([]byte(uuid.NewString()))) }
+	newRefund := func(merchant, paymentID, key string) *repository.Refund {
+		return &repository.Refund{PaymentID: paymentID, MerchantAddress: &merchant, IdempotencyKey: &key, Amount: "5"}
+	}
+	claim := func(r *repository.Refund) bool {
+		t.Helper()
+		claimed, err := store.ClaimRefund(ctx, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return claimed
+	}
+
+	paymentID := newPaymentID()
+	refund := newRefund(merchant, paymentID, key)
+	if !claim(refund) || refund.ID == "" || refund.Status != repository.StatusPending {
+		t.Fatalf("first claim = %+v; want a pending refund", refund)
+	}
+
+	// The merchant's key is taken, for any payment
+	if again := newRefund(merchant, newPaymentID(), key); claim(again) || again.ID != "" {
+		t.Fatalf("claim reusing the key = %+v; want it refused", again)
+	}
+	// Another merchant's key of the same name is its own
+	if other := newRefund(newAddress(t), newPaymentID(), key); !claim(other) {
+		t.Fatal("another merchant's claim of the same key refused")
+	}
+	// One refund of a payment may be pending at a time
+	second := newRefund(merchant, paymentID, uuid.NewString())
+	if claim(second) {
+		t.Fatal("second pending refund of the payment claimed")
+	}
+
+	got, err := store.GetRefundByIdempotencyKey(ctx, merchant, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != refund.ID || got.MerchantAddress == nil || *got.MerchantAddress != merchant {
+		t.Errorf("GetRefundByIdempotencyKey = %+v, want %+v", got, refund)
+	}
+	if _, err := store.GetRefundByIdempotencyKey(ctx, newAddress(t), key); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetRefundByIdempotencyKey(another merchant) error = %v, want ErrNotFound", err)
+	}
+
+	// Only a failed refund can be retried, and not while another refund of
+	// the payment is pending
+	if err := store.RetryRefund(ctx, refund.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RetryRefund(pending) error = %v, want ErrNotFound", err)
+	}
+	fundingTx := newHash()
+	if err := store.SetRefundFunding(ctx, refund.ID, fundingTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.FailRefund(ctx, refund.ID, "reverted"); err != nil {
+		t.Fatal(err)
+	}
+	if !claim(second) {
+		t.Fatal("refund of the payment after the first failed refused")
+	}
+	if err := store.RetryRefund(ctx, refund.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RetryRefund while another is pending: error = %v, want ErrNotFound", err)
+	}
+	if err := store.FailRefund(ctx, second.ID, "reverted"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.RetryRefund(ctx, refund.ID); err != nil {
+		t.Fatalf("RetryRefund(failed) error = %v", err)
+	}
+
+	txHash := newHash()
+	if err := store.SetRefundSubmitted(ctx, refund.ID, txHash); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = store.GetRefund(ctx, refund.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != repository.StatusPending || got.Error != nil ||
+		got.FundingTxHash == nil || *got.FundingTxHash != fundingTx || got.TxHash == nil || *got.TxHash != txHash {
+		t.Errorf("GetRefund = %+v", got)
+	}
+
+	refunds, err := store.ListRefunds(ctx, paymentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refunds) != 2 || refunds[0].ID != second.ID || refunds[1].ID != refund.ID {
+		t.Errorf("ListRefunds = %+v, want the second refund then the first", refunds)
+	}
+}
+
+func TestTransferAuthorizationClaim(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	from, deadline := newAddress(t), time.Now().Add(15*time.Minute).UTC()
+
+	claim := func() bool {
+		t.Helper()
+		claimed, err := store.ClaimTransferAuthorization(ctx, from, "42", deadline)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return claimed
+	}
+
+	if !claim() {
+		t.Fatal("first claim refused")
+	}
+	if claim() {
+		t.Fatal("second claim of the same nonce succeeded")
+	}
+
+	// Another sender's nonce is separate
+	if claimed, err := store.ClaimTransferAuthorization(ctx, newAddress(t), "42", deadline); err != nil || !claimed {
+		t.Fatalf("claim for another sender = %v, %v; want true", claimed, err)
+	}
+
+	// A claim that was never sent can be released and claimed again
+	if err := store.ReleaseTransferAuthorization(ctx, from, "42"); err != nil {
+		t.Fatal(err)
+	}
+	if !claim() {
+		t.Fatal("claim after release refused")
+	}
+
+	// Once sent it is kept
+	if err := store.SetTransferAuthorizationTx(ctx, from, "42", newHash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ReleaseTransferAuthorization(ctx, from, "42"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("ReleaseTransferAuthorization after sending: error = %v, want ErrNotFound", err)
+	}
+	if claim() {
+		t.Error("claim after sending succeeded")
+	}
+}
+
+func TestCreateSponsorshipAfterIndexer(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+
+	ref := repository.EventRef{TxHash: newHash(), BlockNumber: 100, BlockHash: newHash(), LogIndex: 3}
+	user := newAddress(t)
+	if err := store.IndexSponsorship(ctx, ref, &repository.PaymasterSponsorship{
+		UserAddress: user, GasUsed: 21000, VyrCost: "0.5",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The API records the same log after the indexer; it must not count twice
+	sponsorship := &repository.PaymasterSponsorship{UserAddress: user, GasUsed: 21000, VyrCost: "0.5"}
+	if err := store.CreateSponsorship(ctx, ref, sponsorship); err != nil {
+		t.Fatal(err)
+	}
+	if sponsorship.ID == "" {
+		t.Fatal("CreateSponsorship did not return the indexed row")
+	}
+
+	var count int
+	if err := store.DB().QueryRowContext(ctx, `
+		SELECT count(*) FROM paymaster_sponsorships WHERE transaction_hash = $1`, ref.TxHash).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("%d sponsorship rows for one log, want 1", count)
+	}
+}
+
+func TestInvoiceTransitions(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+
+	invoice := &repository.Invoice{MerchantAddress: newAddress(t), Amount: "10", Status: repository.StatusCreated}
+	if err := store.CreateInvoice(ctx, invoice); err != nil {
+		t.Fatal(err)
+	}
+
+	// A payment indexed before the invoice was seen as pending records the
+	// step it skipped, and the late pending event is then ignored
+	block := int64(42)
+	if err := store.TransitionInvoice(ctx, invoice.InvoiceID, repository.StatusPaid, repository.TransitionChain, &block); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.TransitionInvoice(ctx, invoice.InvoiceID, repository.StatusPendingPayment, repository.TransitionChain, &block); err != nil {
+		t.Fatalf("late chain transition error = %v, want it ignored", err)
+	}
+
+	err := store.TransitionInvoice(ctx, invoice.InvoiceID, repository.StatusCancelled, repository.TransitionAPI, nil)
+	if !errors.Is(err, repository.ErrInvalidTransition) {
+		t.Errorf("cancelling a paid invoice error = %v, want ErrInvalidTransition", err)
+	}
+	err = store.TransitionInvoice(ctx, "missing", repository.StatusCancelled, repository.TransitionAPI, nil)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("TransitionInvoice(missing) error = %v, want ErrNotFound", err)
+	}
+
+	transitions, err := store.ListInvoiceTransitions(ctx, invoice.InvoiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, tr := range transitions {
+		got = append(got, tr.To)
+	}
+	want := []string{repository.StatusCreated, repository.StatusPendingPayment, repository.StatusPaid}
+	if len(got) != len(want) {
+		t.Fatalf("transitions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("transitions = %v, want %v", got, want)
+		}
+	}
+	if transitions[2].BlockNumber == nil || *transitions[2].BlockNumber != block {
+		t.Errorf("paid transition block = %v, want %d", transitions[2].BlockNumber, block)
+	}
+}
+
+func TestBlockTimes(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	customer, merchant := newAddress(t), newAddress(t)
+	newPaymentID := func() string { return common.Bytes2Hex(crypto.Keccak256([]byte(uuid.NewString()))) }
+
+	// Block times well before the rows are recorded, so falling back to
+	// created_at would show
+	mined := time.Date(2023, 3, 4, 10, 0, 0, 0, time.UTC)
+	later := mined.Add(36 * time.Hour)
+
+	first := &repository.Payment{PaymentID: newPaymentID(), CustomerAddress: customer, MerchantAddress: merchant,
+		Amount: "10", MerchantFee: "0.1", PlatformFee: "0.05"}
+	firstRef := repository.EventRef{TxHash: newHash(), BlockNumber: 500, BlockHash: newHash(), BlockTime: mined}
+	if err := store.IndexPayment(ctx, firstRef, first, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The API records the same log from its receipt, without a block time;
+	// the indexed time must survive
+	firstRef.BlockTime = time.Time{}
+	if err := store.IndexPayment(ctx, firstRef, first, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A payment recorded without a block time is found and filled in by the
+	// backfill
+	second := &repository.Payment{PaymentID: newPaymentID(), CustomerAddress: customer, MerchantAddress: merchant,
+		Amount: "20", MerchantFee: "0", PlatformFee: "0"}
+	secondRef := repository.EventRef{TxHash: newHash(), BlockNumber: 501, BlockHash: newHash()}
+	if err := store.IndexPayment(ctx, secondRef, second, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	blocks, err := store.BlocksWithoutTime(ctx, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !containsBlock(blocks, 501) || containsBlock(blocks, 500) {
+		t.Errorf("BlocksWithoutTime = %v, want 501 and not 500", blocks)
+	}
+	if err := store.SetBlockTime(ctx, 501, later); err != nil {
+		t.Fatal(err)
+	}
+
+	refundTime := later.Add(time.Hour)
+	refundRef := repository.EventRef{TxHash: newHash(), BlockNumber: 502, BlockHash: newHash(), BlockTime: refundTime}
+	if err := store.IndexRefund(ctx, refundRef, first.PaymentID, "10"); err != nil {
+		t.Fatal(err)
+	}
+
+	activity, err := store.ListActivity(ctx, repository.ActivityFilter{Address: customer, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		typ string
+		at  time.Time
+	}{
+		{repository.ActivityRefund, refundTime},
+		{repository.ActivityPayment, later},
+		{repository.ActivityPayment, mined},
+	}
+	if len(activity) != len(want) {
+		t.Fatalf("ListActivity returned %d entries, want %d", len(activity), len(want))
+	}
+	for n, w := range want {
+		if activity[n].Type != w.typ || !activity[n].Timestamp.Equal(w.at) {
+			t.Errorf("activity[%d] = %s at %s, want %s at %s", n, activity[n].Type, activity[n].Timestamp, w.typ, w.at)
+		}
+	}
+
+	from := mined.Add(time.Hour)
+	filtered, err := store.ListActivity(ctx, repository.ActivityFilter{Address: customer, From: &from, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 2 {
+		t.Errorf("ListActivity from %s returned %d entries, want 2", from, len(filtered))
+	}
+
+	buckets, err := store.MerchantVolume(ctx, repository.MerchantRange{Merchant: merchant}, "day")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 2 || !buckets[0].Start.Equal(time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC)) ||
+		!buckets[1].Start.Equal(time.Date(2023, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("MerchantVolume buckets = %+v, want 2023-03-04 and 2023-03-05", buckets)
+	}
+
+	settlements, err := store.ListSettlements(ctx, repository.MerchantRange{Merchant: merchant, To: &from}, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settlements) != 1 || !settlements[0].Timestamp.Equal(mined) {
+		t.Errorf("ListSettlements before %s = %+v, want the first payment at %s", from, settlements, mined)
+	}
+}
+
+func containsBlock(blocks []int64, block int64) bool {
+	for _, b := range blocks {
+		if b == block {
+			return true
+		}
+	}
+	return false
+}
+
+func TestActivity(t *testing.T) {
+	store := testdb.Open(t)
+	ctx := context.Background()
+	customer, merchant, pos := newAddress(t), newAddress(t), newAddress(t)
+	paymentID := common.Bytes2Hex(crypto.Keccak256([]byte(uuid.NewString())))
+
+	transfer := func(hash, from, to string, block int64, logIndex int) {
+		t.Helper()
+		ref := repository.EventRef{TxHash: hash, BlockNumber: block, BlockHash: newHash(), LogIndex: logIndex}
+		err := store.IndexTransfer(ctx, ref, &repository.Transaction{
+			FromAddress: from, ToAddress: to, Amount: "1", Type: repository.TypeTransfer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := func(address string) []string {
+		t.Helper()
+		activity, err := store.ListActivity(ctx, repository.ActivityFilter{Address: address, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entries []string
+		for _, a := range activity {
+			entries = append(entries, a.Type+" "+*a.TxHash)
+		}
+		return entries
+	}
+	check := func(address string, want ...string) {
+		t.Helper()
+		if got := list(address); strings.Join(got, ", ") != strings.Join(want, ", ") {
+			t.Errorf("ListActivity = %v, want %v", got, want)
+		}
+	}
+
+	// The token Transfers of a payment are listed as the payment
+	payTx := newHash()
+	transfer(payTx, customer, merchant, 7101, 0)
+	transfer(payTx, customer, pos, 7101, 1)
+	payment := &repository.Payment{PaymentID: paymentID, CustomerAddress: customer, MerchantAddress: merchant,
+		Amount: "10", MerchantFee: "0.03", PlatformFee: "0"}
+	if err := store.IndexPayment(ctx, repository.EventRef{TxHash: payTx, BlockNumber: 7101, BlockHash: newHash(), LogIndex: 2},
+		payment, "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	sendTx := newHash()
+	transfer(sendTx, customer, merchant, 7102, 0)
+
+	// Until the refund is indexed, the transfer funding it is the merchant's
+	// own send
+	key := uuid.NewString()
+	refund := &repository.Refund{PaymentID: paymentID, MerchantAddress: &merchant, IdempotencyKey: &key, Amount: "10"}
+	if _, err := store.ClaimRefund(ctx, refund); err != nil {
+		t.Fatal(err)
+	}
+	fundingTx := newHash()
+	if err := store.SetRefundFunding(ctx, refund.ID, fundingTx); err != nil {
+		t.Fatal(err)
+	}
+	transfer(fundingTx, merchant, pos, 7103, 0)
+	check(merchant, "send "+fundingTx, "receive "+sendTx, "payment "+payTx)
+
+	refundTx := newHash()
+	if err := store.SetRefundSubmitted(ctx, refund.ID, refundTx); err != nil {
+		t.Fatal(err)
+	}
+	transfer(refundTx, pos, customer, 7104, 0)
+	if err := store.IndexRefund(ctx, repository.EventRef{TxHash: refundTx, BlockNumber: 7104, BlockHash: newHash(), LogIndex: 1},
+		paymentID, "10"); err != nil {
+		t.Fatal(err)
+	}
+	check(customer, "refund "+refundTx, "send "+sendTx, "payment "+payTx)
+	check(merchant, "refund "+refundTx, "receive "+sendTx, "payment "+payTx)
+
+	// Pages keep to the order entries were recorded in, so one whose block
+	// time is filled in between pages is neither skipped nor repeated
+	var paged []string
+	var after *repository.ActivityCursor
+	for n := 0; n < 4; n++ {
+		page, err := store.ListActivity(ctx, repository.ActivityFilter{Address: customer, After: after, Limit: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page[0].Type+" "+*page[0].TxHash)
+		after = &repository.ActivityCursor{Timestamp: page[0].RecordedAt, ID: page[0].ID}
+		if n == 0 {
+			if err := store.SetBlockTime(ctx, 7102, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if want := list(customer); strings.Join(paged, ", ") != strings.Join(want, ", ") {
+		t.Errorf("paged activity = %v, want %v", paged, want)
+	}
+}
@@ -0,0 +1,41 @@
+package repository
+
+import "context"
+
+const transactionColumns = `id, hash, from_address, to_address, amount, fee, status, type,
+	block_number, gas_used, gas_price, created_at, updated_at`
+
+func scanTransaction(row scanner) (*Transaction, error) {
+	var t Transaction
+	err := row.Scan(&t.ID, &t.Hash, &t.FromAddress, &t.ToAddress, &t.Amount, &t.Fee, &t.Status, &t.Type,
+		&t.BlockNumber, &t.GasUsed, &t.GasPrice, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &t, nil
+}
+
+func (q *Queries) CreateTransaction(ctx context.Context, t *Transaction) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO transactions (hash, from_address, to_address, amount, fee, status, type, block_number, gas_used, gas_price)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at`,
+		t.Hash, t.FromAddress, t.ToAddress, t.Amount, t.Fee, t.Status, t.Type, t.BlockNumber, t.GasUsed, t.GasPrice,
+	).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+}
+
+func (q *Queries) GetTransactionByHash(ctx context.Context, hash string) (*Transaction, error) {
+	return scanTransaction(q.q.QueryRowContext(ctx, `
+		SELECT `+transactionColumns+` FROM transactions WHERE hash = $1
+		ORDER BY log_index NULLS FIRST
+		LIMIT 1`, hash))
+}
+
+// UpdateTransactionStatus records the outcome of a transaction once it is
+// mined or dropped.
+func (q *Queries) UpdateTransactionStatus(ctx context.Context, hash, status string, blockNumber *int64) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE transactions SET status = $2, block_number = COALESCE($3, block_number)
+		WHERE hash = $1`, hash, status, blockNumber)
+	return expectRow(res, err)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// ClaimTransferAuthorization records that the authorization with from's
+// nonce is being relayed. It returns false if it has already been claimed.
+func (q *Queries) ClaimTransferAuthorization(ctx context.Context, from, nonce string, deadline time.Time) (bool, error) {
+	res, err := q.q.ExecContext(ctx, `
+		INSERT INTO transfer_authorizations (from_address, nonce, deadline)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (from_address, nonce) DO NOTHING`, from, nonce, deadline)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// ReleaseTransferAuthorization drops a claim whose transferFrom was never
+// sent, so the authorization can be used again.
+func (q *Queries) ReleaseTransferAuthorization(ctx context.Context, from, nonce string) error {
+	res, err := q.q.ExecContext(ctx, `
+		DELETE FROM transfer_authorizations WHERE from_address = $1 AND nonce = $2 AND tx_hash IS NULL`,
+		from, nonce)
+	return expectRow(res, err)
+}
+
+// SetTransferAuthorizationTx records the transaction an authorization was
+// relayed in.
+func (q *Queries) SetTransferAuthorizationTx(ctx context.Context, from, nonce, txHash string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE transfer_authorizations SET tx_hash = $3 WHERE from_address = $1 AND nonce = $2`,
+		from, nonce, txHash)
+	return expectRow(res, err)
+}
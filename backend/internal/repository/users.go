@@ -0,0 +1,51 @@
+package repository
+
+import "context"
+
+const userColumns = `id, address, is_active, created_at, updated_at`
+
+func scanUser(row scanner) (*User, error) {
+	var u User
+	if err := row.Scan(&u.ID, &u.Address, &u.IsActive, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		return nil, notFound(err)
+	}
+	return &u, nil
+}
+
+// UpsertUser returns the user for address, creating it on first sight.
+func (q *Queries) UpsertUser(ctx context.Context, address string) (*User, error) {
+	return scanUser(q.q.QueryRowContext(ctx, `
+		INSERT INTO users (address) VALUES ($1)
+		ON CONFLICT (address) DO UPDATE SET address = EXCLUDED.address
+		RETURNING `+userColumns, address))
+}
+
+func (q *Queries) GetUserByAddress(ctx context.Context, address string) (*User, error) {
+	return scanUser(q.q.QueryRowContext(ctx, `
+		SELECT `+userColumns+` FROM users WHERE address = $1`, address))
+}
+
+const walletColumns = `id, user_id, address, private_key_encrypted, mnemonic_encrypted, created_at, updated_at`
+
+func scanWallet(row scanner) (*Wallet, error) {
+	var w Wallet
+	err := row.Scan(&w.ID, &w.UserID, &w.Address, &w.PrivateKeyEncrypted, &w.MnemonicEncrypted, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &w, nil
+}
+
+func (q *Queries) CreateWallet(ctx context.Context, w *Wallet) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO wallets (user_id, address, private_key_encrypted, mnemonic_encrypted)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at`,
+		w.UserID, w.Address, w.PrivateKeyEncrypted, w.MnemonicEncrypted,
+	).Scan(&w.ID, &w.CreatedAt, &w.UpdatedAt)
+}
+
+func (q *Queries) GetWalletByAddress(ctx context.Context, address string) (*Wallet, error) {
+	return scanWallet(q.q.QueryRowContext(ctx, `
+		SELECT `+walletColumns+` FROM wallets WHERE address = $1`, address))
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const webhookEndpointColumns = `id, owner_address, url, event_types, is_active, created_at, updated_at`
+
+func scanWebhookEndpoint(row scanner) (*WebhookEndpoint, error) {
+	var e WebhookEndpoint
+	err := row.Scan(&e.ID, &e.OwnerAddress, &e.URL, pq.Array(&e.EventTypes), &e.IsActive, &e.CreatedAt, &e.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &e, nil
+}
+
+const webhookDeliveryColumns = `d.id, d.endpoint_id, d.event_id, ev.type, d.status, d.attempts,
+	d.next_attempt_at, d.last_status_code, d.last_error, d.delivered_at, ev.payload, d.created_at, d.updated_at`
+
+func scanWebhookDelivery(row scanner) (*WebhookDelivery, error) {
+	var d WebhookDelivery
+	var nextAttemptAt time.Time
+	err := row.Scan(&d.ID, &d.EndpointID, &d.EventID, &d.EventType, &d.Status, &d.Attempts,
+		&nextAttemptAt, &d.LastStatusCode, &d.LastError, &d.DeliveredAt, &d.Payload, &d.CreatedAt, &d.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if d.Status == StatusPending {
+		d.NextAttemptAt = &nextAttemptAt
+	}
+	return &d, nil
+}
+
+// CreateWebhookEndpoint registers an endpoint, including its secret.
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, e *WebhookEndpoint) error {
+	return q.q.QueryRowContext(ctx, `
+		INSERT INTO webhook_endpoints (owner_address, url, secret, event_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, is_active, created_at, updated_at`,
+		e.OwnerAddress, e.URL, e.Secret, pq.Array(e.EventTypes),
+	).Scan(&e.ID, &e.IsActive, &e.CreatedAt, &e.UpdatedAt)
+}
+
+// GetWebhookEndpoint returns an endpoint without its secret.
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return scanWebhookEndpoint(q.q.QueryRowContext(ctx, `
+		SELECT `+webhookEndpointColumns+` FROM webhook_endpoints WHERE id = $1`, id))
+}
+
+// ListWebhookEndpoints returns an address's active endpoints, newest first,
+// without their secrets.
+func (q *Queries) ListWebhookEndpoints(ctx context.Context, owner string) ([]*WebhookEndpoint, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT `+webhookEndpointColumns+` FROM webhook_endpoints
+		WHERE owner_address = $1 AND is_active
+		ORDER BY created_at DESC`, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var endpoints []*WebhookEndpoint
+	for rows.Next() {
+		e, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, rows.Err()
+}
+
+// DeactivateWebhookEndpoint stops new events and pending deliveries going
+// to an endpoint. Its delivery log is kept.
+func (q *Queries) DeactivateWebhookEndpoint(ctx context.Context, id string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE webhook_endpoints SET is_active = false WHERE id = $1 AND is_active`, id)
+	return expectRow(res, err)
+}
+
+// DeliveryFilter selects deliveries, newest first. EndpointID or Owner, the
+// owner of the endpoint, must be set; Status is optional.
+type DeliveryFilter struct {
+	EndpointID string
+	Owner      string
+	Status     string
+	Limit      int
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, f DeliveryFilter) ([]*WebhookDelivery, error) {
+	var args []interface{}
+	var where []string
+	if f.EndpointID != "" {
+		args = append(args, f.EndpointID)
+		where = append(where, fmt.Sprintf("d.endpoint_id = $%d", len(args)))
+	}
+	if f.Owner != "" {
+		args = append(args, f.Owner)
+		where = append(where, fmt.Sprintf("e.owner_address = $%d", len(args)))
+	}
+	if f.Status != "" {
+		args = append(args, f.Status)
+		where = append(where, fmt.Sprintf("d.status = $%d", len(args)))
+	}
+	if len(where) == 0 {
+		return nil, fmt.Errorf("delivery filter needs an endpoint or owner")
+	}
+	args = append(args, f.Limit)
+
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries d
+		JOIN webhook_events ev ON ev.id = d.event_id
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY d.created_at DESC, d.id
+		LIMIT $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return scanWebhookDelivery(q.q.QueryRowContext(ctx, `
+		SELECT `+webhookDeliveryColumns+`
+		FROM webhook_deliveries d JOIN webhook_events ev ON ev.id = d.event_id
+		WHERE d.id = $1`, id))
+}
+
+// ListWebhookAttempts returns a delivery's attempt log, oldest first.
+func (q *Queries) ListWebhookAttempts(ctx context.Context, deliveryID string) ([]*WebhookAttempt, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		SELECT id, delivery_id, status_code, error, response_body, duration_ms, created_at
+		FROM webhook_attempts
+		WHERE delivery_id = $1
+		ORDER BY created_at, id`, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []*WebhookAttempt
+	for rows.Next() {
+		var a WebhookAttempt
+		if err := rows.Scan(&a.ID, &a.DeliveryID, &a.StatusCode, &a.Error, &a.ResponseBody, &a.DurationMs, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, &a)
+	}
+	return attempts, rows.Err()
+}
+
+// ReplayWebhookDelivery queues a delivery to be sent again now with a fresh
+// set of attempts, whatever its status. Its attempt log is kept.
+func (q *Queries) ReplayWebhookDelivery(ctx context.Context, id string) error {
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = 0, next_attempt_at = CURRENT_TIMESTAMP, delivered_at = NULL
+		WHERE id = $1`, id, StatusPending)
+	return expectRow(res, err)
+}
+
+// DueDelivery is a delivery claimed for sending, with what is needed to
+// send it.
+type DueDelivery struct {
+	ID             string
+	Attempts       int
+	EventID        string
+	EventType      string
+	EventCreatedAt time.Time
+	Payload        []byte
+	URL            string
+	Secret         string
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries to active
+// endpoints that are due, and pushes their next attempt back by lease so
+// that no other dispatcher claims them while they are being sent.
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*DueDelivery, error) {
+	rows, err := q.q.QueryContext(ctx, `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = CURRENT_TIMESTAMP + $2 * interval '1 millisecond'
+		FROM webhook_events ev, webhook_endpoints e
+		WHERE d.id IN (
+			SELECT d.id FROM webhook_deliveries d
+			JOIN webhook_endpoints e ON e.id = d.endpoint_id
+			WHERE d.status = $3 AND d.next_attempt_at <= CURRENT_TIMESTAMP AND e.is_active
+			ORDER BY d.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF d SKIP LOCKED
+		) AND ev.id = d.event_id AND e.id = d.endpoint_id
+		RETURNING d.id, d.attempts, ev.id, ev.type, ev.created_at, ev.payload, e.url, e.secret`,
+		limit, lease.Milliseconds(), StatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var due []*DueDelivery
+	for rows.Next() {
+		var d DueDelivery
+		if err := rows.Scan(&d.ID, &d.Attempts, &d.EventID, &d.EventType, &d.EventCreatedAt, &d.Payload, &d.URL, &d.Secret); err != nil {
+			return nil, err
+		}
+		due = append(due, &d)
+	}
+	return due, rows.Err()
+}
+
+// RecordWebhookAttempt logs an attempt and moves its delivery to status:
+// DeliverySucceeded, DeliveryDead, or StatusPending to be retried at
+// nextAttempt. Run it inside WithTx.
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, a *WebhookAttempt, status string, nextAttempt time.Time) error {
+	err := q.q.QueryRowContext(ctx, `
+		INSERT INTO webhook_attempts (delivery_id, status_code, error, response_body, duration_ms)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`,
+		a.DeliveryID, a.StatusCode, a.Error, a.ResponseBody, a.DurationMs,
+	).Scan(&a.ID, &a.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	res, err := q.q.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, next_attempt_at = $3,
+			last_status_code = $4, last_error = $5,
+			delivered_at = CASE WHEN $2 = $6 THEN CURRENT_TIMESTAMP END
+		WHERE id = $1`,
+		a.DeliveryID, status, nextAttempt, a.StatusCode, a.Error, DeliverySucceeded)
+	return expectRow(res, err)
+}
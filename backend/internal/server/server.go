@@ -2,22 +2,32 @@ package server
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net"
 	"net/http"
 	"time"
 
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/auth"
 	"vyra-backend/internal/config"
 	"vyra-backend/internal/handlers"
+	"vyra-backend/internal/lifecycle"
+	"vyra-backend/internal/metrics"
 	"vyra-backend/internal/middleware"
+	"vyra-backend/internal/services"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
 type Server struct {
-	config  *config.Config
-	router  *gin.Engine
-	server  *http.Server
-	handler *handlers.Handler
+	config    *config.Config
+	router    *gin.Engine
+	server    *http.Server
+	handler   *handlers.Handler
+	services  *services.Services
+	lifecycle *lifecycle.Manager
 }
 
 func New(cfg *config.Config) *Server {
@@ -30,79 +40,213 @@ func New(cfg *config.Config) *Server {
 
 	// Create router
 	router := gin.New()
-	router.Use(gin.Logger())
-	router.Use(gin.Recovery())
-	router.Use(middleware.CORS())
-
-	// Create handler
-	handler := handlers.New(cfg)
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(func(c *gin.Context) {
+		apierror.Abort(c, apierror.NotFound("Route not found"))
+	})
+	router.NoMethod(func(c *gin.Context) {
+		apierror.Abort(c, apierror.New(http.StatusMethodNotAllowed, apierror.CodeMethodNotAllowed, "Method not allowed"))
+	})
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logrus.WithError(err).Fatal("Invalid TRUSTED_PROXIES")
+	}
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Logger())
+	// Outside Recovery, so requests that panic are recorded as 500s
+	router.Use(middleware.Metrics())
+	router.Use(gin.CustomRecovery(func(c *gin.Context, _ interface{}) {
+		apierror.Abort(c, apierror.Internal("Internal server error"))
+	}))
+	router.Use(middleware.CORS(cfg.CORS.Default,
+		// The web checkout pays invoices
+		middleware.CORSRule{PathPrefix: "/api/v1/payments", Policy: cfg.CORS.Checkout},
+		// The admin console manages merchants' keys, reports and webhooks
+		middleware.CORSRule{PathPrefix: "/api/v1/merchants", Policy: cfg.CORS.Admin},
+		middleware.CORSRule{PathPrefix: "/api/v1/api-keys", Policy: cfg.CORS.Admin},
+		middleware.CORSRule{PathPrefix: "/api/v1/webhooks", Policy: cfg.CORS.Admin},
+	))
+	router.Use(middleware.Timeout(cfg.RequestTimeout))
+
+	// Create services and handler
+	services := services.New(cfg)
+	handler := handlers.New(cfg, services)
 
 	// Setup routes
-	setupRoutes(router, handler)
-
-	return &Server{
-		config:  cfg,
-		router:  router,
-		handler: handler,
+	setupRoutes(router, cfg, handler, services)
+
+	s := &Server{
+		config:   cfg,
+		router:   router,
+		handler:  handler,
+		services: services,
+		server: &http.Server{
+			Addr:         ":" + cfg.Port,
+			Handler:      router,
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: cfg.RequestTimeout + 5*time.Second, // time to answer requests that ran out
+			IdleTimeout:  60 * time.Second,
+		},
+		lifecycle: lifecycle.New(),
 	}
+	s.register()
+	return s
 }
 
-func (s *Server) Start() error {
-	s.server = &http.Server{
-		Addr:         ":" + s.config.Port,
-		Handler:      s.router,
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  60 * time.Second,
+// register adds the components to the lifecycle in start order; they stop
+// in reverse. HTTP requests drain first, then the indexer, the invoice
+// sweeper and the webhook dispatcher stop in turn, so the dispatcher can
+// still deliver what the others recorded, and the stores close last.
+func (s *Server) register() {
+	s.lifecycle.AddCloser("database", s.services.Store.Close)
+	s.lifecycle.AddCloser("rpc client", s.services.RPC.Close)
+	if closer, ok := s.services.RateLimiter.(io.Closer); ok {
+		s.lifecycle.AddCloser("rate limiter", closer.Close)
+	}
+
+	if s.services.Webhooks != nil {
+		s.lifecycle.AddWorker("webhook dispatcher", s.services.Webhooks.Run)
+	}
+	s.lifecycle.AddWorker("invoice worker", s.services.Invoices.Run)
+	if s.services.Indexer != nil {
+		s.lifecycle.AddWorker("indexer", s.services.Indexer.Run)
 	}
 
-	logrus.Infof("Server starting on port %s", s.config.Port)
-	return s.server.ListenAndServe()
+	s.lifecycle.Add(lifecycle.Component{
+		Name: "http server",
+		Start: func(context.Context) error {
+			// Listen before returning so a taken port fails Start
+			listener, err := net.Listen("tcp", s.server.Addr)
+			if err != nil {
+				return err
+			}
+			logrus.Infof("Server starting on port %s", s.config.Port)
+			go func() {
+				if err := s.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+					s.lifecycle.Fail("http server", err)
+				}
+			}()
+			return nil
+		},
+		Stop: s.server.Shutdown,
+	})
 }
 
-func (s *Server) Stop(ctx context.Context) error {
-	logrus.Info("Server shutting down...")
-	return s.server.Shutdown(ctx)
+// Run serves until ctx is cancelled or a component fails, then shuts down
+// gracefully within ShutdownTimeout.
+func (s *Server) Run(ctx context.Context) error {
+	err := s.lifecycle.Run(ctx, s.config.ShutdownTimeout)
+	if err == nil {
+		logrus.Info("Server stopped")
+	}
+	return err
 }
 
-func setupRoutes(router *gin.Engine, handler *handlers.Handler) {
-	// Health check
+func setupRoutes(router *gin.Engine, cfg *config.Config, handler *handlers.Handler, services *services.Services) {
+	// Health checks
 	router.GET("/health", handler.HealthCheck)
+	router.GET("/health/live", handler.LiveCheck)
+	router.GET("/health/ready", handler.ReadyCheck)
+
+	// Prometheus metrics
+	if cfg.MetricsEnabled {
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	}
+
+	// session requires a Sign-In with Ethereum access token; API keys only
+	// reach the routes of their scopes
+	session := middleware.RequireSession()
+
+	// strict applies the tighter limit to routes that spend the relayer's or
+	// paymaster's funds, each with its own allowance
+	strict := func(name string) gin.HandlerFunc {
+		return middleware.RateLimit(services.RateLimiter, name, cfg.RateLimitStrict)
+	}
 
 	// API v1 routes
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.Authenticate(services.Auth, services.APIKey))
+	v1.Use(middleware.RateLimit(services.RateLimiter, "default", cfg.RateLimitDefault))
 	{
+		// Auth routes
+		authRoutes := v1.Group("/auth")
+		{
+			authRoutes.GET("/nonce", handler.GetAuthNonce)
+			authRoutes.POST("/login", handler.Login)
+			authRoutes.POST("/refresh", handler.RefreshSession)
+			authRoutes.POST("/logout", handler.Logout)
+		}
+
+		// API key routes
+		apiKeys := v1.Group("/api-keys", session)
+		{
+			apiKeys.POST("", handler.CreateAPIKey)
+			apiKeys.GET("", handler.GetAPIKeys)
+			apiKeys.POST("/:id/rotate", handler.RotateAPIKey)
+			apiKeys.DELETE("/:id", handler.RevokeAPIKey)
+		}
+
 		// Wallet routes
 		wallets := v1.Group("/wallets")
 		{
 			wallets.POST("/connect", handler.ConnectWallet)
 			wallets.GET("/:address/balance", handler.GetBalance)
 			wallets.GET("/:address/vyra-balance", handler.GetVyraBalance)
-			wallets.POST("/:address/send", handler.SendPayment)
+			wallets.GET("/:address/send/quote", handler.QuoteTransfer)
+			wallets.POST("/:address/send", strict("send"), session, middleware.RequireAddress("address"), handler.SendPayment)
+			wallets.GET("/:address/transactions", handler.GetTransactions)
 		}
 
 		// Payment routes
 		payments := v1.Group("/payments")
 		{
-			payments.POST("/invoice", handler.CreateInvoice)
+			payments.POST("/invoice", middleware.RequireScope(auth.ScopeInvoicesWrite), handler.CreateInvoice)
+			payments.POST("/resolve", handler.ResolvePaymentLink)
 			payments.GET("/:id", handler.GetPayment)
+			payments.GET("/:id/link", handler.GetPaymentLink)
+			payments.GET("/:id/qr", handler.GetPaymentQR)
 			payments.POST("/:id/process", handler.ProcessPayment)
+			payments.POST("/:id/split", strict("split"), session, handler.ProcessSplitPayment)
+			payments.POST("/:id/refund", middleware.RequireScope(auth.ScopeRefundsWrite), handler.RefundPayment)
+			payments.GET("/:id/refunds", handler.GetRefunds)
+			payments.POST("/:id/cancel", middleware.RequireScope(auth.ScopeInvoicesWrite), handler.CancelInvoice)
+			payments.GET("/:id/transitions", handler.GetInvoiceTransitions)
+		}
+
+		// Merchant routes
+		merchants := v1.Group("/merchants", middleware.RequireScope(auth.ScopePaymentsRead), middleware.RequireAddress("address"))
+		{
+			merchants.GET("/:address/stats", handler.GetMerchantStats)
+			merchants.GET("/:address/volume", handler.GetMerchantVolume)
+			merchants.GET("/:address/settlements", handler.GetSettlementReport)
 		}
 
 		// Bridge routes
 		bridge := v1.Group("/bridge")
 		{
-			bridge.POST("/deposit", handler.Deposit)
-			bridge.POST("/withdraw", handler.Withdraw)
+			bridge.POST("/deposit", session, handler.Deposit)
+			bridge.POST("/withdraw", session, handler.Withdraw)
 			bridge.GET("/status/:id", handler.GetBridgeStatus)
 		}
 
 		// Paymaster routes
 		paymaster := v1.Group("/paymaster")
 		{
-			paymaster.POST("/session-key", handler.CreateSessionKey)
-			paymaster.DELETE("/session-key", handler.RevokeSessionKey)
-			paymaster.POST("/sponsor", handler.SponsorGas)
+			paymaster.POST("/session-key", session, handler.CreateSessionKey)
+			paymaster.GET("/session-key/:user", handler.GetSessionKey)
+			paymaster.DELETE("/session-key", session, handler.RevokeSessionKey)
+			paymaster.POST("/sponsor", strict("sponsor"), session, handler.SponsorGas)
+		}
+
+		// Webhook routes
+		webhooks := v1.Group("/webhooks", middleware.RequireScope(auth.ScopeWebhooksManage))
+		{
+			webhooks.POST("/endpoints", handler.CreateWebhookEndpoint)
+			webhooks.GET("/endpoints", handler.GetWebhookEndpoints)
+			webhooks.DELETE("/endpoints/:id", handler.DeleteWebhookEndpoint)
+			webhooks.GET("/endpoints/:id/deliveries", handler.GetWebhookDeliveries)
+			webhooks.GET("/deliveries/:id", handler.GetWebhookDelivery)
+			webhooks.POST("/deliveries/:id/replay", handler.ReplayWebhookDelivery)
+			webhooks.GET("/dead-letters", handler.GetWebhookDeadLetters)
 		}
 	}
 }
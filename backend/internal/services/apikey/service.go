@@ -0,0 +1,184 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/auth"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+const maxNameLength = 255
+
+// ErrInvalidRequest is returned for a malformed merchant address, name,
+// scope or key ID.
+var ErrInvalidRequest = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid API key request")
+
+type Service struct {
+	config *config.Config
+	store  *repository.Store
+}
+
+func New(cfg *config.Config, store *repository.Store) *Service {
+	return &Service{
+		config: cfg,
+		store:  store,
+	}
+}
+
+// Create issues a key acting for merchant within scopes. The returned key
+// carries its value, which is not shown again.
+func (s *Service) Create(ctx context.Context, merchant, name string, scopes []string) (*repository.APIKey, error) {
+	if !common.IsHexAddress(merchant) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, chain.InvalidAddress("merchant", merchant))
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxNameLength {
+		return nil, fmt.Errorf("%w: name must be 1 to %d characters", ErrInvalidRequest, maxNameLength)
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidRequest)
+	}
+	seen := make(map[string]bool)
+	var unique []string
+	for _, scope := range scopes {
+		if !isScope(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalidRequest, scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+
+	key := &repository.APIKey{
+		MerchantAddress: common.HexToAddress(merchant).Hex(),
+		Name:            name,
+		Scopes:          unique,
+	}
+	if err := s.issue(ctx, s.store.Queries, key); err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+	return key, nil
+}
+
+// List returns merchant's keys, newest first, including revoked ones.
+func (s *Service) List(ctx context.Context, merchant string) ([]*repository.APIKey, error) {
+	if !common.IsHexAddress(merchant) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, chain.InvalidAddress("merchant", merchant))
+	}
+	keys, err := s.store.ListAPIKeys(ctx, common.HexToAddress(merchant).Hex())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	if keys == nil {
+		keys = []*repository.APIKey{}
+	}
+	return keys, nil
+}
+
+// Rotate revokes one of merchant's keys and issues a new one with the same
+// name and scopes in its place. It returns repository.ErrNotFound if the key
+// does not exist or is already revoked.
+func (s *Service) Rotate(ctx context.Context, merchant, id string) (*repository.APIKey, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+
+	var key *repository.APIKey
+	err := s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		current, err := tx.GetAPIKey(ctx, common.HexToAddress(merchant).Hex(), id)
+		if err != nil {
+			return err
+		}
+		if current.RevokedAt != nil {
+			return repository.ErrNotFound
+		}
+
+		key = &repository.APIKey{
+			MerchantAddress: current.MerchantAddress,
+			Name:            current.Name,
+			Scopes:          current.Scopes,
+		}
+		if err := s.issue(ctx, tx.Queries, key); err != nil {
+			return err
+		}
+		return tx.RevokeAPIKey(ctx, current.ID, &key.ID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to rotate API key: %w", err)
+	}
+	return key, nil
+}
+
+// Revoke revokes one of merchant's keys. It returns repository.ErrNotFound
+// if the key does not exist or is already revoked.
+func (s *Service) Revoke(ctx context.Context, merchant, id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+
+	return s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		current, err := tx.GetAPIKey(ctx, common.HexToAddress(merchant).Hex(), id)
+		if err != nil {
+			return err
+		}
+		return tx.RevokeAPIKey(ctx, current.ID, nil)
+	})
+}
+
+// AuthenticateKey returns the merchant address and scopes of an unrevoked
+// key and records that it was used.
+func (s *Service) AuthenticateKey(ctx context.Context, key string) (string, []string, error) {
+	if !auth.IsAPIKey(key) {
+		return "", nil, auth.ErrInvalidAPIKey
+	}
+	record, err := s.store.UseAPIKey(ctx, auth.HashSecret(key))
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+	return record.MerchantAddress, record.Scopes, nil
+}
+
+// issue generates a value for key and stores it.
+func (s *Service) issue(ctx context.Context, q *repository.Queries, key *repository.APIKey) error {
+	value, prefix, err := auth.NewAPIKey()
+	if err != nil {
+		return err
+	}
+	key.Key = value
+	key.Prefix = prefix
+	key.KeyHash = auth.HashSecret(value)
+	return q.CreateAPIKey(ctx, key)
+}
+
+func isScope(scope string) bool {
+	for _, s := range auth.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func validateID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: invalid key ID %q", ErrInvalidRequest, id)
+	}
+	return nil
+}
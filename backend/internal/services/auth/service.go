@@ -0,0 +1,276 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/auth"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// nonceTTL is how long a client has to sign in with a nonce
+	nonceTTL = 10 * time.Minute
+
+	// clockSkew is how far ahead of the server's clock a message's issued-at
+	// and not-before times may be
+	clockSkew = time.Minute
+
+	// refreshTokenPrefix marks refresh tokens so they are recognisable if
+	// leaked
+	refreshTokenPrefix = "vyra_rt_"
+
+	statement = "Sign in to Vyra."
+)
+
+var (
+	// ErrInvalidLogin is returned for a sign-in message that does not verify,
+	// does not match this server or carries an unknown or used nonce.
+	ErrInvalidLogin = apierror.Define(http.StatusUnauthorized, apierror.CodeInvalidLogin, "invalid sign-in")
+
+	// ErrInvalidRefreshToken is returned for an unknown, expired or revoked
+	// refresh token.
+	ErrInvalidRefreshToken = apierror.Define(http.StatusUnauthorized, apierror.CodeInvalidRefreshToken, "invalid refresh token")
+)
+
+type Service struct {
+	config *config.Config
+	store  *repository.Store
+	tokens *auth.Tokens
+}
+
+// Challenge is what a client needs to build a sign-in message. Message is a
+// ready-made message for Address when one was given.
+type Challenge struct {
+	Nonce   string `json:"nonce"`
+	Domain  string `json:"domain"`
+	URI     string `json:"uri"`
+	ChainID int64  `json:"chainId"`
+	Version string `json:"version"`
+	Message string `json:"message,omitempty"`
+}
+
+// Session is the tokens issued by a login or refresh.
+type Session struct {
+	Address               string    `json:"address"`
+	AccessToken           string    `json:"accessToken"`
+	TokenType             string    `json:"tokenType"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+func New(cfg *config.Config, store *repository.Store) *Service {
+	return &Service{
+		config: cfg,
+		store:  store,
+		tokens: auth.NewTokens(cfg.JWTSecret, cfg.AccessTokenTTL),
+	}
+}
+
+// Nonce issues a single-use nonce for a sign-in message. Given an address,
+// it also builds the message for the wallet to sign.
+func (s *Service) Nonce(ctx context.Context, address string) (*Challenge, error) {
+	if address != "" && !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidLogin, chain.InvalidAddress("", address))
+	}
+
+	nonce, err := randomHex(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	if err := s.store.CreateAuthNonce(ctx, nonce, nonceTTL); err != nil {
+		return nil, fmt.Errorf("failed to store nonce: %w", err)
+	}
+
+	challenge := &Challenge{
+		Nonce:   nonce,
+		Domain:  s.config.AuthDomain,
+		URI:     s.config.AuthURI,
+		ChainID: s.config.ChainID,
+		Version: auth.Version,
+	}
+	if address != "" {
+		now := time.Now().UTC().Truncate(time.Second)
+		expires := now.Add(nonceTTL)
+		message := &auth.Message{
+			Domain:         s.config.AuthDomain,
+			Address:        common.HexToAddress(address),
+			Statement:      statement,
+			URI:            s.config.AuthURI,
+			Version:        auth.Version,
+			ChainID:        s.config.ChainID,
+			Nonce:          nonce,
+			IssuedAt:       now,
+			ExpirationTime: &expires,
+		}
+		challenge.Message = message.String()
+	}
+	return challenge, nil
+}
+
+// Login verifies a signed sign-in message and starts a session for its
+// address. The message must be for this server's domain, URI and chain and
+// carry a nonce from Nonce, which it uses up.
+func (s *Service) Login(ctx context.Context, text, signature string) (*Session, error) {
+	message, err := auth.ParseMessage(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogin, err)
+	}
+	if message.Domain != s.config.AuthDomain {
+		return nil, fmt.Errorf("%w: domain must be %s", ErrInvalidLogin, s.config.AuthDomain)
+	}
+	if message.URI != s.config.AuthURI {
+		return nil, fmt.Errorf("%w: uri must be %s", ErrInvalidLogin, s.config.AuthURI)
+	}
+	if message.ChainID != s.config.ChainID {
+		return nil, fmt.Errorf("%w: chain ID must be %d", ErrInvalidLogin, s.config.ChainID)
+	}
+	if err := message.CheckTime(time.Now(), clockSkew); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogin, err)
+	}
+	if err := message.VerifySignature(text, signature); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogin, err)
+	}
+
+	var session *Session
+	err = s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		if err := tx.ConsumeAuthNonce(ctx, message.Nonce); errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("%w: unknown, expired or used nonce", ErrInvalidLogin)
+		} else if err != nil {
+			return err
+		}
+		session, _, err = s.issue(ctx, tx, message.Address.Hex(), "")
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidLogin) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	return session, nil
+}
+
+// Refresh exchanges a refresh token for a new session and revokes it.
+// Presenting a token that was already exchanged means it has leaked, so
+// every token from the same login is revoked.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (*Session, error) {
+	var session *Session
+	reused := false
+	err := s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		current, err := tx.GetRefreshToken(ctx, auth.HashSecret(refreshToken))
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidRefreshToken
+		} else if err != nil {
+			return err
+		}
+		if current.RevokedAt != nil {
+			if current.ReplacedBy != nil {
+				reused = true
+				return tx.RevokeRefreshTokenFamily(ctx, current.FamilyID)
+			}
+			return ErrInvalidRefreshToken
+		}
+		if current.Expired {
+			return ErrInvalidRefreshToken
+		}
+
+		var next string
+		session, next, err = s.issue(ctx, tx, current.Address, current.FamilyID)
+		if err != nil {
+			return err
+		}
+		return tx.RevokeRefreshToken(ctx, current.ID, &next)
+	})
+	if reused && err == nil {
+		return nil, fmt.Errorf("%w: token was already used; the session has been revoked", ErrInvalidRefreshToken)
+	}
+	if err != nil {
+		if errors.Is(err, ErrInvalidRefreshToken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to refresh session: %w", err)
+	}
+	return session, nil
+}
+
+// Logout revokes a refresh token and every token from the same login.
+// Access tokens already issued stay valid until they expire.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	err := s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		current, err := tx.GetRefreshToken(ctx, auth.HashSecret(refreshToken))
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidRefreshToken
+		} else if err != nil {
+			return err
+		}
+		return tx.RevokeRefreshTokenFamily(ctx, current.FamilyID)
+	})
+	if err != nil && !errors.Is(err, ErrInvalidRefreshToken) {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return err
+}
+
+// Authenticate verifies an access token and returns the checksummed address
+// it was issued to. It does not need the database, so ctx is unused.
+func (s *Service) Authenticate(ctx context.Context, accessToken string) (string, error) {
+	address, err := s.tokens.Verify(accessToken)
+	if err != nil {
+		return "", err
+	}
+	return address.Hex(), nil
+}
+
+// issue stores a new refresh token for address in family, or in a new
+// family if it is empty, and returns it with a new access token and the
+// refresh token's row ID.
+func (s *Service) issue(ctx context.Context, tx *repository.Tx, address, family string) (*Session, string, error) {
+	now := time.Now()
+	accessToken, expiresAt, err := s.tokens.Issue(common.HexToAddress(address), now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	refreshToken := refreshTokenPrefix + secret
+	record := &repository.RefreshToken{
+		TokenHash: auth.HashSecret(refreshToken),
+		Address:   address,
+		FamilyID:  family,
+	}
+	if err := tx.CreateRefreshToken(ctx, record, s.config.RefreshTokenTTL); err != nil {
+		return nil, "", err
+	}
+
+	return &Session{
+		Address:               address,
+		AccessToken:           accessToken,
+		TokenType:             "Bearer",
+		ExpiresAt:             expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: now.Add(s.config.RefreshTokenTTL),
+	}, record.ID, nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
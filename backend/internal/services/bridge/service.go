@@ -1,57 +1,115 @@
 package bridge
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+// ErrInvalidBridgeRequest marks a deposit or withdrawal with a malformed
+// amount or transaction hash.
+var ErrInvalidBridgeRequest = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid bridge request")
+
 type Service struct {
 	config *config.Config
+	store  *repository.Store
 }
 
-func New(cfg *config.Config) *Service {
+func New(cfg *config.Config, store *repository.Store) *Service {
 	return &Service{
 		config: cfg,
+		store:  store,
+	}
+}
+
+// Deposit records a pending L1 -> L2 deposit and returns its tracking ID.
+// l1TxHash is the user's deposit transaction, if already sent.
+func (s *Service) Deposit(ctx context.Context, user, amount, l1TxHash string) (string, error) {
+	if !common.IsHexAddress(user) {
+		return "", chain.InvalidAddress("user", user)
+	}
+	value, err := parseAmount(amount)
+	if err != nil {
+		return "", err
+	}
+	if l1TxHash != "" && !isTxHash(l1TxHash) {
+		return "", fmt.Errorf("%w: l1TxHash must be a 0x-prefixed 32-byte hash", ErrInvalidBridgeRequest)
+	}
+
+	deposit := &repository.BridgeTransaction{
+		UserAddress: common.HexToAddress(user).Hex(),
+		Amount:      chain.FormatUnits(value, chain.VYRDecimals),
+		Direction:   repository.DirectionDeposit,
+		Status:      repository.StatusPending,
+	}
+	if l1TxHash != "" {
+		deposit.L1TxHash = &l1TxHash
+	}
+
+	if err := s.store.CreateBridgeTransaction(ctx, deposit); err != nil {
+		return "", fmt.Errorf("failed to store deposit: %w", err)
 	}
+
+	return deposit.TransactionID, nil
 }
 
-func (s *Service) Deposit(amount string) (string, error) {
-	// Generate a random deposit ID
-	bytes := make([]byte, 32)
-	rand.Read(bytes)
-	depositID := hex.EncodeToString(bytes)
-	
-	// In a real implementation, this would:
-	// 1. Validate the amount
-	// 2. Create a deposit transaction
-	// 3. Store the deposit in the database
-	
-	return depositID, nil
+// Withdraw records a pending L2 -> L1 withdrawal with the validator
+// signatures collected for it and returns its tracking ID.
+func (s *Service) Withdraw(ctx context.Context, user, amount, l2TxHash string, signatures []string) (string, error) {
+	if !common.IsHexAddress(user) {
+		return "", chain.InvalidAddress("user", user)
+	}
+	value, err := parseAmount(amount)
+	if err != nil {
+		return "", err
+	}
+	if !isTxHash(l2TxHash) {
+		return "", fmt.Errorf("%w: l2TxHash must be a 0x-prefixed 32-byte hash", ErrInvalidBridgeRequest)
+	}
+
+	withdrawal := &repository.BridgeTransaction{
+		UserAddress: common.HexToAddress(user).Hex(),
+		Amount:      chain.FormatUnits(value, chain.VYRDecimals),
+		Direction:   repository.DirectionWithdrawal,
+		Status:      repository.StatusPending,
+		L2TxHash:    &l2TxHash,
+		Signatures:  signatures,
+	}
+
+	if err := s.store.CreateBridgeTransaction(ctx, withdrawal); err != nil {
+		return "", fmt.Errorf("failed to store withdrawal: %w", err)
+	}
+
+	return withdrawal.TransactionID, nil
+}
+
+// parseAmount parses a VYR amount such as "10.5", which must be positive.
+func parseAmount(amount string) (*big.Int, error) {
+	value, err := chain.ParseUnits(amount, chain.VYRDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBridgeRequest, err)
+	}
+	if value.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidBridgeRequest)
+	}
+	return value, nil
 }
 
-func (s *Service) Withdraw(amount, l2TxHash string, signatures []string) (string, error) {
-	// Generate a random withdrawal ID
-	bytes := make([]byte, 32)
-	rand.Read(bytes)
-	withdrawalID := hex.EncodeToString(bytes)
-	
-	// In a real implementation, this would:
-	// 1. Validate the withdrawal request
-	// 2. Verify the signatures
-	// 3. Process the withdrawal
-	
-	return withdrawalID, nil
+// isTxHash reports whether s is a 0x-prefixed 32-byte hex hash.
+func isTxHash(s string) bool {
+	b, err := hexutil.Decode(s)
+	return err == nil && len(b) == common.HashLength
 }
 
-func (s *Service) GetStatus(txID string) (map[string]interface{}, error) {
-	// In a real implementation, this would:
-	// 1. Query the database for the transaction
-	// 2. Return the current status
-	
-	return map[string]interface{}{
-		"id":     txID,
-		"status": "pending",
-		"type":   "deposit",
-	}, nil
+func (s *Service) GetStatus(ctx context.Context, txID string) (*repository.BridgeTransaction, error) {
+	return s.store.GetBridgeTransaction(ctx, txID)
 }
@@ -0,0 +1,207 @@
+package history
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+
+	// exportBatchSize is how many rows ExportCSV reads per query
+	exportBatchSize = 500
+)
+
+// ErrInvalidFilter is returned for a malformed address, filter or cursor.
+var ErrInvalidFilter = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid filter")
+
+type Service struct {
+	config *config.Config
+	store  *repository.Store
+}
+
+// Filter narrows an address's history. Types and Statuses match any of the
+// listed values; From is inclusive and To exclusive.
+type Filter struct {
+	Types    []string
+	Statuses []string
+	From     *time.Time
+	To       *time.Time
+	Cursor   string
+	Limit    int
+}
+
+// Page is one page of history. NextCursor is empty on the last page.
+type Page struct {
+	Transactions []*repository.Activity `json:"transactions"`
+	NextCursor   string                 `json:"nextCursor,omitempty"`
+}
+
+func New(cfg *config.Config, store *repository.Store) *Service {
+	return &Service{
+		config: cfg,
+		store:  store,
+	}
+}
+
+// List returns a page of an address's sends, receives, payments, refunds,
+// bridge transfers and gas sponsorships, most recently recorded first.
+func (s *Service) List(ctx context.Context, address string, filter Filter) (*Page, error) {
+	query, err := s.query(address, filter)
+	if err != nil {
+		return nil, err
+	}
+	if query.Limit <= 0 {
+		query.Limit = DefaultPageSize
+	}
+	if query.Limit > MaxPageSize {
+		query.Limit = MaxPageSize
+	}
+
+	// Fetch one extra row to know whether there is another page
+	limit := query.Limit
+	query.Limit++
+	activity, err := s.store.ListActivity(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity: %w", err)
+	}
+
+	page := &Page{Transactions: activity}
+	if len(activity) > limit {
+		page.Transactions = activity[:limit]
+		page.NextCursor = encodeCursor(activity[limit-1])
+	}
+	if page.Transactions == nil {
+		page.Transactions = []*repository.Activity{}
+	}
+	return page, nil
+}
+
+// ExportCSV writes every entry matching filter, from the cursor onwards, as
+// CSV. The filter's Limit is ignored.
+func (s *Service) ExportCSV(ctx context.Context, w io.Writer, address string, filter Filter) error {
+	query, err := s.query(address, filter)
+	if err != nil {
+		return err
+	}
+	query.Limit = exportBatchSize
+
+	out := csv.NewWriter(w)
+	out.Write([]string{"timestamp", "type", "status", "amount", "fee", "counterparty",
+		"tx_hash", "block_number", "reference", "id"})
+
+	for {
+		activity, err := s.store.ListActivity(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to list activity: %w", err)
+		}
+
+		for _, a := range activity {
+			blockNumber := ""
+			if a.BlockNumber != nil {
+				blockNumber = strconv.FormatInt(*a.BlockNumber, 10)
+			}
+			out.Write([]string{
+				a.Timestamp.UTC().Format(time.RFC3339), a.Type, a.Status, a.Amount, a.Fee,
+				deref(a.Counterparty), deref(a.TxHash), blockNumber, deref(a.Reference), a.ID,
+			})
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			return err
+		}
+
+		if len(activity) < query.Limit {
+			return nil
+		}
+		last := activity[len(activity)-1]
+		query.After = &repository.ActivityCursor{Timestamp: last.RecordedAt, ID: last.ID}
+	}
+}
+
+func (s *Service) query(address string, filter Filter) (repository.ActivityFilter, error) {
+	if !common.IsHexAddress(address) {
+		return repository.ActivityFilter{}, fmt.Errorf("%w: %w", ErrInvalidFilter, chain.InvalidAddress("", address))
+	}
+
+	for _, t := range filter.Types {
+		if !isActivityType(t) {
+			return repository.ActivityFilter{}, fmt.Errorf("%w: unknown type %q", ErrInvalidFilter, t)
+		}
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return repository.ActivityFilter{}, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+
+	query := repository.ActivityFilter{
+		Address:  common.HexToAddress(address).Hex(),
+		Types:    filter.Types,
+		Statuses: filter.Statuses,
+		From:     filter.From,
+		To:       filter.To,
+		Limit:    filter.Limit,
+	}
+	if filter.Cursor != "" {
+		after, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return repository.ActivityFilter{}, err
+		}
+		query.After = after
+	}
+	return query, nil
+}
+
+func isActivityType(t string) bool {
+	for _, known := range repository.ActivityTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Cursors are opaque to clients: base64 of "<unix nanos>|<id>", the time
+// the last entry was recorded and its ID.
+
+func encodeCursor(a *repository.Activity) string {
+	raw := strconv.FormatInt(a.RecordedAt.UnixNano(), 10) + "|" + a.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*repository.ActivityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	nanos, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	return &repository.ActivityCursor{Timestamp: time.Unix(0, n).UTC(), ID: id}, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,185 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/testdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+func newAddress(t *testing.T) string {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+func newHash() string {
+	return common.BytesToHash(crypto.Keccak256([]byte(uuid.NewString()))).Hex()
+}
+
+// newTestService returns a service over the test database and an address
+// that has sent count transfers, the last sent first in its history.
+func newTestService(t *testing.T, count int) (*Service, string) {
+	t.Helper()
+	store := testdb.Open(t)
+	address := newAddress(t)
+	for n := 0; n < count; n++ {
+		ref := repository.EventRef{TxHash: newHash(), BlockNumber: int64(8000 + n), BlockHash: newHash()}
+		err := store.IndexTransfer(context.Background(), ref, &repository.Transaction{
+			FromAddress: address, ToAddress: newAddress(t), Amount: "1", Type: repository.TypeTransfer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return New(&config.Config{}, store), address
+}
+
+func TestList(t *testing.T) {
+	s, address := newTestService(t, 5)
+	ctx := context.Background()
+
+	all, err := s.List(ctx, address, Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Transactions) != 5 || all.NextCursor != "" {
+		t.Fatalf("List = %d entries, cursor %q; want all 5 and no cursor", len(all.Transactions), all.NextCursor)
+	}
+
+	// Paging through, with the address as clients may write it
+	var paged []string
+	filter := Filter{Limit: 2}
+	for n := 0; n < 3; n++ {
+		page, err := s.List(ctx, strings.ToLower(address), filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range page.Transactions {
+			paged = append(paged, a.ID)
+		}
+		if (page.NextCursor == "") != (n == 2) {
+			t.Fatalf("page %d cursor = %q", n, page.NextCursor)
+		}
+		filter.Cursor = page.NextCursor
+	}
+	if len(paged) != len(all.Transactions) {
+		t.Fatalf("paged %d entries, want %d", len(paged), len(all.Transactions))
+	}
+	for n, a := range all.Transactions {
+		if paged[n] != a.ID {
+			t.Errorf("paged entry %d = %s, want %s", n, paged[n], a.ID)
+		}
+	}
+
+	none, err := s.List(ctx, address, Filter{Types: []string{repository.ActivityReceive}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if none.Transactions == nil || len(none.Transactions) != 0 {
+		t.Errorf("List of no entries = %#v, want an empty list", none.Transactions)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	s, address := newTestService(t, 3)
+	ctx := context.Background()
+
+	read := func(filter Filter) [][]string {
+		t.Helper()
+		var out bytes.Buffer
+		if err := s.ExportCSV(ctx, &out, address, filter); err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(&out).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) == 0 || strings.Join(records[0], ",") !=
+			"timestamp,type,status,amount,fee,counterparty,tx_hash,block_number,reference,id" {
+			t.Fatalf("CSV header = %v", records)
+		}
+		return records[1:]
+	}
+
+	page, err := s.List(ctx, address, Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := read(Filter{Limit: 1})
+	if len(rows) != 3 {
+		t.Fatalf("export has %d rows, want 3 whatever the limit", len(rows))
+	}
+	first := page.Transactions[0]
+	if rows[0][1] != repository.ActivitySend || rows[0][6] != *first.TxHash || rows[0][9] != first.ID {
+		t.Errorf("first row = %v, want the send %s", rows[0], first.ID)
+	}
+
+	// From a cursor, the export continues where the page ended
+	rest := read(Filter{Cursor: page.NextCursor})
+	if len(rest) != 2 || rest[0][9] != rows[1][9] {
+		t.Errorf("export from the cursor = %v, want the last 2 rows", rest)
+	}
+}
+
+func TestInvalidFilter(t *testing.T) {
+	s := New(&config.Config{}, nil)
+	address := newAddress(t)
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	cursor := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	tests := []struct {
+		name    string
+		address string
+		filter  Filter
+	}{
+		{"address", "0x1234", Filter{}},
+		{"type", address, Filter{Types: []string{"send", "mint"}}},
+		{"range", address, Filter{From: &from, To: &to}},
+		{"empty range", address, Filter{From: &from, To: &from}},
+		{"cursor not base64", address, Filter{Cursor: "not base64!"}},
+		{"cursor without ID", address, Filter{Cursor: cursor("1704067200000000000|")}},
+		{"cursor without separator", address, Filter{Cursor: cursor("1704067200000000000")}},
+		{"cursor time", address, Filter{Cursor: cursor("yesterday|send:1")}},
+	}
+	for _, tt := range tests {
+		if _, err := s.List(context.Background(), tt.address, tt.filter); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("%s: List error = %v, want ErrInvalidFilter", tt.name, err)
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	recorded := time.Date(2024, 1, 1, 12, 30, 0, 123456000, time.UTC)
+	entry := &repository.Activity{
+		ID:         "send:1f0c",
+		Timestamp:  recorded.Add(-time.Hour),
+		RecordedAt: recorded,
+	}
+
+	after, err := decodeCursor(encodeCursor(entry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !after.Timestamp.Equal(recorded) || after.ID != entry.ID {
+		t.Errorf("cursor = %+v, want the entry's recorded time and ID", after)
+	}
+}
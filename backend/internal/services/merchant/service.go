@@ -0,0 +1,398 @@
+package merchant
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxVolumeBuckets bounds the periods a volume query returns
+	maxVolumeBuckets = 366
+
+	defaultDailyBuckets  = 30
+	defaultWeeklyBuckets = 12
+
+	// exportBatchSize is how many rows ExportSettlements reads per query
+	exportBatchSize = 500
+)
+
+// ErrInvalidQuery is returned for a malformed address, interval or date range.
+var ErrInvalidQuery = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid query")
+
+type Service struct {
+	config     *config.Config
+	store      *repository.Store
+	posAddress common.Address
+	pos        *contracts.VyraPOS
+}
+
+// Range bounds a query by when payments were made. From is inclusive and To
+// exclusive; either may be nil for an open range.
+type Range struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// Stats summarizes a merchant's sales. Payments are invoice payments through
+// VyraPOS.processPayment, which charge fees; Splits are the merchant's
+// shares of split payments, which do not.
+type Stats struct {
+	Merchant string        `json:"merchant"`
+	From     *time.Time    `json:"from,omitempty"`
+	To       *time.Time    `json:"to,omitempty"`
+	Payments PaymentStats  `json:"payments"`
+	Splits   SplitStats    `json:"splits"`
+	Refunds  RefundStats   `json:"refunds"`
+	Invoices InvoiceStats  `json:"invoices"`
+	Onchain  *OnchainStats `json:"onchain"`
+}
+
+type PaymentStats struct {
+	Count        int64  `json:"count"`
+	Volume       string `json:"volume"`
+	MerchantFees string `json:"merchantFees"`
+	PlatformFees string `json:"platformFees"`
+	TotalFees    string `json:"totalFees"`
+	Net          string `json:"net"`
+}
+
+type SplitStats struct {
+	Count  int64  `json:"count"`
+	Volume string `json:"volume"`
+}
+
+// RefundStats counts refunded invoice payments. Rate is the fraction of
+// payments that were refunded.
+type RefundStats struct {
+	Count  int64   `json:"count"`
+	Amount string  `json:"amount"`
+	Rate   float64 `json:"rate"`
+}
+
+type InvoiceStats struct {
+	Paid      int64 `json:"paid"`
+	Expired   int64 `json:"expired"`
+	Pending   int64 `json:"pending"`
+	Cancelled int64 `json:"cancelled"`
+}
+
+// OnchainStats compares VyraPOS.getMerchantStats with the indexed payments
+// as of the last block the indexer processed. VyraPOS keeps earnings from
+// invoice payments only, without subtracting refunds, and does not track a
+// transaction count. Consistent is nil when the comparison could not be
+// made, such as when the indexer has not run.
+type OnchainStats struct {
+	Earnings        string `json:"earnings"`
+	IndexedEarnings string `json:"indexedEarnings,omitempty"`
+	BlockNumber     int64  `json:"blockNumber,omitempty"`
+	Consistent      *bool  `json:"consistent"`
+}
+
+// VolumePeriod is a merchant's payment volume over one day or week, starting
+// at Start (UTC). Weeks start on Monday.
+type VolumePeriod struct {
+	Start  time.Time `json:"start"`
+	Count  int64     `json:"count"`
+	Volume string    `json:"volume"`
+	Fees   string    `json:"fees"`
+}
+
+// Volume is a merchant's payment volume over consecutive periods, including
+// periods without payments.
+type Volume struct {
+	Merchant string          `json:"merchant"`
+	Interval string          `json:"interval"`
+	Periods  []*VolumePeriod `json:"periods"`
+}
+
+func New(cfg *config.Config, store *repository.Store, backend chain.Backend) *Service {
+	posAddress := common.HexToAddress(cfg.POS)
+	pos, err := contracts.NewVyraPOS(posAddress, backend)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to bind VyraPOS contract: %v", err))
+	}
+
+	return &Service{
+		config:     cfg,
+		store:      store,
+		posAddress: posAddress,
+		pos:        pos,
+	}
+}
+
+// Stats returns a merchant's sales, fees, refunds and invoice counts from
+// indexed events within r, cross-checked against VyraPOS.
+func (s *Service) Stats(ctx context.Context, address string, r Range) (*Stats, error) {
+	query, err := merchantRange(address, r)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.store.MerchantTotals(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum payments: %w", err)
+	}
+	invoices, err := s.store.MerchantInvoiceCounts(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count invoices: %w", err)
+	}
+	onchain, err := s.onchainStats(ctx, common.HexToAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{
+		Merchant: query.Merchant,
+		From:     r.From,
+		To:       r.To,
+		Payments: PaymentStats{
+			Count:        totals.PaymentCount,
+			Volume:       normalize(totals.Volume),
+			MerchantFees: normalize(totals.MerchantFees),
+			PlatformFees: normalize(totals.PlatformFees),
+			TotalFees:    sum(totals.MerchantFees, totals.PlatformFees),
+			Net:          normalize(totals.NetAmount),
+		},
+		Splits: SplitStats{
+			Count:  totals.SplitCount,
+			Volume: normalize(totals.SplitVolume),
+		},
+		Refunds: RefundStats{
+			Count:  totals.RefundCount,
+			Amount: normalize(totals.RefundedAmount),
+		},
+		Invoices: InvoiceStats{
+			Paid:      invoices.Paid,
+			Expired:   invoices.Expired,
+			Pending:   invoices.Pending,
+			Cancelled: invoices.Cancelled,
+		},
+		Onchain: onchain,
+	}
+	if totals.PaymentCount > 0 {
+		stats.Refunds.Rate = float64(totals.RefundCount) / float64(totals.PaymentCount)
+	}
+	return stats, nil
+}
+
+// Volume returns a merchant's payment volume per day or week. Without a
+// range it covers the last 30 days or 12 weeks.
+func (s *Service) Volume(ctx context.Context, address, interval string, r Range) (*Volume, error) {
+	var days, periods int
+	switch interval {
+	case "day":
+		days, periods = 1, defaultDailyBuckets
+	case "week":
+		days, periods = 7, defaultWeeklyBuckets
+	default:
+		return nil, fmt.Errorf("%w: interval must be day or week", ErrInvalidQuery)
+	}
+
+	// Align the range to whole periods so the first and last are complete
+	to := time.Now().UTC()
+	if r.To != nil {
+		to = r.To.UTC()
+	}
+	end := truncate(to.Add(-time.Nanosecond), interval).AddDate(0, 0, days)
+	start := end.AddDate(0, 0, -days*periods)
+	if r.From != nil {
+		start = truncate(r.From.UTC(), interval)
+	}
+
+	query, err := merchantRange(address, Range{From: &start, To: &end})
+	if err != nil {
+		return nil, err
+	}
+
+	volume := &Volume{
+		Merchant: query.Merchant,
+		Interval: interval,
+		Periods:  []*VolumePeriod{},
+	}
+	byStart := make(map[time.Time]*VolumePeriod)
+	for t := start; t.Before(end); t = t.AddDate(0, 0, days) {
+		if len(volume.Periods) == maxVolumeBuckets {
+			return nil, fmt.Errorf("%w: range covers more than %d periods", ErrInvalidQuery, maxVolumeBuckets)
+		}
+		period := &VolumePeriod{Start: t, Volume: "0.0", Fees: "0.0"}
+		volume.Periods = append(volume.Periods, period)
+		byStart[t] = period
+	}
+
+	buckets, err := s.store.MerchantVolume(ctx, query, interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum volume: %w", err)
+	}
+	for _, b := range buckets {
+		// Timestamps are stored without a zone and are UTC
+		start := time.Date(b.Start.Year(), b.Start.Month(), b.Start.Day(), 0, 0, 0, 0, time.UTC)
+		if period, ok := byStart[start]; ok {
+			period.Count = b.PaymentCount
+			period.Volume = normalize(b.Volume)
+			period.Fees = normalize(b.Fees)
+		}
+	}
+	return volume, nil
+}
+
+// ExportSettlements writes a merchant's settlement report for r as CSV: one
+// line per invoice payment, with its fees and any refund, and per split
+// payment share.
+func (s *Service) ExportSettlements(ctx context.Context, w io.Writer, address string, r Range) error {
+	if r.From == nil || r.To == nil {
+		return fmt.Errorf("%w: from and to are required", ErrInvalidQuery)
+	}
+	query, err := merchantRange(address, r)
+	if err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	out.Write([]string{"timestamp", "payment_id", "invoice_id", "customer", "gross", "merchant_fee",
+		"platform_fee", "net", "refunded", "status", "tx_hash", "reference"})
+
+	var after *repository.ActivityCursor
+	for {
+		rows, err := s.store.ListSettlements(ctx, query, after, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list settlements: %w", err)
+		}
+
+		for _, row := range rows {
+			out.Write([]string{
+				row.Timestamp.UTC().Format(time.RFC3339), row.PaymentID, deref(row.InvoiceID), row.Customer,
+				normalize(row.Gross), normalize(row.MerchantFee), normalize(row.PlatformFee), normalize(row.Net),
+				normalize(row.Refunded), row.Status, deref(row.TxHash), deref(row.Reference),
+			})
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			return err
+		}
+
+		if len(rows) < exportBatchSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		after = &repository.ActivityCursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+}
+
+// onchainStats reads VyraPOS.getMerchantStats at the indexer's checkpoint and
+// compares it with the merchant's indexed invoice payments up to that block.
+func (s *Service) onchainStats(ctx context.Context, merchant common.Address) (*OnchainStats, error) {
+	block, err := s.store.GetCheckpoint(ctx, s.posAddress.Hex())
+	if errors.Is(err, repository.ErrNotFound) {
+		// Nothing indexed to compare with; report the current figure
+		stats, err := s.pos.GetMerchantStats(&bind.CallOpts{Context: ctx}, merchant)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get merchant stats: %w", err)
+		}
+		return &OnchainStats{Earnings: chain.FormatUnits(stats.Earnings, chain.VYRDecimals)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get indexer checkpoint: %w", err)
+	}
+
+	stats, err := s.pos.GetMerchantStats(&bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(block)}, merchant)
+	if err != nil {
+		// Nodes without archive state cannot answer for older blocks
+		logging.FromContext(ctx).WithError(err).WithField("block", block).Warn("Failed to get merchant stats at indexer checkpoint")
+		stats, err = s.pos.GetMerchantStats(&bind.CallOpts{Context: ctx}, merchant)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get merchant stats: %w", err)
+		}
+		return &OnchainStats{Earnings: chain.FormatUnits(stats.Earnings, chain.VYRDecimals)}, nil
+	}
+
+	indexed, err := s.store.MerchantEarningsThrough(ctx, merchant.Hex(), block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum indexed earnings: %w", err)
+	}
+	indexedEarnings, err := chain.ParseUnits(indexed, chain.VYRDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse indexed earnings: %w", err)
+	}
+
+	consistent := indexedEarnings.Cmp(stats.Earnings) == 0
+	if !consistent {
+		logging.FromContext(ctx).WithFields(logrus.Fields{
+			"merchant": merchant.Hex(),
+			"block":    block,
+			"onchain":  stats.Earnings.String(),
+			"indexed":  indexedEarnings.String(),
+		}).Warn("Indexed merchant earnings do not match VyraPOS")
+	}
+	return &OnchainStats{
+		Earnings:        chain.FormatUnits(stats.Earnings, chain.VYRDecimals),
+		IndexedEarnings: chain.FormatUnits(indexedEarnings, chain.VYRDecimals),
+		BlockNumber:     block,
+		Consistent:      &consistent,
+	}, nil
+}
+
+func merchantRange(address string, r Range) (repository.MerchantRange, error) {
+	if !common.IsHexAddress(address) {
+		return repository.MerchantRange{}, fmt.Errorf("%w: %w", ErrInvalidQuery, chain.InvalidAddress("", address))
+	}
+	if r.From != nil && r.To != nil && !r.From.Before(*r.To) {
+		return repository.MerchantRange{}, fmt.Errorf("%w: from must be before to", ErrInvalidQuery)
+	}
+	return repository.MerchantRange{
+		Merchant: common.HexToAddress(address).Hex(),
+		From:     r.From,
+		To:       r.To,
+	}, nil
+}
+
+// truncate returns the start of the UTC day or Monday-based week holding t.
+func truncate(t time.Time, interval string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if interval == "week" {
+		day = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+// normalize renders a stored decimal amount as the API formats amounts,
+// trimming the database's trailing zeros.
+func normalize(amount string) string {
+	value, err := chain.ParseUnits(amount, chain.VYRDecimals)
+	if err != nil {
+		return amount
+	}
+	return chain.FormatUnits(value, chain.VYRDecimals)
+}
+
+func sum(a, b string) string {
+	x, errX := chain.ParseUnits(a, chain.VYRDecimals)
+	y, errY := chain.ParseUnits(b, chain.VYRDecimals)
+	if errX != nil || errY != nil {
+		return ""
+	}
+	return chain.FormatUnits(x.Add(x, y), chain.VYRDecimals)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
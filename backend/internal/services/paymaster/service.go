@@ -1,52 +1,255 @@
 package paymaster
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// receiptTimeout bounds how long SponsorGas waits for sponsorGas to be
+// mined.
+const receiptTimeout = 2 * time.Minute
+
+var (
+	// ErrInvalidSponsorship marks a sponsorship request that failed
+	// validation or that VyraPaymaster would refuse.
+	ErrInvalidSponsorship = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid sponsorship")
+
+	// ErrInvalidSignature marks a sponsorship not signed by the user.
+	ErrInvalidSignature = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidSignature, "invalid signature")
 )
 
 type Service struct {
-	config *config.Config
+	config        *config.Config
+	store         *repository.Store
+	client        chain.Backend
+	relayer       *chain.Relayer
+	paymasterAddr common.Address
+	paymaster     *contracts.VyraPaymaster
+	paymasterABI  *abi.ABI
 }
 
-func New(cfg *config.Config) *Service {
+// SessionKey is a newly generated session key. The private key is handed
+// to the client once and never stored.
+type SessionKey struct {
+	Address    string    `json:"sessionKey"`
+	PrivateKey string    `json:"privateKey"`
+	Expiry     time.Time `json:"expiry"`
+}
+
+func New(cfg *config.Config, store *repository.Store, backend chain.Backend, relayer *chain.Relayer) *Service {
+	paymasterAddr := common.HexToAddress(cfg.Paymaster)
+	paymaster, err := contracts.NewVyraPaymaster(paymasterAddr, backend)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to bind VyraPaymaster contract: %v", err))
+	}
+	paymasterABI, err := contracts.VyraPaymasterMetaData.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to parse VyraPaymaster ABI: %v", err))
+	}
+
 	return &Service{
-		config: cfg,
+		config:        cfg,
+		store:         store,
+		client:        backend,
+		relayer:       relayer,
+		paymasterAddr: paymasterAddr,
+		paymaster:     paymaster,
+		paymasterABI:  paymasterABI,
 	}
 }
 
-func (s *Service) CreateSessionKey(expiry int64) (string, error) {
-	// Generate a random session key
-	bytes := make([]byte, 20)
-	rand.Read(bytes)
-	sessionKey := "0x" + hex.EncodeToString(bytes)
-	
-	// In a real implementation, this would:
-	// 1. Create a session key contract call
-	// 2. Store the session key in the database
-	
-	return sessionKey, nil
+// CreateSessionKey generates a session key for user and records it,
+// replacing any key the user already had, as VyraPaymaster keeps one key
+// per user.
+func (s *Service) CreateSessionKey(ctx context.Context, user string, expiry int64) (*SessionKey, error) {
+	if !common.IsHexAddress(user) {
+		return nil, chain.InvalidAddress("user", user)
+	}
+	expiresAt := time.Unix(expiry, 0).UTC()
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("session key expiry must be in the future")
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session key: %w", err)
+	}
+
+	record := &repository.SessionKey{
+		UserAddress: common.HexToAddress(user).Hex(),
+		SessionKey:  crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Expiry:      expiresAt,
+		IsActive:    true,
+	}
+
+	err = s.store.WithTx(ctx, func(tx *repository.Tx) error {
+		if _, err := tx.DeactivateSessionKeys(ctx, record.UserAddress); err != nil {
+			return err
+		}
+		return tx.CreateSessionKey(ctx, record)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store session key: %w", err)
+	}
+
+	return &SessionKey{
+		Address:    record.SessionKey,
+		PrivateKey: hexutil.Encode(crypto.FromECDSA(key)),
+		Expiry:     expiresAt,
+	}, nil
+}
+
+// GetSessionKey returns the user's active session key.
+func (s *Service) GetSessionKey(ctx context.Context, user string) (*repository.SessionKey, error) {
+	if !common.IsHexAddress(user) {
+		return nil, chain.InvalidAddress("user", user)
+	}
+	return s.store.GetActiveSessionKey(ctx, common.HexToAddress(user).Hex())
 }
 
-func (s *Service) RevokeSessionKey() error {
-	// In a real implementation, this would:
-	// 1. Call the revoke session key function
-	// 2. Update the database
-	
+func (s *Service) RevokeSessionKey(ctx context.Context, user string) error {
+	if !common.IsHexAddress(user) {
+		return chain.InvalidAddress("user", user)
+	}
+
+	revoked, err := s.store.DeactivateSessionKeys(ctx, common.HexToAddress(user).Hex())
+	if err != nil {
+		return fmt.Errorf("failed to revoke session key: %w", err)
+	}
+	if revoked == 0 {
+		return repository.ErrNotFound
+	}
+
 	return nil
 }
 
-func (s *Service) SponsorGas(user, gasUsed, signature string) (string, error) {
-	// Generate a random transaction hash
-	bytes := make([]byte, 32)
-	rand.Read(bytes)
-	txHash := "0x" + hex.EncodeToString(bytes)
-	
-	// In a real implementation, this would:
-	// 1. Validate the signature
-	// 2. Create a gas sponsorship transaction
-	// 3. Process the sponsorship
-	
-	return txHash, nil
+// SponsorGas relays VyraPaymaster.sponsorGas for user, which pays for
+// gasUsed out of the user's sponsor balance, and records the sponsorship
+// its GasSponsored event announces. signature is the user's personal_sign
+// over keccak256(abi.encodePacked(user, gasUsed, chainId)). The relayer must
+// hold SPONSOR_ROLE.
+func (s *Service) SponsorGas(ctx context.Context, user, gasUsed, signature string) (*repository.PaymasterSponsorship, error) {
+	if !common.IsHexAddress(user) {
+		return nil, chain.InvalidAddress("user", user)
+	}
+	userAddress := common.HexToAddress(user)
+
+	gas, ok := new(big.Int).SetString(gasUsed, 10)
+	if !ok || gas.Sign() <= 0 || !gas.IsInt64() {
+		return nil, fmt.Errorf("%w: gasUsed must be a positive integer", ErrInvalidSponsorship)
+	}
+
+	hash := crypto.Keccak256(
+		userAddress.Bytes(),
+		common.LeftPadBytes(gas.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(s.config.ChainID).Bytes(), 32),
+	)
+	signer, err := chain.RecoverSigner(accounts.TextHash(hash), signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if signer != userAddress {
+		return nil, fmt.Errorf("%w: signature is by %s, not the user %s", ErrInvalidSignature, signer.Hex(), userAddress.Hex())
+	}
+
+	relayer, err := s.relayer.Address()
+	if err != nil {
+		return nil, err
+	}
+	sig := common.FromHex(signature)
+	data, err := s.paymasterABI.Pack("sponsorGas", userAddress, gas, sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sponsorGas: %w", err)
+	}
+	// Reverts if the user's sponsor balance or allowance is short, or the
+	// daily limit is reached
+	gasLimit, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: relayer,
+		To:   &s.paymasterAddr,
+		Data: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: sponsorGas would fail: %w", ErrInvalidSponsorship, err)
+	}
+
+	tx, err := s.relayer.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = gasLimit
+		return s.paymaster.SponsorGas(opts, userAddress, gas, sig)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit sponsorGas: %w", err)
+	}
+	// The transaction is out: record it even if the client goes away
+	ctx = context.WithoutCancel(ctx)
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":  tx.Hash().Hex(),
+		"user":    userAddress.Hex(),
+		"gasUsed": gas.String(),
+	}).Info("Gas sponsorship broadcast")
+
+	return s.recordSponsorship(ctx, tx)
+}
+
+// recordSponsorship waits for a sponsorGas transaction and stores the
+// sponsorship its GasSponsored event announces.
+func (s *Service) recordSponsorship(ctx context.Context, tx *types.Transaction) (*repository.PaymasterSponsorship, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(waitCtx, s.client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("sponsorGas transaction %s reverted", tx.Hash().Hex())
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != s.paymasterAddr {
+			continue
+		}
+		event, err := s.paymaster.ParseGasSponsored(*log)
+		if err != nil {
+			continue
+		}
+
+		sponsorship := &repository.PaymasterSponsorship{
+			UserAddress:     event.User.Hex(),
+			GasUsed:         event.GasUsed.Int64(),
+			VyrCost:         chain.FormatUnits(event.VyrSpent, chain.VYRDecimals),
+			TransactionHash: log.TxHash.Hex(),
+		}
+		ref := repository.EventRef{
+			TxHash:      log.TxHash.Hex(),
+			BlockNumber: int64(log.BlockNumber),
+			BlockHash:   log.BlockHash.Hex(),
+			LogIndex:    int(log.Index),
+		}
+		if err := s.store.CreateSponsorship(ctx, ref, sponsorship); err != nil {
+			return nil, fmt.Errorf("failed to store sponsorship: %w", err)
+		}
+		return sponsorship, nil
+	}
+
+	return nil, fmt.Errorf("sponsorGas transaction %s emitted no GasSponsored event", tx.Hash().Hex())
 }
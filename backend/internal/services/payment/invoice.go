@@ -0,0 +1,390 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/paylink"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidInvoice marks an invoice request that failed validation.
+// Handlers report it to the client rather than as an internal error.
+var ErrInvalidInvoice = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid invoice")
+
+const (
+	// defaultInvoiceTTL is the expiry given to invoices created without one
+	defaultInvoiceTTL = 24 * time.Hour
+
+	// receiptTimeout is how long the service waits for a submitted VyraPOS
+	// transaction to be mined
+	receiptTimeout = 2 * time.Minute
+)
+
+// Invoice creation statuses reported in InvoiceResult.
+const (
+	InvoiceSignatureRequired   = "signature_required"
+	InvoiceTransactionRequired = "transaction_required"
+	InvoiceCreated             = "created"
+)
+
+// InvoiceRequest describes an invoice and, optionally, the merchant's
+// consent to create it. VyraPOS.createInvoice must be sent by the merchant,
+// so the server can only broadcast a transaction the merchant signed:
+//
+//   - with neither Signature nor SignedTx, CreateInvoice returns the message
+//     the merchant signs;
+//   - with Signature, it checks it and returns the createInvoice call for
+//     the merchant's wallet to sign;
+//   - with SignedTx, it broadcasts the transaction and records the invoice.
+type InvoiceRequest struct {
+	Merchant    string
+	Amount      string
+	Description string
+	Expiry      int64
+	Signature   string
+	SignedTx    string
+}
+
+// InvoiceAuthorization is the message VyraPOS.createInvoice verifies:
+// keccak256(abi.encodePacked(merchant, amount, keccak256(description),
+// expiry, merchantNonces[merchant], chainid)). The merchant signs Hash with
+// personal_sign (EIP-191).
+type InvoiceAuthorization struct {
+	Merchant        string `json:"merchant"`
+	Amount          string `json:"amount"`
+	AmountWei       string `json:"amountWei"`
+	Description     string `json:"description"`
+	DescriptionHash string `json:"descriptionHash"`
+	Expiry          int64  `json:"expiry"`
+	Nonce           string `json:"nonce"`
+	ChainID         int64  `json:"chainId"`
+	Hash            string `json:"hash"`
+}
+
+// UnsignedTransaction is a contract call for a client wallet to sign and
+// send back as signedTx.
+type UnsignedTransaction struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Data    string `json:"data"`
+	Value   string `json:"value"`
+	Gas     uint64 `json:"gas"`
+	ChainID int64  `json:"chainId"`
+}
+
+// InvoiceResult is the outcome of CreateInvoice. Which fields are set
+// depends on Status.
+type InvoiceResult struct {
+	Status        string                `json:"status"`
+	InvoiceID     string                `json:"invoiceId,omitempty"`
+	TxHash        string                `json:"txHash,omitempty"`
+	Invoice       *repository.Invoice   `json:"invoice,omitempty"`
+	Authorization *InvoiceAuthorization `json:"authorization,omitempty"`
+	Transaction   *UnsignedTransaction  `json:"transaction,omitempty"`
+
+	// Links are the created invoice's payment URIs for QR codes and deep
+	// links
+	Links *paylink.Links `json:"links,omitempty"`
+}
+
+type invoice struct {
+	merchant    common.Address
+	amount      *big.Int
+	description string
+	expiry      int64
+}
+
+// CreateInvoice moves an invoice one step towards creation on VyraPOS; see
+// InvoiceRequest.
+func (s *Service) CreateInvoice(ctx context.Context, req InvoiceRequest) (*InvoiceResult, error) {
+	inv, err := parseInvoice(req)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := s.invoiceAuthorization(ctx, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.Signature != "" && req.SignedTx != "":
+		return nil, fmt.Errorf("%w: provide either signature or signedTx, not both", ErrInvalidInvoice)
+	case req.SignedTx != "":
+		return s.submitInvoice(ctx, inv, auth, req.SignedTx)
+	case req.Signature != "":
+		tx, err := s.invoiceTransaction(ctx, inv, auth, req.Signature)
+		if err != nil {
+			return nil, err
+		}
+		return &InvoiceResult{
+			Status:        InvoiceTransactionRequired,
+			Authorization: auth,
+			Transaction:   tx,
+		}, nil
+	default:
+		return &InvoiceResult{
+			Status:        InvoiceSignatureRequired,
+			Authorization: auth,
+		}, nil
+	}
+}
+
+func parseInvoice(req InvoiceRequest) (*invoice, error) {
+	if !common.IsHexAddress(req.Merchant) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInvoice, chain.InvalidAddress("merchant", req.Merchant))
+	}
+
+	amount, err := chain.ParseUnits(req.Amount, chain.VYRDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInvoice, err)
+	}
+	if amount.Sign() == 0 {
+		return nil, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidInvoice)
+	}
+
+	expiry := req.Expiry
+	if expiry == 0 {
+		expiry = time.Now().Add(defaultInvoiceTTL).Unix()
+	}
+	if expiry <= time.Now().Unix() {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidInvoice)
+	}
+
+	return &invoice{
+		merchant:    common.HexToAddress(req.Merchant),
+		amount:      amount,
+		description: req.Description,
+		expiry:      expiry,
+	}, nil
+}
+
+// invoiceAuthorization builds the message createInvoice verifies, using the
+// merchant's current nonce.
+func (s *Service) invoiceAuthorization(ctx context.Context, inv *invoice) (*InvoiceAuthorization, error) {
+	nonce, err := s.pos.MerchantNonces(&bind.CallOpts{Context: ctx}, inv.merchant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant nonce: %w", err)
+	}
+
+	descriptionHash := crypto.Keccak256([]byte(inv.description))
+	hash := invoiceHash(inv, nonce, s.config.ChainID)
+
+	return &InvoiceAuthorization{
+		Merchant:        inv.merchant.Hex(),
+		Amount:          chain.FormatUnits(inv.amount, chain.VYRDecimals),
+		AmountWei:       inv.amount.String(),
+		Description:     inv.description,
+		DescriptionHash: hexutil.Encode(descriptionHash),
+		Expiry:          inv.expiry,
+		Nonce:           nonce.String(),
+		ChainID:         s.config.ChainID,
+		Hash:            hexutil.Encode(hash),
+	}, nil
+}
+
+// invoiceHash is the message createInvoice verifies:
+// keccak256(abi.encodePacked(merchant, amount, keccak256(bytes(description)),
+// expiry, nonce, chainId)).
+func invoiceHash(inv *invoice, nonce *big.Int, chainID int64) []byte {
+	return crypto.Keccak256(
+		inv.merchant.Bytes(),
+		common.LeftPadBytes(inv.amount.Bytes(), 32),
+		crypto.Keccak256([]byte(inv.description)),
+		common.LeftPadBytes(big.NewInt(inv.expiry).Bytes(), 32),
+		common.LeftPadBytes(nonce.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(chainID).Bytes(), 32),
+	)
+}
+
+// checkInvoiceSignature verifies that the merchant signed auth, as
+// createInvoice will.
+func checkInvoiceSignature(auth *InvoiceAuthorization, signature string) error {
+	signer, err := chain.RecoverSigner(accounts.TextHash(common.FromHex(auth.Hash)), signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInvoice, err)
+	}
+	if signer.Hex() != auth.Merchant {
+		return fmt.Errorf("%w: signature is by %s, not the merchant %s, or is for an earlier nonce",
+			ErrInvalidInvoice, signer.Hex(), auth.Merchant)
+	}
+	return nil
+}
+
+// invoiceTransaction returns the createInvoice call carrying a checked
+// merchant signature.
+func (s *Service) invoiceTransaction(ctx context.Context, inv *invoice, auth *InvoiceAuthorization, signature string) (*UnsignedTransaction, error) {
+	if err := checkInvoiceSignature(auth, signature); err != nil {
+		return nil, err
+	}
+
+	data, err := s.posABI.Pack("createInvoice", inv.amount, inv.description, big.NewInt(inv.expiry), common.FromHex(signature))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode createInvoice: %w", err)
+	}
+
+	gas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: inv.merchant,
+		To:   &s.posAddress,
+		Data: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: invoice creation would fail: %w", ErrInvalidInvoice, err)
+	}
+
+	return &UnsignedTransaction{
+		From:    inv.merchant.Hex(),
+		To:      s.posAddress.Hex(),
+		Data:    hexutil.Encode(data),
+		Value:   "0",
+		Gas:     gas,
+		ChainID: s.config.ChainID,
+	}, nil
+}
+
+// submitInvoice checks that a merchant-signed transaction is exactly the
+// requested createInvoice call, broadcasts it and records the invoice from
+// its InvoiceCreated event.
+func (s *Service) submitInvoice(ctx context.Context, inv *invoice, auth *InvoiceAuthorization, signedTx string) (*InvoiceResult, error) {
+	tx, sender, err := chain.DecodeSignedTransaction(signedTx, s.config.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInvoice, err)
+	}
+	if sender != inv.merchant {
+		return nil, fmt.Errorf("%w: transaction is signed by %s, not the merchant %s", ErrInvalidInvoice, sender.Hex(), inv.merchant.Hex())
+	}
+	if tx.To() == nil || *tx.To() != s.posAddress {
+		return nil, fmt.Errorf("%w: transaction is not sent to VyraPOS", ErrInvalidInvoice)
+	}
+	if tx.Value().Sign() != 0 {
+		return nil, fmt.Errorf("%w: transaction must not carry ETH", ErrInvalidInvoice)
+	}
+
+	data := tx.Data()
+	method := s.posABI.Methods["createInvoice"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return nil, fmt.Errorf("%w: transaction is not a createInvoice call", ErrInvalidInvoice)
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed createInvoice calldata: %v", ErrInvalidInvoice, err)
+	}
+	amount, description, expiry, signature := args[0].(*big.Int), args[1].(string), args[2].(*big.Int), args[3].([]byte)
+	if amount.Cmp(inv.amount) != 0 || description != inv.description || expiry.Cmp(big.NewInt(inv.expiry)) != 0 {
+		return nil, fmt.Errorf("%w: transaction does not match the requested amount, description and expiry", ErrInvalidInvoice)
+	}
+	if err := checkInvoiceSignature(auth, hexutil.Encode(signature)); err != nil {
+		return nil, err
+	}
+
+	gas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: sender,
+		To:   tx.To(),
+		Data: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: invoice creation would fail: %w", ErrInvalidInvoice, err)
+	}
+	if tx.Gas() < gas {
+		return nil, fmt.Errorf("%w: gas limit %d is below the estimated %d", ErrInvalidInvoice, tx.Gas(), gas)
+	}
+
+	if err := s.client.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+	// The transaction is out: record it even if the client goes away
+	ctx = context.WithoutCancel(ctx)
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":   tx.Hash().Hex(),
+		"merchant": inv.merchant.Hex(),
+		"amount":   auth.Amount,
+	}).Info("Invoice creation broadcast")
+
+	// Record the invoice before waiting for it to be mined, so it is not
+	// lost if the wait times out; the indexer finds it by its transaction
+	txHash := tx.Hash().Hex()
+	expiresAt := time.Unix(inv.expiry, 0).UTC()
+	err = s.store.CreateInvoice(ctx, &repository.Invoice{
+		MerchantAddress: inv.merchant.Hex(),
+		Amount:          auth.Amount,
+		Description:     &inv.description,
+		Expiry:          &expiresAt,
+		Status:          repository.StatusCreated,
+		TxHash:          &txHash,
+	})
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", txHash).Warn("Failed to record created invoice")
+	}
+
+	invoice, err := s.recordInvoice(ctx, tx, inv)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseOnchainID(invoice.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvoiceResult{
+		Status:    InvoiceCreated,
+		InvoiceID: invoice.InvoiceID,
+		TxHash:    tx.Hash().Hex(),
+		Invoice:   invoice,
+		Links:     paylink.NewLinks(s.paymentRequest(id, inv.amount)),
+	}, nil
+}
+
+// recordInvoice waits for a createInvoice transaction and stores the invoice
+// its InvoiceCreated event announces. The indexer records the same event;
+// whichever gets there first, the row is the same.
+func (s *Service) recordInvoice(ctx context.Context, tx *types.Transaction, inv *invoice) (*repository.Invoice, error) {
+	receipt, err := s.waitMined(ctx, tx, "createInvoice")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != s.posAddress {
+			continue
+		}
+		event, err := s.pos.ParseInvoiceCreated(*log)
+		if err != nil {
+			continue
+		}
+
+		invoiceID := common.Bytes2Hex(event.InvoiceId[:])
+		expiry := time.Unix(inv.expiry, 0).UTC()
+		err = s.store.IndexInvoice(ctx, eventRef(log), &repository.Invoice{
+			InvoiceID:       invoiceID,
+			MerchantAddress: event.Merchant.Hex(),
+			Amount:          chain.FormatUnits(event.Amount, chain.VYRDecimals),
+			Description:     &inv.description,
+			Expiry:          &expiry,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to store invoice: %w", err)
+		}
+
+		return s.store.GetInvoice(ctx, invoiceID)
+	}
+
+	return nil, fmt.Errorf("createInvoice transaction %s emitted no InvoiceCreated event", tx.Hash().Hex())
+}
@@ -0,0 +1,92 @@
+package payment
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// packed builds an abi.encodePacked preimage from hex parts, so the
+// expected hashes below spell out the layout Solidity uses rather than
+// reuse the code under test.
+func packed(t *testing.T, parts ...string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.Join(parts, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// word is a uint256 as abi.encodePacked writes it: 32 bytes, big-endian.
+func word(v int64) string {
+	return fmt.Sprintf("%064x", v)
+}
+
+func TestInvoiceHash(t *testing.T) {
+	merchant := common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6")
+	inv := &invoice{
+		merchant:    merchant,
+		amount:      big.NewInt(1_500_000),
+		description: "Coffee",
+		expiry:      1700000000,
+	}
+
+	want := crypto.Keccak256(packed(t,
+		"742d35cc6634c0532925a3b8d4c9db96c4b4d8b6",
+		word(1_500_000),
+		hex.EncodeToString(crypto.Keccak256([]byte("Coffee"))),
+		word(1700000000),
+		word(3),
+		word(31337),
+	))
+	if got := invoiceHash(inv, big.NewInt(3), 31337); !bytes.Equal(got, want) {
+		t.Errorf("invoiceHash = %x, want %x", got, want)
+	}
+}
+
+func TestCheckInvoiceSignature(t *testing.T) {
+	merchantKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	merchant := crypto.PubkeyToAddress(merchantKey.PublicKey)
+
+	hash := invoiceHash(&invoice{merchant: merchant, amount: big.NewInt(1), expiry: 1700000000}, big.NewInt(0), 31337)
+	auth := &InvoiceAuthorization{Merchant: merchant.Hex(), Hash: hexutil.Encode(hash)}
+
+	merchantSig, _ := crypto.Sign(accounts.TextHash(hash), merchantKey)
+	otherSig, _ := crypto.Sign(accounts.TextHash(hash), otherKey)
+	rawSig, _ := crypto.Sign(hash, merchantKey)
+	walletSig := append([]byte{}, merchantSig...)
+	walletSig[64] += 27
+
+	tests := []struct {
+		name      string
+		signature string
+		valid     bool
+	}{
+		{"personal_sign", hexutil.Encode(walletSig), true},
+		{"recovery id 0/1", hexutil.Encode(merchantSig), true},
+		{"another signer", hexutil.Encode(otherSig), false},
+		{"without the EIP-191 prefix", hexutil.Encode(rawSig), false},
+		{"truncated", hexutil.Encode(merchantSig[:64]), false},
+		{"not hex", "0xzz", false},
+	}
+	for _, tt := range tests {
+		err := checkInvoiceSignature(auth, tt.signature)
+		if tt.valid && err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidInvoice) {
+			t.Errorf("%s: error = %v, want ErrInvalidInvoice", tt.name, err)
+		}
+	}
+}
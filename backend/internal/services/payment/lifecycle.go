@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CancelInvoice cancels an invoice that has not been paid. VyraPOS has no
+// cancellation, so the invoice stays payable on-chain; the backend stops
+// relaying payments and serving payment links for it. It returns
+// repository.ErrInvalidTransition if the invoice is paid, refunded or
+// expired, and ErrNotMerchant if merchant is not the invoice's merchant.
+func (s *Service) CancelInvoice(ctx context.Context, invoiceID, merchant string) (*repository.Invoice, error) {
+	id, err := parseOnchainID(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	invoiceID = common.Bytes2Hex(id[:])
+
+	invoice, err := s.store.GetInvoice(ctx, invoiceID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceNotFound, invoiceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(invoice.MerchantAddress, merchant) {
+		return nil, fmt.Errorf("%w: %s", ErrNotMerchant, invoiceID)
+	}
+
+	err = s.store.TransitionInvoice(ctx, invoiceID, repository.StatusCancelled, repository.TransitionAPI, nil)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceNotFound, invoiceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.store.GetInvoice(ctx, invoiceID)
+}
+
+// InvoiceHistory returns an invoice's status transitions, oldest first.
+func (s *Service) InvoiceHistory(ctx context.Context, invoiceID string) ([]*repository.InvoiceTransition, error) {
+	id, err := parseOnchainID(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	invoiceID = common.Bytes2Hex(id[:])
+
+	if _, err := s.store.GetInvoice(ctx, invoiceID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrInvoiceNotFound, invoiceID)
+		}
+		return nil, err
+	}
+	transitions, err := s.store.ListInvoiceTransitions(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice transitions: %w", err)
+	}
+	if transitions == nil {
+		transitions = []*repository.InvoiceTransition{}
+	}
+	return transitions, nil
+}
+
+// checkNotCancelled returns ErrInvoiceCancelled if the invoice's merchant
+// cancelled it. An invoice not recorded yet has not been cancelled.
+func (s *Service) checkNotCancelled(ctx context.Context, id [32]byte) error {
+	invoice, err := s.store.GetInvoice(ctx, common.Bytes2Hex(id[:]))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice.Status == repository.StatusCancelled {
+		return fmt.Errorf("%w: %s", ErrInvoiceCancelled, invoice.InvoiceID)
+	}
+	return nil
+}
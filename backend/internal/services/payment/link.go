@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/paylink"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidPaymentLink is returned for a scanned payment link that is
+// malformed or does not match the invoice it names.
+var ErrInvalidPaymentLink = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid payment link")
+
+// PaymentRequest returns the payment request to encode in an invoice's QR
+// code or deep link. The invoice is read from VyraPOS and must still be
+// payable, and must not have been cancelled.
+func (s *Service) PaymentRequest(ctx context.Context, invoiceID string) (*paylink.Invoice, error) {
+	id, err := parseOnchainID(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotCancelled(ctx, id); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.pos.Invoices(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read invoice: %w", err)
+	}
+	if invoice.Merchant == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceNotFound, invoiceID)
+	}
+	if invoice.Paid {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceAlreadyPaid, invoiceID)
+	}
+	if invoice.Expiry.Cmp(big.NewInt(time.Now().Unix())) <= 0 {
+		return nil, fmt.Errorf("%w: %s expired at %s", ErrInvoiceExpired, invoiceID, time.Unix(invoice.Expiry.Int64(), 0).UTC())
+	}
+
+	return s.paymentRequest(id, invoice.Amount), nil
+}
+
+// ResolvePaymentLink decodes a payment link a wallet scanned and checks it
+// before the wallet pays: it must be a vyra:// link for this chain and
+// VyraPOS contract, and ask for the amount the invoice does. It returns the
+// invoice's current payment request, with PaymentRequest's errors. EIP-681
+// links carry no invoice ID, so they cannot be resolved.
+func (s *Service) ResolvePaymentLink(ctx context.Context, uri string) (*paylink.Invoice, error) {
+	link, err := paylink.Decode(uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPaymentLink, err)
+	}
+	if link.InvoiceID == "" {
+		return nil, fmt.Errorf("%w: an EIP-681 link does not name an invoice", ErrInvalidPaymentLink)
+	}
+	if link.ChainID != s.config.ChainID {
+		return nil, fmt.Errorf("%w: link is for chain %d, not %d", ErrInvalidPaymentLink, link.ChainID, s.config.ChainID)
+	}
+	if link.POS != s.posAddress {
+		return nil, fmt.Errorf("%w: link is for VyraPOS at %s, not %s", ErrInvalidPaymentLink, link.POS.Hex(), s.posAddress.Hex())
+	}
+
+	request, err := s.PaymentRequest(ctx, link.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Amount.Cmp(link.Amount) != 0 {
+		return nil, fmt.Errorf("%w: link asks for %s VYR but the invoice is for %s VYR", ErrInvalidPaymentLink,
+			chain.FormatUnits(link.Amount, chain.VYRDecimals), chain.FormatUnits(request.Amount, chain.VYRDecimals))
+	}
+	return request, nil
+}
+
+func (s *Service) paymentRequest(id [32]byte, amount *big.Int) *paylink.Invoice {
+	return &paylink.Invoice{
+		InvoiceID: common.Bytes2Hex(id[:]),
+		Amount:    amount,
+		ChainID:   s.config.ChainID,
+		POS:       s.posAddress,
+		Token:     common.HexToAddress(s.config.VyraToken),
+	}
+}
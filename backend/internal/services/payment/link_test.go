@@ -0,0 +1,48 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/paylink"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestResolvePaymentLinkChecks covers the checks made before the invoice is
+// read from the chain.
+func TestResolvePaymentLinkChecks(t *testing.T) {
+	pos := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	s := &Service{config: &config.Config{ChainID: 31337}, posAddress: pos}
+	link := &paylink.Invoice{
+		InvoiceID: strings.Repeat("ab", 32),
+		Amount:    big.NewInt(1),
+		ChainID:   31337,
+		POS:       pos,
+		Token:     common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"),
+	}
+	otherChain, otherPOS := *link, *link
+	otherChain.ChainID = 1
+	otherPOS.POS = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	tests := []struct {
+		name string
+		uri  string
+		want string
+	}{
+		{"malformed", "vyra://pay?invoiceId=abc", "invalid invoiceId"},
+		{"EIP-681", paylink.EIP681(link), "does not name an invoice"},
+		{"another chain", paylink.VyraURI(&otherChain), "chain 1"},
+		{"another contract", paylink.VyraURI(&otherPOS), "VyraPOS at 0x00000000000000000000000000000000000000AA"},
+	}
+	for _, tt := range tests {
+		_, err := s.ResolvePaymentLink(context.Background(), tt.uri)
+		if !errors.Is(err, ErrInvalidPaymentLink) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want ErrInvalidPaymentLink mentioning %q", tt.name, err, tt.want)
+		}
+	}
+}
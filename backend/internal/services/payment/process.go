@@ -0,0 +1,376 @@
+package payment
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrInvalidPayment marks a payment request that failed validation.
+	ErrInvalidPayment = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidPayment, "invalid payment")
+
+	// ErrInvoiceCancelled is returned for an invoice its merchant cancelled.
+	ErrInvoiceCancelled = apierror.Define(http.StatusConflict, apierror.CodeInvoiceCancelled, "invoice cancelled")
+
+	// ErrNotMerchant is returned when the caller acts on another merchant's
+	// invoice or payment.
+	ErrNotMerchant = apierror.Define(http.StatusForbidden, apierror.CodeNotMerchant, "not the merchant of this invoice or payment")
+
+	// The errors below mirror the VyraPOS custom errors a payment can fail
+	// with, whether found by the pre-checks or reverted by the contract.
+	ErrInvoiceNotFound       = apierror.Define(http.StatusNotFound, apierror.CodeInvoiceNotFound, "invoice not found")
+	ErrInvoiceExpired        = apierror.Define(http.StatusBadRequest, apierror.CodeInvoiceExpired, "invoice expired")
+	ErrInvoiceAlreadyPaid    = apierror.Define(http.StatusConflict, apierror.CodeInvoiceAlreadyPaid, "invoice already paid")
+	ErrInsufficientBalance   = apierror.Define(http.StatusBadRequest, apierror.CodeInsufficientBalance, "insufficient balance")
+	ErrInsufficientAllowance = apierror.Define(http.StatusBadRequest, apierror.CodeInsufficientAllowance, "insufficient allowance")
+	ErrInvalidSignature      = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidSignature, "invalid signature")
+	ErrPaymentNotFound       = apierror.Define(http.StatusNotFound, apierror.CodePaymentNotFound, "payment not found")
+	ErrPaymentRefunded       = apierror.Define(http.StatusConflict, apierror.CodePaymentAlreadyRefunded, "payment already refunded")
+)
+
+// errReverted is returned by waitMined for a transaction that was mined but
+// failed.
+var errReverted = errors.New("transaction reverted")
+
+// posErrors maps VyraPOS custom error names to the errors above.
+var posErrors = map[string]error{
+	"InvoiceNotFound":        ErrInvoiceNotFound,
+	"InvoiceExpired":         ErrInvoiceExpired,
+	"InvoiceAlreadyPaid":     ErrInvoiceAlreadyPaid,
+	"InsufficientBalance":    ErrInsufficientBalance,
+	"InvalidSignature":       ErrInvalidSignature,
+	"PaymentNotFound":        ErrPaymentNotFound,
+	"PaymentAlreadyRefunded": ErrPaymentRefunded,
+}
+
+// Payment statuses reported in PaymentResult.
+const (
+	PaymentSignatureRequired = "signature_required"
+	PaymentConfirmed         = repository.StatusConfirmed
+)
+
+// PaymentAuthorization is the message VyraPOS.processPayment verifies:
+// keccak256(abi.encodePacked(customer, invoiceId, amount, chainid)). The
+// customer signs Hash with personal_sign (EIP-191) and must have approved
+// Spender, the VyraPOS contract, for AmountWei VYR.
+type PaymentAuthorization struct {
+	Customer  string `json:"customer"`
+	InvoiceID string `json:"invoiceId"`
+	AmountWei string `json:"amountWei"`
+	ChainID   int64  `json:"chainId"`
+	Spender   string `json:"spender"`
+	Hash      string `json:"hash"`
+}
+
+// PaymentResult is the outcome of ProcessPayment. The fees are those
+// VyraPOS charges: the merchant fee is retained by the contract and the
+// platform fee goes to the treasury, so the merchant receives NetAmount.
+type PaymentResult struct {
+	Status        string                `json:"status"`
+	InvoiceID     string                `json:"invoiceId"`
+	PaymentID     string                `json:"paymentId,omitempty"`
+	TxHash        string                `json:"txHash,omitempty"`
+	Merchant      string                `json:"merchant"`
+	Amount        string                `json:"amount"`
+	MerchantFee   string                `json:"merchantFee"`
+	PlatformFee   string                `json:"platformFee"`
+	NetAmount     string                `json:"netAmount"`
+	Authorization *PaymentAuthorization `json:"authorization,omitempty"`
+}
+
+// ProcessPayment pays an on-chain invoice from customer. Without a
+// signature it returns the fees and the message the customer signs; with
+// one it checks that the payment will succeed and submits processPayment
+// from the relayer account.
+func (s *Service) ProcessPayment(ctx context.Context, invoiceID, customer, signature string) (*PaymentResult, error) {
+	id, err := parseOnchainID(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(customer) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPayment, chain.InvalidAddress("customer", customer))
+	}
+	customerAddress := common.HexToAddress(customer)
+	if err := s.checkNotCancelled(ctx, id); err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	invoice, err := s.pos.Invoices(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read invoice: %w", err)
+	}
+	if invoice.Merchant == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceNotFound, invoiceID)
+	}
+	if invoice.Paid {
+		return nil, fmt.Errorf("%w: %s", ErrInvoiceAlreadyPaid, invoiceID)
+	}
+	if invoice.Expiry.Cmp(big.NewInt(time.Now().Unix())) <= 0 {
+		return nil, fmt.Errorf("%w: %s expired at %s", ErrInvoiceExpired, invoiceID, time.Unix(invoice.Expiry.Int64(), 0).UTC())
+	}
+
+	result, err := s.paymentQuote(opts, id, invoice.Merchant, invoice.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := paymentHash(customerAddress, id, invoice.Amount, s.config.ChainID)
+
+	if signature == "" {
+		result.Status = PaymentSignatureRequired
+		result.Authorization = &PaymentAuthorization{
+			Customer:  customerAddress.Hex(),
+			InvoiceID: result.InvoiceID,
+			AmountWei: invoice.Amount.String(),
+			ChainID:   s.config.ChainID,
+			Spender:   s.posAddress.Hex(),
+			Hash:      hexutil.Encode(hash),
+		}
+		return result, nil
+	}
+
+	signer, err := chain.RecoverSigner(accounts.TextHash(hash), signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if signer != customerAddress {
+		return nil, fmt.Errorf("%w: signature is by %s, not the customer %s", ErrInvalidSignature, signer.Hex(), customerAddress.Hex())
+	}
+
+	if err := s.checkFunds(opts, customerAddress, invoice.Amount); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.relay(ctx, "processPayment", id, customerAddress, common.FromHex(signature))
+	if err != nil {
+		return nil, err
+	}
+	// The transaction is out: record it even if the client goes away
+	ctx = context.WithoutCancel(ctx)
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":    tx.Hash().Hex(),
+		"invoiceId": invoiceID,
+		"customer":  customerAddress.Hex(),
+		"amount":    result.Amount,
+	}).Info("Invoice payment broadcast")
+
+	payment, err := s.recordPayment(ctx, tx, result.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Status = PaymentConfirmed
+	result.PaymentID = payment.PaymentID
+	result.TxHash = tx.Hash().Hex()
+	return result, nil
+}
+
+// paymentHash is the message processPayment verifies:
+// keccak256(abi.encodePacked(customer, invoiceId, amount, chainId)).
+func paymentHash(customer common.Address, invoiceID [32]byte, amount *big.Int, chainID int64) []byte {
+	return crypto.Keccak256(
+		customer.Bytes(),
+		invoiceID[:],
+		common.LeftPadBytes(amount.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(chainID).Bytes(), 32),
+	)
+}
+
+// paymentQuote computes the fees processPayment will charge at the
+// contract's current rates.
+func (s *Service) paymentQuote(opts *bind.CallOpts, invoiceID [32]byte, merchant common.Address, amount *big.Int) (*PaymentResult, error) {
+	merchantRate, err := s.pos.MerchantFeeRate(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant fee rate: %w", err)
+	}
+	platformRate, err := s.pos.PlatformFeeRate(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform fee rate: %w", err)
+	}
+	denominator, err := s.pos.FEEDENOMINATOR(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee denominator: %w", err)
+	}
+
+	merchantFee := new(big.Int).Div(new(big.Int).Mul(amount, merchantRate), denominator)
+	platformFee := new(big.Int).Div(new(big.Int).Mul(amount, platformRate), denominator)
+	net := new(big.Int).Sub(amount, merchantFee)
+	net.Sub(net, platformFee)
+
+	return &PaymentResult{
+		InvoiceID:   common.Bytes2Hex(invoiceID[:]),
+		Merchant:    merchant.Hex(),
+		Amount:      chain.FormatUnits(amount, chain.VYRDecimals),
+		MerchantFee: chain.FormatUnits(merchantFee, chain.VYRDecimals),
+		PlatformFee: chain.FormatUnits(platformFee, chain.VYRDecimals),
+		NetAmount:   chain.FormatUnits(net, chain.VYRDecimals),
+	}, nil
+}
+
+// recordPayment waits for a processPayment transaction and stores the
+// payment its PaymentProcessed event announces, marking the invoice paid.
+func (s *Service) recordPayment(ctx context.Context, tx *types.Transaction, invoiceID string) (*repository.Payment, error) {
+	receipt, err := s.waitMined(ctx, tx, "processPayment")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != s.posAddress {
+			continue
+		}
+		event, err := s.pos.ParsePaymentProcessed(*log)
+		if err != nil {
+			continue
+		}
+
+		details, err := s.pos.Payments(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, event.PaymentId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read payment: %w", err)
+		}
+
+		payment := &repository.Payment{
+			PaymentID:       common.Bytes2Hex(event.PaymentId[:]),
+			CustomerAddress: event.Customer.Hex(),
+			MerchantAddress: details.Merchant.Hex(),
+			Amount:          chain.FormatUnits(event.Amount, chain.VYRDecimals),
+			MerchantFee:     chain.FormatUnits(details.MerchantFee, chain.VYRDecimals),
+			PlatformFee:     chain.FormatUnits(details.PlatformFee, chain.VYRDecimals),
+		}
+		if err := s.store.IndexPayment(ctx, eventRef(log), payment, invoiceID, nil); err != nil {
+			return nil, fmt.Errorf("failed to store payment: %w", err)
+		}
+		return payment, nil
+	}
+
+	return nil, fmt.Errorf("processPayment transaction %s emitted no PaymentProcessed event", tx.Hash().Hex())
+}
+
+// checkFunds checks that customer holds amount VYR and has approved VyraPOS
+// for it, since VyraPOS pulls payments with transferFrom.
+func (s *Service) checkFunds(opts *bind.CallOpts, customer common.Address, amount *big.Int) error {
+	balance, err := s.token.BalanceOf(opts, customer)
+	if err != nil {
+		return fmt.Errorf("failed to get VYR balance: %w", err)
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: customer has %s VYR, payment is for %s", ErrInsufficientBalance,
+			chain.FormatUnits(balance, chain.VYRDecimals), chain.FormatUnits(amount, chain.VYRDecimals))
+	}
+
+	allowance, err := s.token.Allowance(opts, customer, s.posAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get VYR allowance: %w", err)
+	}
+	if allowance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: customer has not approved VyraPOS %s for %s VYR", ErrInsufficientAllowance,
+			s.posAddress.Hex(), chain.FormatUnits(amount, chain.VYRDecimals))
+	}
+	return nil
+}
+
+// relay submits a VyraPOS call from the relayer account, reporting a
+// VyraPOS custom error if the call would revert with one.
+func (s *Service) relay(ctx context.Context, method string, args ...interface{}) (*types.Transaction, error) {
+	from, err := s.relayer.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	// Estimate separately: the binding's own estimate drops the revert data
+	// that identifies the VyraPOS error
+	data, err := s.posABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+	gas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &s.posAddress,
+		Data: data,
+	})
+	if err != nil {
+		if posErr := s.posError(err); posErr != nil {
+			return nil, posErr
+		}
+		return nil, fmt.Errorf("%w: %s would fail: %w", ErrInvalidPayment, method, err)
+	}
+
+	pos := &contracts.VyraPOSRaw{Contract: s.pos}
+	tx, err := s.relayer.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = gas
+		return pos.Transact(opts, method, args...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit %s: %w", method, err)
+	}
+	return tx, nil
+}
+
+// waitMined waits for tx to be mined and checks that it succeeded.
+func (s *Service) waitMined(ctx context.Context, tx *types.Transaction, method string) (*types.Receipt, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(waitCtx, s.client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("%w: %s transaction %s", errReverted, method, tx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+func eventRef(log *types.Log) repository.EventRef {
+	return repository.EventRef{
+		TxHash:      log.TxHash.Hex(),
+		BlockNumber: int64(log.BlockNumber),
+		BlockHash:   log.BlockHash.Hex(),
+		LogIndex:    int(log.Index),
+	}
+}
+
+// posError returns the error matching a VyraPOS custom error in a failed
+// call or gas estimate, or nil if err carries none.
+func (s *Service) posError(err error) error {
+	revert := contracts.DecodeRevert(err)
+	if revert == nil {
+		return nil
+	}
+	if sentinel, ok := posErrors[revert.Name]; ok {
+		return fmt.Errorf("%w: rejected by VyraPOS", sentinel)
+	}
+	return fmt.Errorf("%w: rejected by VyraPOS: %w", ErrInvalidPayment, revert)
+}
+
+// parseOnchainID parses a bytes32 identifier given as 64 hex digits, with
+// or without the 0x prefix.
+func parseOnchainID(id string) ([32]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(id, "0x"))
+	if err != nil || len(raw) != 32 {
+		return [32]byte{}, fmt.Errorf("%w: invalid ID %q, expected 64 hex digits", ErrInvalidPayment, id)
+	}
+	return [32]byte(raw), nil
+}
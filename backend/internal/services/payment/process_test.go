@@ -0,0 +1,173 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestPaymentHash(t *testing.T) {
+	customer := common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72")
+	var id [32]byte
+	copy(id[:], common.FromHex("0x1111111111111111111111111111111111111111111111111111111111111111"))
+
+	want := crypto.Keccak256(packed(t,
+		"8ba1f109551bd432803012645ac136ddd64dba72",
+		"1111111111111111111111111111111111111111111111111111111111111111",
+		word(2_000_000),
+		word(31337),
+	))
+	if got := paymentHash(customer, id, big.NewInt(2_000_000), 31337); !bytes.Equal(got, want) {
+		t.Errorf("paymentHash = %x, want %x", got, want)
+	}
+}
+
+func TestProcessPayment(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	_, merchant := newAccount(t)
+	customerKey, customer := newAccount(t)
+	id := p.invoice(t, merchant, vyr(10), time.Now().Add(time.Hour))
+	p.fund(customer, posAddress, vyr(10), vyr(10))
+
+	quote, err := p.ProcessPayment(ctx, hexutil.Encode(id[:]), customer.Hex(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := paymentHash(customer, id, vyr(10), testChainID)
+	if quote.Status != PaymentSignatureRequired || quote.Authorization.Hash != hexutil.Encode(hash) ||
+		quote.Authorization.Spender != posAddress.Hex() {
+		t.Fatalf("unsigned payment = %+v, want the message to sign and VyraPOS as spender", quote)
+	}
+	if quote.MerchantFee != "0.025" || quote.PlatformFee != "0.005" || quote.NetAmount != "9.97" {
+		t.Errorf("fees = %s + %s, net %s, want 0.025 + 0.005, net 9.97", quote.MerchantFee, quote.PlatformFee, quote.NetAmount)
+	}
+
+	result, err := p.ProcessPayment(ctx, hexutil.Encode(id[:]), customer.Hex(), personalSign(t, customerKey, hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != PaymentConfirmed || result.PaymentID == "" || result.TxHash == "" {
+		t.Fatalf("payment = %+v, want confirmed", result)
+	}
+	payment, err := p.store.GetPayment(ctx, result.PaymentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.CustomerAddress != customer.Hex() || payment.MerchantAddress != merchant.Hex() || !sameAmount(payment.Amount, vyr(10)) {
+		t.Errorf("stored payment = %+v, want 10 VYR from %s to %s", payment, customer.Hex(), merchant.Hex())
+	}
+	if got, want := p.backend.balance(merchant), vyr(10); got.Cmp(new(big.Int).Sub(want, fee(want, 30))) != 0 {
+		t.Errorf("merchant balance = %s, want 10 VYR less fees", got)
+	}
+
+	// The invoice cannot be paid twice
+	_, err = p.ProcessPayment(ctx, hexutil.Encode(id[:]), customer.Hex(), personalSign(t, customerKey, hash))
+	if !errors.Is(err, ErrInvoiceAlreadyPaid) {
+		t.Errorf("second payment error = %v, want ErrInvoiceAlreadyPaid", err)
+	}
+	if p.backend.mined["processPayment"] != 1 {
+		t.Errorf("mined %v, want one processPayment", p.backend.mined)
+	}
+}
+
+func TestProcessPaymentChecks(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	_, merchant := newAccount(t)
+	customerKey, customer := newAccount(t)
+	otherKey, _ := newAccount(t)
+
+	valid := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		expiry    time.Time
+		balance   *big.Int
+		allowance *big.Int
+		signer    *ecdsa.PrivateKey
+		wantErr   error
+	}{
+		{"expired", time.Now().Add(-time.Minute), vyr(10), vyr(10), customerKey, ErrInvoiceExpired},
+		{"signed by another account", valid, vyr(10), vyr(10), otherKey, ErrInvalidSignature},
+		{"balance too low", valid, vyr(9), vyr(10), customerKey, ErrInsufficientBalance},
+		{"not approved", valid, vyr(10), vyr(9), customerKey, ErrInsufficientAllowance},
+	}
+	for _, tt := range tests {
+		id := p.invoice(t, merchant, vyr(10), tt.expiry)
+		p.fund(customer, posAddress, tt.balance, tt.allowance)
+		signature := personalSign(t, tt.signer, paymentHash(customer, id, vyr(10), testChainID))
+
+		_, err := p.ProcessPayment(ctx, hexutil.Encode(id[:]), customer.Hex(), signature)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	unknown := randomID(t)
+	if _, err := p.ProcessPayment(ctx, hexutil.Encode(unknown[:]), customer.Hex(), ""); !errors.Is(err, ErrInvoiceNotFound) {
+		t.Errorf("unknown invoice error = %v, want ErrInvoiceNotFound", err)
+	}
+	if len(p.backend.mined) != 0 {
+		t.Errorf("mined %v, want nothing", p.backend.mined)
+	}
+}
+
+// TestRelayReverts checks that calls VyraPOS would reject are reported
+// with the matching error and not sent, for the races the service's own
+// checks cannot rule out, such as an invoice paid or expiring meanwhile.
+func TestRelayReverts(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, false)
+	_, merchant := newAccount(t)
+	customerKey, customer := newAccount(t)
+	poorKey, poorCustomer := newAccount(t)
+	p.fund(customer, posAddress, vyr(10), vyr(10))
+	p.fund(poorCustomer, posAddress, vyr(5), vyr(10))
+
+	valid := p.invoice(t, merchant, vyr(10), time.Now().Add(time.Hour))
+	expired := p.invoice(t, merchant, vyr(10), time.Now().Add(-time.Minute))
+	paid := p.invoice(t, merchant, vyr(10), time.Now().Add(time.Hour))
+	p.backend.invoices[paid].paid = true
+	signature := func(id [32]byte) []byte {
+		return common.FromHex(personalSign(t, customerKey, paymentHash(customer, id, vyr(10), testChainID)))
+	}
+	poorSignature := common.FromHex(personalSign(t, poorKey, paymentHash(poorCustomer, valid, vyr(10), testChainID)))
+
+	tests := []struct {
+		name    string
+		method  string
+		args    []interface{}
+		wantErr error
+	}{
+		{"unknown invoice", "processPayment", []interface{}{randomID(t), customer, signature(valid)}, ErrInvoiceNotFound},
+		{"expired", "processPayment", []interface{}{expired, customer, signature(expired)}, ErrInvoiceExpired},
+		{"already paid", "processPayment", []interface{}{paid, customer, signature(paid)}, ErrInvoiceAlreadyPaid},
+		{"signed for another invoice", "processPayment", []interface{}{valid, customer, signature(paid)}, ErrInvalidSignature},
+		{"balance too low", "processPayment", []interface{}{valid, poorCustomer, poorSignature}, ErrInsufficientBalance},
+		{"unknown payment", "refundPayment", []interface{}{randomID(t), vyr(1)}, ErrPaymentNotFound},
+	}
+	for _, tt := range tests {
+		if _, err := p.relay(ctx, tt.method, tt.args...); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	// Errors that are not VyraPOS's own are reported with the revert
+	p.fund(customer, posAddress, vyr(10), vyr(9))
+	_, err := p.relay(ctx, "processPayment", valid, customer, signature(valid))
+	if !errors.Is(err, ErrInvalidPayment) || !strings.Contains(err.Error(), "ERC20InsufficientAllowance") {
+		t.Errorf("allowance too low error = %v, want ErrInvalidPayment with ERC20InsufficientAllowance", err)
+	}
+	if len(p.backend.mined) != 0 {
+		t.Errorf("mined %v, want nothing", p.backend.mined)
+	}
+}
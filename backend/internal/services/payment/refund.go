@@ -0,0 +1,428 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrIdempotencyConflict is returned when an idempotency key is reused
+	// for a different refund.
+	ErrIdempotencyConflict = apierror.Define(http.StatusConflict, apierror.CodeIdempotencyKeyReused, "idempotency key already used for a different refund")
+
+	// ErrRefundInProgress is returned while another refund of the payment,
+	// or the transfer funding this one, is still pending.
+	ErrRefundInProgress = apierror.Define(http.StatusConflict, apierror.CodeRefundInProgress, "refund in progress")
+)
+
+const maxIdempotencyKeyLength = 255
+
+// RefundRequest asks for a refund of an on-chain payment. Amount is the
+// full payment amount when empty. Merchant is the caller, who must be the
+// payment's merchant. The merchant consents by signing the EIP-712 Refund
+// message that RefundPayment returns without a Signature, and pays for the
+// refund from VYR it has approved the relayer to spend.
+type RefundRequest struct {
+	PaymentID      string
+	Merchant       string
+	Amount         string
+	Reason         string
+	IdempotencyKey string
+	Signature      string
+}
+
+// RefundResult is the outcome of RefundPayment. Status is
+// PaymentSignatureRequired with the Authorization to sign, or the status of
+// the stored Refund. Spender is the relayer account the merchant approves
+// to take the refund amount.
+type RefundResult struct {
+	Status        string              `json:"status"`
+	Merchant      string              `json:"merchant"`
+	Customer      string              `json:"customer"`
+	Spender       string              `json:"spender"`
+	Refund        *repository.Refund  `json:"refund,omitempty"`
+	Authorization *apitypes.TypedData `json:"authorization,omitempty"`
+}
+
+// RefundPayment refunds all or part of a payment through
+// VyraPOS.refundPayment, which the relayer account must hold REFUND_ROLE to
+// call. VyraPOS pays refunds from the VYR it holds, which includes the fees
+// of every merchant, so the merchant funds each refund first: the relayer
+// moves the amount from the merchant into VyraPOS with transferFrom, and
+// only then asks VyraPOS to pay it out. VyraPOS allows one refund per
+// payment. The idempotency key makes retries safe: a key already used
+// returns the refund it started rather than sending another, and only a
+// failed refund is attempted again, reusing its funding if that went
+// through.
+func (s *Service) RefundPayment(ctx context.Context, req RefundRequest) (*RefundResult, error) {
+	id, err := parseOnchainID(req.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	paymentID := common.Bytes2Hex(id[:])
+	if req.IdempotencyKey == "" || len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("%w: idempotency key must be 1 to %d characters", ErrInvalidPayment, maxIdempotencyKeyLength)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	payment, err := s.pos.Payments(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payment: %w", err)
+	}
+	if payment.Customer == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, paymentID)
+	}
+	if !strings.EqualFold(payment.Merchant.Hex(), req.Merchant) {
+		return nil, fmt.Errorf("%w: %s", ErrNotMerchant, paymentID)
+	}
+
+	amount := payment.Amount
+	if req.Amount != "" {
+		if amount, err = chain.ParseUnits(req.Amount, chain.VYRDecimals); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPayment, err)
+		}
+		if amount.Sign() == 0 {
+			return nil, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidPayment)
+		}
+		if amount.Cmp(payment.Amount) > 0 {
+			return nil, fmt.Errorf("%w: refund of %s exceeds the payment of %s", ErrInvalidPayment,
+				req.Amount, chain.FormatUnits(payment.Amount, chain.VYRDecimals))
+		}
+	}
+
+	relayer, err := s.relayer.Address()
+	if err != nil {
+		return nil, err
+	}
+	merchant := payment.Merchant.Hex()
+	result := &RefundResult{
+		Merchant: merchant,
+		Customer: payment.Customer.Hex(),
+		Spender:  relayer.Hex(),
+	}
+	refund := &repository.Refund{
+		PaymentID:       paymentID,
+		MerchantAddress: &merchant,
+		IdempotencyKey:  &req.IdempotencyKey,
+		Amount:          chain.FormatUnits(amount, chain.VYRDecimals),
+	}
+	if req.Reason != "" {
+		refund.Reason = &req.Reason
+	}
+
+	// A retried request returns the refund it already started. Keys are
+	// the merchant's own, so another merchant's refunds are not considered
+	existing, err := s.store.GetRefundByIdempotencyKey(ctx, merchant, req.IdempotencyKey)
+	switch {
+	case err == nil:
+		if existing.PaymentID != refund.PaymentID || !sameAmount(existing.Amount, amount) {
+			return nil, ErrIdempotencyConflict
+		}
+		if existing.Status != repository.StatusFailed {
+			result.Status = existing.Status
+			result.Refund = existing
+			return result, nil
+		}
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to look up refund: %w", err)
+	}
+
+	if payment.Refunded {
+		return nil, fmt.Errorf("%w: %s", ErrPaymentRefunded, paymentID)
+	}
+
+	typedData := s.refundTypedData(id, amount, req.IdempotencyKey)
+	if req.Signature == "" {
+		result.Status = PaymentSignatureRequired
+		result.Authorization = &typedData
+		return result, nil
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash refund authorization: %w", err)
+	}
+	signer, err := chain.RecoverSigner(hash, req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if signer != payment.Merchant {
+		return nil, fmt.Errorf("%w: refund is signed by %s, not the merchant %s", ErrInvalidSignature, signer.Hex(), payment.Merchant.Hex())
+	}
+
+	if err := s.checkRefundRole(opts, relayer); err != nil {
+		return nil, err
+	}
+	funded := false
+	if existing != nil {
+		if funded, err = s.refundFunded(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+	if !funded {
+		if err := s.checkRefundFunds(opts, payment.Merchant, relayer, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if existing != nil {
+		if err := s.store.RetryRefund(ctx, existing.ID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				// Another request is already retrying it, or refunding the
+				// payment under another key, which leaves it failed
+				replayed, err := s.replayRefund(ctx, result, req.IdempotencyKey)
+				if err == nil && replayed.Status == repository.StatusFailed {
+					return nil, fmt.Errorf("%w: another refund of this payment is pending", ErrRefundInProgress)
+				}
+				return replayed, err
+			}
+			return nil, fmt.Errorf("failed to retry refund: %w", err)
+		}
+		refund = existing
+	} else {
+		claimed, err := s.store.ClaimRefund(ctx, refund)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store refund: %w", err)
+		}
+		if !claimed {
+			return s.replayRefund(ctx, result, req.IdempotencyKey)
+		}
+	}
+
+	// The refund is claimed: finish the attempt and record its outcome even
+	// if the client goes away, so it is not left pending
+	ctx = context.WithoutCancel(ctx)
+	if !funded {
+		if err := s.fundRefund(ctx, refund.ID, payment.Merchant, amount); err != nil {
+			s.failRefund(ctx, refund.ID, err)
+			return nil, err
+		}
+	}
+	tx, err := s.relay(ctx, "refundPayment", id, amount)
+	if err != nil {
+		// The merchant's funds stay with VyraPOS; retrying the refund under
+		// the same key uses them rather than taking them again
+		s.failRefund(ctx, refund.ID, err)
+		return nil, err
+	}
+	if err := s.store.SetRefundSubmitted(ctx, refund.ID, tx.Hash().Hex()); err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Error("Failed to record refund transaction")
+	}
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":    tx.Hash().Hex(),
+		"paymentId": paymentID,
+		"amount":    refund.Amount,
+	}).Info("Refund broadcast")
+
+	if err := s.recordRefund(ctx, tx, refund.ID); err != nil {
+		// The transaction is out; the indexer confirms the refund once it is
+		// mined, so report it as pending rather than failed
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Warn("Refund not yet confirmed")
+	}
+
+	return s.replayRefund(ctx, result, req.IdempotencyKey)
+}
+
+// ListRefunds returns a payment's refunds and refund attempts, newest first.
+func (s *Service) ListRefunds(ctx context.Context, paymentID string) ([]*repository.Refund, error) {
+	id, err := parseOnchainID(paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.store.ListRefunds(ctx, common.Bytes2Hex(id[:]))
+	if err != nil {
+		return nil, err
+	}
+	if refunds == nil {
+		refunds = []*repository.Refund{}
+	}
+	return refunds, nil
+}
+
+// replayRefund reports the refund result's merchant stored under key.
+// There is none if the key could not be claimed because another refund of
+// the payment is pending.
+func (s *Service) replayRefund(ctx context.Context, result *RefundResult, key string) (*RefundResult, error) {
+	refund, err := s.store.GetRefundByIdempotencyKey(ctx, result.Merchant, key)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("%w: another refund of this payment is pending", ErrRefundInProgress)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up refund: %w", err)
+	}
+	result.Status = refund.Status
+	result.Refund = refund
+	return result, nil
+}
+
+// failRefund marks a claimed refund failed, so it can be tried again.
+func (s *Service) failRefund(ctx context.Context, id string, reason error) {
+	if err := s.store.FailRefund(ctx, id, reason.Error()); err != nil {
+		logging.FromContext(ctx).WithError(err).Error("Failed to mark refund failed")
+	}
+}
+
+// checkRefundRole checks that the relayer may call refundPayment, before
+// the merchant is charged for a refund it cannot make.
+func (s *Service) checkRefundRole(opts *bind.CallOpts, relayer common.Address) error {
+	role, err := s.pos.REFUNDROLE(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get REFUND_ROLE: %w", err)
+	}
+	allowed, err := s.pos.HasRole(opts, role, relayer)
+	if err != nil {
+		return fmt.Errorf("failed to check REFUND_ROLE: %w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("relayer %s does not hold REFUND_ROLE on VyraPOS", relayer.Hex())
+	}
+	return nil
+}
+
+// checkRefundFunds checks that merchant holds amount VYR and has approved
+// the relayer for it, since the relayer funds the refund with transferFrom.
+func (s *Service) checkRefundFunds(opts *bind.CallOpts, merchant, relayer common.Address, amount *big.Int) error {
+	balance, err := s.token.BalanceOf(opts, merchant)
+	if err != nil {
+		return fmt.Errorf("failed to get VYR balance: %w", err)
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: merchant has %s VYR, refund is for %s", ErrInsufficientBalance,
+			chain.FormatUnits(balance, chain.VYRDecimals), chain.FormatUnits(amount, chain.VYRDecimals))
+	}
+
+	allowance, err := s.token.Allowance(opts, merchant, relayer)
+	if err != nil {
+		return fmt.Errorf("failed to get VYR allowance: %w", err)
+	}
+	if allowance.Cmp(amount) < 0 {
+		return fmt.Errorf("%w: merchant has not approved the relayer %s for %s VYR", ErrInsufficientAllowance,
+			relayer.Hex(), chain.FormatUnits(amount, chain.VYRDecimals))
+	}
+	return nil
+}
+
+// fundRefund moves amount VYR from merchant into VyraPOS and waits for the
+// transfer to be mined. The transaction is recorded before waiting, so a
+// retry can tell whether the merchant has already paid.
+func (s *Service) fundRefund(ctx context.Context, refundID string, merchant common.Address, amount *big.Int) error {
+	tx, err := s.relayer.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.token.TransferFrom(opts, merchant, s.posAddress, amount)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to submit refund funding: %w", err)
+	}
+	if err := s.store.SetRefundFunding(ctx, refundID, tx.Hash().Hex()); err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Error("Failed to record refund funding transaction")
+	}
+	_, err = s.waitMined(ctx, tx, "transferFrom")
+	return err
+}
+
+// refundFunded reports whether a failed refund's funding transfer went
+// through, in which case VyraPOS already holds the merchant's VYR for it. A
+// transfer not yet mined is reported as ErrRefundInProgress rather than
+// sending another.
+func (s *Service) refundFunded(ctx context.Context, refund *repository.Refund) (bool, error) {
+	if refund.FundingTxHash == nil {
+		return false, nil
+	}
+	receipt, err := s.client.TransactionReceipt(ctx, common.HexToHash(*refund.FundingTxHash))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, fmt.Errorf("%w: funding transaction %s is not mined yet", ErrRefundInProgress, *refund.FundingTxHash)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get refund funding receipt: %w", err)
+	}
+	return receipt.Status == types.ReceiptStatusSuccessful, nil
+}
+
+// recordRefund waits for a refundPayment transaction and records its
+// PaymentRefunded event.
+func (s *Service) recordRefund(ctx context.Context, tx *types.Transaction, refundID string) error {
+	receipt, err := s.waitMined(ctx, tx, "refundPayment")
+	if err != nil {
+		if errors.Is(err, errReverted) {
+			s.failRefund(ctx, refundID, err)
+		}
+		return err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != s.posAddress {
+			continue
+		}
+		event, err := s.pos.ParsePaymentRefunded(*log)
+		if err != nil {
+			continue
+		}
+
+		paymentID := common.Bytes2Hex(event.PaymentId[:])
+		err = s.store.IndexRefund(ctx, eventRef(log), paymentID, chain.FormatUnits(event.RefundAmount, chain.VYRDecimals))
+		if errors.Is(err, repository.ErrNotFound) {
+			// The refund is recorded; the payment itself is not indexed yet
+			return nil
+		}
+		return err
+	}
+
+	return fmt.Errorf("refundPayment transaction %s emitted no PaymentRefunded event", tx.Hash().Hex())
+}
+
+// refundTypedData is the EIP-712 message a merchant signs to authorize a
+// refund, and the relayer to take its amount from the merchant's VYR. The
+// relayer holds REFUND_ROLE for every merchant, so this is what stops one
+// merchant, or anyone else, refunding another's payments.
+func (s *Service) refundTypedData(id [32]byte, amount *big.Int, key string) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Refund": {
+				{Name: "paymentId", Type: "bytes32"},
+				{Name: "amount", Type: "uint256"},
+				{Name: "idempotencyKey", Type: "string"},
+			},
+		},
+		PrimaryType: "Refund",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Vyra Relayer",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(s.config.ChainID),
+			VerifyingContract: s.posAddress.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"paymentId":      "0x" + common.Bytes2Hex(id[:]),
+			"amount":         amount.String(),
+			"idempotencyKey": key,
+		},
+	}
+}
+
+// sameAmount compares a stored decimal amount with a token amount.
+func sameAmount(stored string, amount *big.Int) bool {
+	value, err := chain.ParseUnits(stored, chain.VYRDecimals)
+	return err == nil && value.Cmp(amount) == 0
+}
@@ -0,0 +1,390 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+)
+
+func TestRefundTypedDataHash(t *testing.T) {
+	pos := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	s := &Service{config: &config.Config{ChainID: 31337}, posAddress: pos}
+	var id [32]byte
+	copy(id[:], common.FromHex("0x2222222222222222222222222222222222222222222222222222222222222222"))
+
+	got, _, err := apitypes.TypedDataAndHash(s.refundTypedData(id, big.NewInt(500_000), "refund-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Encoded by hand as the contract's EIP712 helpers would: strings are
+	// hashed, bytes32 is taken as is and the address is left-padded.
+	domain := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Vyra Relayer")),
+		crypto.Keccak256([]byte("1")),
+		packed(t, word(31337)),
+		common.LeftPadBytes(pos.Bytes(), 32),
+	)
+	message := crypto.Keccak256(
+		crypto.Keccak256([]byte("Refund(bytes32 paymentId,uint256 amount,string idempotencyKey)")),
+		id[:],
+		packed(t, word(500_000)),
+		crypto.Keccak256([]byte("refund-1")),
+	)
+	want := crypto.Keccak256([]byte{0x19, 0x01}, domain, message)
+	if !bytes.Equal(got, want) {
+		t.Errorf("refund digest = %s, want %s", hexutil.Encode(got), hexutil.Encode(want))
+	}
+}
+
+// signRefund asks for a refund without a signature and signs the
+// authorization it returns as merchantKey.
+func signRefund(t *testing.T, p *testPOS, req RefundRequest, merchantKey *ecdsa.PrivateKey) RefundRequest {
+	t.Helper()
+	req.Signature = ""
+	result, err := p.RefundPayment(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != PaymentSignatureRequired || result.Authorization == nil {
+		t.Fatalf("unsigned refund status = %q, want %q with an authorization", result.Status, PaymentSignatureRequired)
+	}
+	hash, _, err := apitypes.TypedDataAndHash(*result.Authorization)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := crypto.Sign(hash, merchantKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	req.Signature = hexutil.Encode(sig)
+	return req
+}
+
+func TestRefundPayment(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	merchantKey, merchant := newAccount(t)
+	_, customer := newAccount(t)
+	id := p.payment(t, customer, merchant, vyr(10))
+	p.fund(merchant, p.relayerAddress, vyr(15), vyr(10))
+
+	req := RefundRequest{
+		PaymentID:      hexutil.Encode(id[:]),
+		Merchant:       merchant.Hex(),
+		Reason:         "damaged",
+		IdempotencyKey: "refund-1",
+	}
+	unsigned, err := p.RefundPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsigned.Spender != p.relayerAddress.Hex() || unsigned.Customer != customer.Hex() {
+		t.Errorf("unsigned refund = %+v, want spender %s and customer %s", unsigned, p.relayerAddress.Hex(), customer.Hex())
+	}
+	if _, err := p.store.GetRefundByIdempotencyKey(ctx, merchant.Hex(), req.IdempotencyKey); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("refund stored before it was signed: %v", err)
+	}
+
+	req = signRefund(t, p, req, merchantKey)
+	result, err := p.RefundPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refund := result.Refund
+	if result.Status != repository.StatusConfirmed || refund.FundingTxHash == nil || refund.TxHash == nil {
+		t.Fatalf("refund = %+v, want confirmed with its funding and refund transactions", refund)
+	}
+	if !sameAmount(refund.Amount, vyr(10)) || *refund.Reason != "damaged" || *refund.MerchantAddress != merchant.Hex() {
+		t.Errorf("refund = %+v, want 10.0 VYR for damaged from %s", refund, merchant.Hex())
+	}
+
+	// The merchant paid for the refund, not the VYR VyraPOS held
+	if got := p.backend.balance(merchant); got.Cmp(vyr(5)) != 0 {
+		t.Errorf("merchant balance = %s, want %s", got, vyr(5))
+	}
+	if got := p.backend.balance(customer); got.Cmp(vyr(10)) != 0 {
+		t.Errorf("customer balance = %s, want %s", got, vyr(10))
+	}
+	if got := p.backend.balance(posAddress); got.Sign() != 0 {
+		t.Errorf("VyraPOS balance = %s, want 0", got)
+	}
+	payment, err := p.store.GetPayment(ctx, common.Bytes2Hex(id[:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Status != repository.StatusRefunded {
+		t.Errorf("payment status = %q, want %q", payment.Status, repository.StatusRefunded)
+	}
+
+	// Retrying the request returns the refund without sending anything
+	replayed, err := p.RefundPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.Refund.ID != refund.ID || replayed.Status != repository.StatusConfirmed {
+		t.Errorf("replayed refund = %+v, want %+v", replayed.Refund, refund)
+	}
+	if p.backend.mined["transferFrom"] != 1 || p.backend.mined["refundPayment"] != 1 {
+		t.Errorf("mined %v, want one transferFrom and one refundPayment", p.backend.mined)
+	}
+
+	// The key cannot be reused for another refund
+	req.Amount = "5"
+	if _, err := p.RefundPayment(ctx, req); !errors.Is(err, ErrIdempotencyConflict) {
+		t.Errorf("reused key error = %v, want ErrIdempotencyConflict", err)
+	}
+	other := p.payment(t, customer, merchant, vyr(10))
+	req.PaymentID, req.Amount = hexutil.Encode(other[:]), ""
+	if _, err := p.RefundPayment(ctx, req); !errors.Is(err, ErrIdempotencyConflict) {
+		t.Errorf("key reused for another payment error = %v, want ErrIdempotencyConflict", err)
+	}
+}
+
+func TestRefundKeysPerMerchant(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	_, customer := newAccount(t)
+
+	// Two merchants choosing the same key get a refund each
+	var refunds []*repository.Refund
+	for n := 0; n < 2; n++ {
+		merchantKey, merchant := newAccount(t)
+		id := p.payment(t, customer, merchant, vyr(3))
+		p.fund(merchant, p.relayerAddress, vyr(3), vyr(3))
+
+		req := signRefund(t, p, RefundRequest{
+			PaymentID:      hexutil.Encode(id[:]),
+			Merchant:       merchant.Hex(),
+			IdempotencyKey: "order-1",
+		}, merchantKey)
+		result, err := p.RefundPayment(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Status != repository.StatusConfirmed {
+			t.Fatalf("refund status = %q, want %q", result.Status, repository.StatusConfirmed)
+		}
+		refunds = append(refunds, result.Refund)
+	}
+	if refunds[0].ID == refunds[1].ID || refunds[0].PaymentID == refunds[1].PaymentID {
+		t.Errorf("refunds %+v and %+v, want one per merchant", refunds[0], refunds[1])
+	}
+}
+
+func TestRefundPaymentChecks(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	merchantKey, merchant := newAccount(t)
+	otherKey, other := newAccount(t)
+	_, customer := newAccount(t)
+	id := p.payment(t, customer, merchant, vyr(10))
+
+	base := RefundRequest{
+		PaymentID: hexutil.Encode(id[:]),
+		Merchant:  merchant.Hex(),
+	}
+	tests := []struct {
+		name    string
+		amount  string
+		key     string
+		setup   func(req *RefundRequest)
+		wantErr error
+	}{
+		{
+			name: "another merchant",
+			setup: func(req *RefundRequest) {
+				req.Merchant = other.Hex()
+			},
+			wantErr: ErrNotMerchant,
+		},
+		{name: "more than was paid", amount: "10.5", wantErr: ErrInvalidPayment},
+		{name: "zero", amount: "0", wantErr: ErrInvalidPayment},
+		{name: "no key", key: "-", wantErr: ErrInvalidPayment},
+		{
+			name: "signed by another account",
+			setup: func(req *RefundRequest) {
+				*req = signRefund(t, p, *req, otherKey)
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "not approved",
+			setup: func(req *RefundRequest) {
+				p.fund(merchant, p.relayerAddress, vyr(10), vyr(9))
+				*req = signRefund(t, p, *req, merchantKey)
+			},
+			wantErr: ErrInsufficientAllowance,
+		},
+		{
+			name: "balance too low",
+			setup: func(req *RefundRequest) {
+				p.fund(merchant, p.relayerAddress, vyr(9), vyr(10))
+				*req = signRefund(t, p, *req, merchantKey)
+			},
+			wantErr: ErrInsufficientBalance,
+		},
+	}
+	for n, tt := range tests {
+		req := base
+		req.Amount = tt.amount
+		req.IdempotencyKey = fmt.Sprintf("check-%d", n)
+		if tt.key == "-" {
+			req.IdempotencyKey = ""
+		}
+		if tt.setup != nil {
+			tt.setup(&req)
+		}
+
+		_, err := p.RefundPayment(ctx, req)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if req.IdempotencyKey != "" {
+			if _, err := p.store.GetRefundByIdempotencyKey(ctx, merchant.Hex(), req.IdempotencyKey); !errors.Is(err, repository.ErrNotFound) {
+				t.Errorf("%s: refund stored: %v", tt.name, err)
+			}
+		}
+	}
+
+	// Without REFUND_ROLE the merchant is not charged for a refund that
+	// cannot be made
+	p.fund(merchant, p.relayerAddress, vyr(10), vyr(10))
+	p.backend.refunders[p.relayerAddress] = false
+	req := base
+	req.IdempotencyKey = "no-role"
+	if _, err := p.RefundPayment(ctx, signRefund(t, p, req, merchantKey)); err == nil {
+		t.Error("refund without REFUND_ROLE succeeded")
+	}
+	if len(p.backend.mined) != 0 {
+		t.Errorf("mined %v, want nothing", p.backend.mined)
+	}
+}
+
+func TestRefundRetry(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	merchantKey, merchant := newAccount(t)
+	_, customer := newAccount(t)
+	id := p.payment(t, customer, merchant, vyr(10))
+	p.fund(merchant, p.relayerAddress, vyr(20), vyr(20))
+
+	req := signRefund(t, p, RefundRequest{
+		PaymentID:      hexutil.Encode(id[:]),
+		Merchant:       merchant.Hex(),
+		IdempotencyKey: "retry-1",
+	}, merchantKey)
+
+	// The funding transfer reverts: the refund fails and the merchant keeps
+	// its VYR
+	p.backend.revertNext["transferFrom"] = true
+	if _, err := p.RefundPayment(ctx, req); !errors.Is(err, errReverted) {
+		t.Fatalf("refund with reverted funding error = %v, want errReverted", err)
+	}
+	failed, err := p.store.GetRefundByIdempotencyKey(ctx, merchant.Hex(), req.IdempotencyKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Status != repository.StatusFailed || failed.FundingTxHash == nil {
+		t.Fatalf("refund = %+v, want failed with its funding transaction", failed)
+	}
+
+	// Retried, the refund is funded again, and refundPayment reverts: the
+	// refund fails with the merchant's VYR left in VyraPOS
+	p.backend.revertNext["refundPayment"] = true
+	result, err := p.RefundPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != repository.StatusFailed || result.Refund.ID != failed.ID {
+		t.Fatalf("refund = %+v, want %s failed", result.Refund, failed.ID)
+	}
+	if got := p.backend.balance(posAddress); got.Cmp(vyr(10)) != 0 {
+		t.Errorf("VyraPOS balance = %s, want %s", got, vyr(10))
+	}
+
+	// Retried again, the refund uses the funds already sent
+	result, err = p.RefundPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != repository.StatusConfirmed || result.Refund.ID != failed.ID {
+		t.Fatalf("refund = %+v, want %s confirmed", result.Refund, failed.ID)
+	}
+	if got := p.backend.balance(merchant); got.Cmp(vyr(10)) != 0 {
+		t.Errorf("merchant balance = %s, want %s", got, vyr(10))
+	}
+	if p.backend.mined["transferFrom"] != 2 || p.backend.mined["refundPayment"] != 2 {
+		t.Errorf("mined %v, want two transferFrom and two refundPayment", p.backend.mined)
+	}
+
+	// A confirmed refund is not tried again
+	if _, err := p.RefundPayment(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if p.backend.mined["refundPayment"] != 2 {
+		t.Errorf("mined %v after the refund was confirmed", p.backend.mined)
+	}
+}
+
+func TestRefundPending(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	merchantKey, merchant := newAccount(t)
+	_, customer := newAccount(t)
+	id := p.payment(t, customer, merchant, vyr(10))
+	p.fund(merchant, p.relayerAddress, vyr(10), vyr(10))
+
+	// Another request is refunding the payment
+	merchantAddress := merchant.Hex()
+	key := "pending-1"
+	pending := &repository.Refund{
+		PaymentID:       common.Bytes2Hex(id[:]),
+		MerchantAddress: &merchantAddress,
+		IdempotencyKey:  &key,
+		Amount:          "10.0",
+	}
+	if claimed, err := p.store.ClaimRefund(ctx, pending); err != nil || !claimed {
+		t.Fatalf("ClaimRefund = %v, %v", claimed, err)
+	}
+
+	// Its key reports it, signed or not, rather than refunding again
+	result, err := p.RefundPayment(ctx, RefundRequest{
+		PaymentID:      hexutil.Encode(id[:]),
+		Merchant:       merchant.Hex(),
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != repository.StatusPending || result.Refund.ID != pending.ID {
+		t.Errorf("refund = %+v, want %s pending", result.Refund, pending.ID)
+	}
+
+	// Another key cannot refund the payment meanwhile
+	req := signRefund(t, p, RefundRequest{
+		PaymentID:      hexutil.Encode(id[:]),
+		Merchant:       merchant.Hex(),
+		IdempotencyKey: "pending-2",
+	}, merchantKey)
+	if _, err := p.RefundPayment(ctx, req); !errors.Is(err, ErrRefundInProgress) {
+		t.Errorf("second refund error = %v, want ErrRefundInProgress", err)
+	}
+	if len(p.backend.mined) != 0 {
+		t.Errorf("mined %v, want nothing", p.backend.mined)
+	}
+}
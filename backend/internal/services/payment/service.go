@@ -1,53 +1,97 @@
 package payment
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"context"
+	"errors"
+	"fmt"
+
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Service struct {
-	config *config.Config
+	config     *config.Config
+	store      *repository.Store
+	client     chain.Backend
+	relayer    *chain.Relayer
+	posAddress common.Address
+	pos        *contracts.VyraPOS
+	posABI     *abi.ABI
+	token      *contracts.VyraToken
+}
+
+// Record is what a payment lookup returns: the settled payment, or the
+// invoice while it is still awaiting payment.
+type Record struct {
+	ID          string              `json:"id"`
+	Status      string              `json:"status"`
+	Amount      string              `json:"amount"`
+	Description string              `json:"description,omitempty"`
+	Invoice     *repository.Invoice `json:"invoice,omitempty"`
+	Payment     *repository.Payment `json:"payment,omitempty"`
 }
 
-func New(cfg *config.Config) *Service {
+func New(cfg *config.Config, store *repository.Store, backend chain.Backend, relayer *chain.Relayer) *Service {
+	posAddress := common.HexToAddress(cfg.POS)
+	pos, err := contracts.NewVyraPOS(posAddress, backend)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to bind VyraPOS contract: %v", err))
+	}
+	posABI, err := contracts.VyraPOSMetaData.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to parse VyraPOS ABI: %v", err))
+	}
+	token, err := contracts.NewVyraToken(common.HexToAddress(cfg.VyraToken), backend)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to bind VyraToken contract: %v", err))
+	}
+
 	return &Service{
-		config: cfg,
+		config:     cfg,
+		store:      store,
+		client:     backend,
+		relayer:    relayer,
+		posAddress: posAddress,
+		pos:        pos,
+		posABI:     posABI,
+		token:      token,
 	}
 }
 
-func (s *Service) CreateInvoice(amount, description string, expiry int64) (string, error) {
-	// Generate a random invoice ID
-	bytes := make([]byte, 32)
-	rand.Read(bytes)
-	invoiceID := hex.EncodeToString(bytes)
-	
-	// In a real implementation, this would:
-	// 1. Validate the amount and description
-	// 2. Store the invoice in the database
-	// 3. Return the invoice ID
-	
-	return invoiceID, nil
-}
+// GetPayment looks up a payment by payment ID, falling back to the invoice
+// with that ID so clients can poll an invoice until it is paid.
+func (s *Service) GetPayment(ctx context.Context, paymentID string) (*Record, error) {
+	payment, err := s.store.GetPayment(ctx, paymentID)
+	if err == nil {
+		return &Record{
+			ID:      payment.PaymentID,
+			Status:  payment.Status,
+			Amount:  payment.Amount,
+			Payment: payment,
+		}, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
 
-func (s *Service) GetPayment(paymentID string) (map[string]interface{}, error) {
-	// In a real implementation, this would:
-	// 1. Query the database for the payment
-	// 2. Return the payment details
-	
-	return map[string]interface{}{
-		"id":          paymentID,
-		"status":      "pending",
-		"amount":      "0.0",
-		"description": "Sample payment",
-	}, nil
-}
+	invoice, err := s.store.GetInvoice(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *Service) ProcessPayment(paymentID, customer string) (string, error) {
-	// In a real implementation, this would:
-	// 1. Validate the payment
-	// 2. Create and send the transaction
-	// 3. Update the payment status
-	
-	return "0xplaceholder_tx_hash", nil
+	record := &Record{
+		ID:      invoice.InvoiceID,
+		Status:  invoice.Status,
+		Amount:  invoice.Amount,
+		Invoice: invoice,
+	}
+	if invoice.Description != nil {
+		record.Description = *invoice.Description
+	}
+	return record, nil
 }
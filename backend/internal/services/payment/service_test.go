@@ -0,0 +1,594 @@
+package payment
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/testdb"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// The simulated backend's chain ID
+const testChainID = 1337
+
+// VyraPOS's fee rates, in basis points
+const (
+	testMerchantFeeRate = 25
+	testPlatformFeeRate = 5
+)
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+var (
+	posAddress      = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	tokenAddress    = common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512")
+	treasuryAddress = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	refundRole      = crypto.Keccak256Hash([]byte("REFUND_ROLE"))
+)
+
+// vyr returns n whole VYR in base units.
+func vyr(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.Ether))
+}
+
+// revertError is a reverted call as a node reports it, with the revert
+// data hex-encoded in the error data.
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorCode() int         { return 3 }
+func (e *revertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+type posInvoice struct {
+	merchant  common.Address
+	amount    *big.Int
+	expiry    int64
+	paid      bool
+	paymentID [32]byte
+}
+
+type posPayment struct {
+	customer    common.Address
+	merchant    common.Address
+	amount      *big.Int
+	merchantFee *big.Int
+	platformFee *big.Int
+	refunded    bool
+	invoiceID   [32]byte
+}
+
+// posBackend is go-ethereum's simulated backend with VyraPOS at posAddress
+// and VyraToken at tokenAddress executed in memory. The bindings carry no
+// bytecode to deploy, so calls to the contracts are answered here: views
+// read the state below, gas estimates dry-run the call and report a revert
+// as a node would, and a transaction to either contract takes effect when
+// the simulated chain mines it, which it does as soon as it is sent.
+type posBackend struct {
+	*backends.SimulatedBackend
+	posABI   *abi.ABI
+	tokenABI *abi.ABI
+
+	invoices   map[[32]byte]*posInvoice
+	payments   map[[32]byte]*posPayment
+	balances   map[common.Address]*big.Int
+	allowances map[[2]common.Address]*big.Int
+	refunders  map[common.Address]bool
+	paymentSeq int64
+
+	// revertNext makes the next transaction calling a method revert when
+	// mined, as one whose estimate raced another transaction would
+	revertNext map[string]bool
+	// mined counts the contract calls mined, reverted or not, by method
+	mined    map[string]int
+	reverted map[common.Hash]bool
+	logs     map[common.Hash][]*types.Log
+}
+
+func (b *posBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || (*call.To != posAddress && *call.To != tokenAddress) {
+		return b.SimulatedBackend.CallContract(ctx, call, blockNumber)
+	}
+	method, args, err := b.decode(*call.To, call.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		return method.Outputs.Pack(b.balance(args[0].(common.Address)))
+	case "allowance":
+		return method.Outputs.Pack(b.allowance(args[0].(common.Address), args[1].(common.Address)))
+	case "invoices":
+		inv := b.invoices[args[0].([32]byte)]
+		if inv == nil {
+			inv = &posInvoice{amount: new(big.Int)}
+		}
+		return method.Outputs.Pack(inv.merchant, inv.amount, "", big.NewInt(inv.expiry), inv.paid, inv.paymentID)
+	case "payments":
+		p := b.payments[args[0].([32]byte)]
+		if p == nil {
+			p = &posPayment{amount: new(big.Int), merchantFee: new(big.Int), platformFee: new(big.Int)}
+		}
+		return method.Outputs.Pack(p.customer, p.merchant, p.amount, p.merchantFee, p.platformFee,
+			new(big.Int), p.refunded, p.invoiceID)
+	case "merchantFeeRate":
+		return method.Outputs.Pack(big.NewInt(testMerchantFeeRate))
+	case "platformFeeRate":
+		return method.Outputs.Pack(big.NewInt(testPlatformFeeRate))
+	case "FEE_DENOMINATOR":
+		return method.Outputs.Pack(big.NewInt(10000))
+	case "REFUND_ROLE":
+		return method.Outputs.Pack(refundRole)
+	case "hasRole":
+		return method.Outputs.Pack(args[0].([32]byte) == refundRole && b.refunders[args[1].(common.Address)])
+	}
+	return nil, fmt.Errorf("test contracts do not implement %s", method.Name)
+}
+
+func (b *posBackend) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if account == posAddress || account == tokenAddress {
+		return []byte{0x00}, nil
+	}
+	return b.SimulatedBackend.CodeAt(ctx, account, blockNumber)
+}
+
+func (b *posBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	if account == posAddress || account == tokenAddress {
+		return []byte{0x00}, nil
+	}
+	return b.SimulatedBackend.PendingCodeAt(ctx, account)
+}
+
+// EstimateGas dry-runs contract calls, failing with the revert they would
+// make.
+func (b *posBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if call.To == nil || (*call.To != posAddress && *call.To != tokenAddress) {
+		return b.SimulatedBackend.EstimateGas(ctx, call)
+	}
+	if err := b.execute(call.From, *call.To, call.Data, nil); err != nil {
+		return 0, err
+	}
+	return 200_000, nil
+}
+
+// SendTransaction mines tx at once, applying a contract call's effects or
+// marking it reverted.
+func (b *posBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	if to := tx.To(); to != nil && (*to == posAddress || *to == tokenAddress) {
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return err
+		}
+		method, _, err := b.decode(*to, tx.Data())
+		if err != nil {
+			return err
+		}
+		b.mined[method.Name]++
+
+		hash := tx.Hash()
+		if b.revertNext[method.Name] {
+			delete(b.revertNext, method.Name)
+			b.reverted[hash] = true
+		} else if err := b.execute(from, *to, tx.Data(), &hash); err != nil {
+			b.reverted[hash] = true
+		}
+	}
+	b.Commit()
+	return nil
+}
+
+// TransactionReceipt reports the outcome of contract calls: failed if they
+// reverted, and otherwise with the events they emitted.
+func (b *posBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := b.SimulatedBackend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	r := *receipt
+	if b.reverted[hash] {
+		r.Status = types.ReceiptStatusFailed
+		r.Logs = nil
+		return &r, nil
+	}
+	for n, log := range b.logs[hash] {
+		l := *log
+		l.TxHash = hash
+		l.TxIndex = r.TransactionIndex
+		l.BlockHash = r.BlockHash
+		l.BlockNumber = r.BlockNumber.Uint64()
+		l.Index = uint(n)
+		r.Logs = append(r.Logs, &l)
+	}
+	return &r, nil
+}
+
+func (b *posBackend) decode(contract common.Address, data []byte) (*abi.Method, []interface{}, error) {
+	parsed := b.posABI
+	if contract == tokenAddress {
+		parsed = b.tokenABI
+	}
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("call data too short")
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// execute runs a call to one of the contracts from sender, as VyraPOS and
+// VyraToken would. It only checks that the call succeeds unless tx is
+// given, in which case it applies the call's effects and records its
+// events under tx.
+func (b *posBackend) execute(sender, contract common.Address, data []byte, tx *common.Hash) error {
+	method, args, err := b.decode(contract, data)
+	if err != nil {
+		return err
+	}
+
+	switch method.Name {
+	case "transferFrom":
+		from, to, amount := args[0].(common.Address), args[1].(common.Address), args[2].(*big.Int)
+		if err := b.spend(sender, from, amount, tx != nil); err != nil {
+			return err
+		}
+		if tx != nil {
+			b.credit(to, amount)
+		}
+		return nil
+	case "processPayment":
+		return b.processPayment(args[0].([32]byte), args[1].(common.Address), args[2].([]byte), tx)
+	case "processSplitPayment":
+		return b.processSplitPayment(args[0].([]common.Address), args[1].([]*big.Int), args[2].(*big.Int),
+			args[3].(common.Address), args[4].([]byte), tx)
+	case "refundPayment":
+		return b.refundPayment(sender, args[0].([32]byte), args[1].(*big.Int), tx)
+	}
+	return fmt.Errorf("test contracts do not implement %s", method.Name)
+}
+
+func (b *posBackend) processPayment(id [32]byte, customer common.Address, signature []byte, tx *common.Hash) error {
+	inv := b.invoices[id]
+	switch {
+	case inv == nil:
+		return b.revert("InvoiceNotFound")
+	case inv.paid:
+		return b.revert("InvoiceAlreadyPaid")
+	case inv.expiry <= time.Now().Unix():
+		return b.revert("InvoiceExpired")
+	case !signedBy(paymentHash(customer, id, inv.amount, testChainID), signature, customer):
+		return b.revert("InvalidSignature")
+	case b.balance(customer).Cmp(inv.amount) < 0:
+		return b.revert("InsufficientBalance")
+	}
+	if err := b.spend(posAddress, customer, inv.amount, tx != nil); err != nil || tx == nil {
+		return err
+	}
+
+	merchantFee := fee(inv.amount, testMerchantFeeRate)
+	platformFee := fee(inv.amount, testPlatformFeeRate)
+	net := new(big.Int).Sub(inv.amount, merchantFee)
+	net.Sub(net, platformFee)
+	b.credit(inv.merchant, net)
+	b.credit(posAddress, merchantFee)
+	b.credit(treasuryAddress, platformFee)
+
+	paymentID := b.nextPaymentID(id[:], customer.Bytes())
+	inv.paid = true
+	inv.paymentID = paymentID
+	b.payments[paymentID] = &posPayment{
+		customer:    customer,
+		merchant:    inv.merchant,
+		amount:      inv.amount,
+		merchantFee: merchantFee,
+		platformFee: platformFee,
+		invoiceID:   id,
+	}
+	b.emit(*tx, "PaymentProcessed", []common.Hash{paymentID, id, common.BytesToHash(customer.Bytes())}, inv.amount)
+	return nil
+}
+
+func (b *posBackend) processSplitPayment(recipients []common.Address, percentages []*big.Int, total *big.Int, customer common.Address, signature []byte, tx *common.Hash) error {
+	sum := new(big.Int)
+	for _, percentage := range percentages {
+		sum.Add(sum, percentage)
+	}
+	sp := &split{customer: customer, amount: total, recipients: recipients, percentages: percentages}
+	switch {
+	case len(recipients) == 0 || len(recipients) != len(percentages):
+		return b.revert("InvalidRecipients")
+	case total.Sign() == 0:
+		return b.revert("InvalidAmount")
+	case sum.Cmp(big.NewInt(splitDenominator)) != 0:
+		return b.revert("InvalidPercentage")
+	case !signedBy(splitHash(sp, testChainID), signature, customer):
+		return b.revert("InvalidSignature")
+	case b.balance(customer).Cmp(total) < 0:
+		return b.revert("InsufficientBalance")
+	}
+
+	amounts := make([]*big.Int, len(recipients))
+	paid := new(big.Int)
+	for n, percentage := range percentages {
+		amounts[n] = fee(total, percentage.Int64())
+		paid.Add(paid, amounts[n])
+	}
+	if err := b.spend(posAddress, customer, paid, tx != nil); err != nil || tx == nil {
+		return err
+	}
+	for n, recipient := range recipients {
+		b.credit(recipient, amounts[n])
+	}
+
+	paymentID := b.nextPaymentID(customer.Bytes(), total.Bytes())
+	b.emit(*tx, "SplitPaymentProcessed", []common.Hash{paymentID}, recipients, amounts)
+	return nil
+}
+
+func (b *posBackend) refundPayment(sender common.Address, id [32]byte, amount *big.Int, tx *common.Hash) error {
+	p := b.payments[id]
+	switch {
+	case !b.refunders[sender]:
+		return b.revert("AccessControlUnauthorizedAccount", sender, refundRole)
+	case p == nil:
+		return b.revert("PaymentNotFound")
+	case p.refunded:
+		return b.revert("PaymentAlreadyRefunded")
+	case amount.Sign() == 0 || amount.Cmp(p.amount) > 0:
+		return b.revert("InvalidAmount")
+	}
+	if balance := b.balance(posAddress); balance.Cmp(amount) < 0 {
+		return b.tokenRevert("ERC20InsufficientBalance", posAddress, balance, amount)
+	}
+	if tx == nil {
+		return nil
+	}
+
+	p.refunded = true
+	b.balances[posAddress] = new(big.Int).Sub(b.balance(posAddress), amount)
+	b.credit(p.customer, amount)
+	b.emit(*tx, "PaymentRefunded", []common.Hash{id}, amount)
+	return nil
+}
+
+// spend takes amount from owner's balance and spender's allowance, or
+// reverts as VyraToken.transferFrom would.
+func (b *posBackend) spend(spender, owner common.Address, amount *big.Int, apply bool) error {
+	allowance := b.allowance(owner, spender)
+	if allowance.Cmp(amount) < 0 {
+		return b.tokenRevert("ERC20InsufficientAllowance", spender, allowance, amount)
+	}
+	balance := b.balance(owner)
+	if balance.Cmp(amount) < 0 {
+		return b.tokenRevert("ERC20InsufficientBalance", owner, balance, amount)
+	}
+	if apply {
+		b.allowances[[2]common.Address{owner, spender}] = new(big.Int).Sub(allowance, amount)
+		b.balances[owner] = new(big.Int).Sub(balance, amount)
+	}
+	return nil
+}
+
+func (b *posBackend) credit(account common.Address, amount *big.Int) {
+	b.balances[account] = new(big.Int).Add(b.balance(account), amount)
+}
+
+func (b *posBackend) balance(account common.Address) *big.Int {
+	if balance := b.balances[account]; balance != nil {
+		return balance
+	}
+	return new(big.Int)
+}
+
+func (b *posBackend) allowance(owner, spender common.Address) *big.Int {
+	if allowance := b.allowances[[2]common.Address{owner, spender}]; allowance != nil {
+		return allowance
+	}
+	return new(big.Int)
+}
+
+// nextPaymentID derives a payment ID as VyraPOS does, with a sequence
+// number standing in for the block timestamp.
+func (b *posBackend) nextPaymentID(parts ...[]byte) [32]byte {
+	b.paymentSeq++
+	return crypto.Keccak256Hash(append(parts, big.NewInt(b.paymentSeq).Bytes())...)
+}
+
+func (b *posBackend) emit(tx common.Hash, name string, topics []common.Hash, args ...interface{}) {
+	event := b.posABI.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		panic(err)
+	}
+	b.logs[tx] = append(b.logs[tx], &types.Log{
+		Address: posAddress,
+		Topics:  append([]common.Hash{event.ID}, topics...),
+		Data:    data,
+	})
+}
+
+func (b *posBackend) revert(name string, args ...interface{}) error {
+	return revertWith(b.posABI, name, args...)
+}
+
+func (b *posBackend) tokenRevert(name string, args ...interface{}) error {
+	return revertWith(b.tokenABI, name, args...)
+}
+
+func revertWith(parsed *abi.ABI, name string, args ...interface{}) error {
+	e := parsed.Errors[name]
+	data, err := e.Inputs.Pack(args...)
+	if err != nil {
+		panic(err)
+	}
+	return &revertError{data: append(e.ID.Bytes()[:4], data...)}
+}
+
+func fee(amount *big.Int, rate int64) *big.Int {
+	f := new(big.Int).Mul(amount, big.NewInt(rate))
+	return f.Div(f, big.NewInt(10000))
+}
+
+func signedBy(hash, signature []byte, account common.Address) bool {
+	signer, err := chain.RecoverSigner(accounts.TextHash(hash), hexutil.Encode(signature))
+	return err == nil && signer == account
+}
+
+// testPOS is the payment service on a posBackend, relaying from an account
+// that holds REFUND_ROLE.
+type testPOS struct {
+	*Service
+	backend        *posBackend
+	relayerAddress common.Address
+}
+
+func newTestPOS(t *testing.T, withStore bool) *testPOS {
+	t.Helper()
+
+	relayKey, _ := crypto.GenerateKey()
+	relayer := crypto.PubkeyToAddress(relayKey.PublicKey)
+
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+		relayer: {Balance: big.NewInt(params.Ether)},
+	}, 30_000_000)
+	t.Cleanup(func() { sim.Close() })
+
+	posABI, err := contracts.VyraPOSMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenABI, err := contracts.VyraTokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &posBackend{
+		SimulatedBackend: sim,
+		posABI:           posABI,
+		tokenABI:         tokenABI,
+		invoices:         make(map[[32]byte]*posInvoice),
+		payments:         make(map[[32]byte]*posPayment),
+		balances:         make(map[common.Address]*big.Int),
+		allowances:       make(map[[2]common.Address]*big.Int),
+		refunders:        map[common.Address]bool{relayer: true},
+		revertNext:       make(map[string]bool),
+		mined:            make(map[string]int),
+		reverted:         make(map[common.Hash]bool),
+		logs:             make(map[common.Hash][]*types.Log),
+	}
+
+	cfg := &config.Config{
+		ChainID:    testChainID,
+		POS:        posAddress.Hex(),
+		VyraToken:  tokenAddress.Hex(),
+		RelayerKey: hexutil.Encode(crypto.FromECDSA(relayKey)),
+	}
+	svc := New(cfg, nil, backend, chain.NewRelayer(cfg.RelayerKey, cfg.ChainID, backend))
+	if withStore {
+		svc.store = testdb.Open(t)
+	}
+	return &testPOS{Service: svc, backend: backend, relayerAddress: relayer}
+}
+
+// fund gives owner balance VYR, allowing spender allowance of it.
+func (p *testPOS) fund(owner, spender common.Address, balance, allowance *big.Int) {
+	p.backend.balances[owner] = balance
+	p.backend.allowances[[2]common.Address{owner, spender}] = allowance
+}
+
+// invoice creates an on-chain invoice from merchant expiring at expiry.
+func (p *testPOS) invoice(t *testing.T, merchant common.Address, amount *big.Int, expiry time.Time) [32]byte {
+	t.Helper()
+	id := randomID(t)
+	p.backend.invoices[id] = &posInvoice{merchant: merchant, amount: amount, expiry: expiry.Unix()}
+	return id
+}
+
+// payment records a payment from customer to merchant on-chain and in the
+// database, as if VyraPOS had processed it and the indexer seen it.
+func (p *testPOS) payment(t *testing.T, customer, merchant common.Address, amount *big.Int) [32]byte {
+	t.Helper()
+	id := randomID(t)
+	p.backend.payments[id] = &posPayment{
+		customer:    customer,
+		merchant:    merchant,
+		amount:      amount,
+		merchantFee: fee(amount, testMerchantFeeRate),
+		platformFee: fee(amount, testPlatformFeeRate),
+	}
+
+	ref := repository.EventRef{
+		TxHash:      common.BytesToHash(id[:]).Hex(),
+		BlockNumber: 1,
+		BlockHash:   common.Hash{1}.Hex(),
+	}
+	err := p.store.IndexPayment(context.Background(), ref, &repository.Payment{
+		PaymentID:       common.Bytes2Hex(id[:]),
+		CustomerAddress: customer.Hex(),
+		MerchantAddress: merchant.Hex(),
+		Amount:          chain.FormatUnits(amount, chain.VYRDecimals),
+		MerchantFee:     chain.FormatUnits(fee(amount, testMerchantFeeRate), chain.VYRDecimals),
+		PlatformFee:     chain.FormatUnits(fee(amount, testPlatformFeeRate), chain.VYRDecimals),
+	}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func newAccount(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func randomID(t *testing.T) [32]byte {
+	t.Helper()
+	var id [32]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// personalSign signs hash as a wallet's personal_sign does.
+func personalSign(t *testing.T, key *ecdsa.PrivateKey, hash []byte) string {
+	t.Helper()
+	sig, err := crypto.Sign(accounts.TextHash(hash), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig)
+}
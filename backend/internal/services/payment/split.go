@@ -0,0 +1,407 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/logging"
+	"vyra-backend/internal/repository"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// splitDenominator is what VyraPOS requires split percentages, in basis
+	// points, to sum to
+	splitDenominator = 10000
+
+	// maxSplitRecipients bounds the gas a relayed split payment can use
+	maxSplitRecipients = 20
+
+	maxReferenceLength = 255
+)
+
+// ErrReferenceReused is returned when a customer's payment reference is
+// reused for a different split payment.
+var ErrReferenceReused = apierror.Define(http.StatusConflict, apierror.CodeReferenceReused, "reference already used for a different payment")
+
+// SplitRecipient is one recipient of a split payment and its share in basis
+// points, as in VyraPOS.SplitRecipient.
+type SplitRecipient struct {
+	Recipient  string `json:"recipient"`
+	Percentage uint16 `json:"percentage"`
+}
+
+// SplitRequest is a split payment from a customer. Reference is the
+// client's identifier for it, such as a marketplace order ID.
+type SplitRequest struct {
+	Reference  string
+	Customer   string
+	Amount     string
+	Recipients []SplitRecipient
+	Signature  string
+}
+
+// SplitAmount is what one recipient receives from a split payment.
+type SplitAmount struct {
+	Recipient  string `json:"recipient"`
+	Percentage uint16 `json:"percentage"`
+	Amount     string `json:"amount"`
+}
+
+// SplitAuthorization is the message VyraPOS.processSplitPayment verifies:
+// keccak256(abi.encodePacked(customer, recipients, percentages, totalAmount,
+// chainid)). The customer signs Hash with personal_sign (EIP-191) and must
+// have approved Spender, the VyraPOS contract, for AmountWei VYR.
+//
+// The message has no nonce and VyraPOS keeps no record of split payments,
+// so once relayed the signature is public and anyone can submit it again,
+// paying the same split again, for as long as the customer's allowance
+// covers it. The reference only stops this service relaying it twice.
+// Customers should approve exactly AmountWei for each split payment rather
+// than a standing allowance.
+type SplitAuthorization struct {
+	Customer    string   `json:"customer"`
+	Recipients  []string `json:"recipients"`
+	Percentages []uint16 `json:"percentages"`
+	AmountWei   string   `json:"amountWei"`
+	ChainID     int64    `json:"chainId"`
+	Spender     string   `json:"spender"`
+	Hash        string   `json:"hash"`
+}
+
+// SplitResult is the outcome of ProcessSplitPayment. Before submission the
+// split amounts are computed as VyraPOS will; once confirmed they are the
+// amounts SplitPaymentProcessed reported. Rounding leaves any remainder
+// with the customer.
+type SplitResult struct {
+	Status        string              `json:"status"`
+	Reference     string              `json:"reference"`
+	PaymentID     string              `json:"paymentId,omitempty"`
+	TxHash        string              `json:"txHash,omitempty"`
+	Customer      string              `json:"customer"`
+	Amount        string              `json:"amount"`
+	Splits        []SplitAmount       `json:"splits"`
+	Authorization *SplitAuthorization `json:"authorization,omitempty"`
+}
+
+type split struct {
+	customer    common.Address
+	amount      *big.Int
+	recipients  []common.Address
+	percentages []*big.Int
+}
+
+// splitHash is the message processSplitPayment verifies. Array elements are
+// padded to 32 bytes even in packed encoding.
+func splitHash(sp *split, chainID int64) []byte {
+	packed := [][]byte{sp.customer.Bytes()}
+	for _, recipient := range sp.recipients {
+		packed = append(packed, common.LeftPadBytes(recipient.Bytes(), 32))
+	}
+	for _, percentage := range sp.percentages {
+		packed = append(packed, common.LeftPadBytes(percentage.Bytes(), 32))
+	}
+	packed = append(packed,
+		common.LeftPadBytes(sp.amount.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(chainID).Bytes(), 32),
+	)
+	return crypto.Keccak256(packed...)
+}
+
+// ProcessSplitPayment pays several recipients from one customer in a single
+// VyraPOS.processSplitPayment call. Without a signature it returns the split
+// and the message the customer signs; with one it checks that the payment
+// will succeed and submits it from the relayer account. VyraPOS split
+// payments carry no nonce, so the customer's reference makes retries safe:
+// it is claimed before the payment is submitted, a reference already used
+// returns the payment it started, and only a failed payment is attempted
+// again.
+func (s *Service) ProcessSplitPayment(ctx context.Context, req SplitRequest) (*SplitResult, error) {
+	sp, err := parseSplit(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SplitResult{
+		Reference: req.Reference,
+		Customer:  sp.customer.Hex(),
+		Amount:    chain.FormatUnits(sp.amount, chain.VYRDecimals),
+		Splits:    make([]SplitAmount, len(sp.recipients)),
+	}
+	for n, recipient := range sp.recipients {
+		share := new(big.Int).Mul(sp.amount, sp.percentages[n])
+		result.Splits[n] = SplitAmount{
+			Recipient:  recipient.Hex(),
+			Percentage: req.Recipients[n].Percentage,
+			Amount:     chain.FormatUnits(share.Div(share, big.NewInt(splitDenominator)), chain.VYRDecimals),
+		}
+	}
+
+	hash := splitHash(sp, s.config.ChainID)
+	requestHash := hexutil.Encode(hash)
+
+	// A retried request returns the payment it already started
+	existing, err := s.store.GetPaymentReference(ctx, sp.customer.Hex(), req.Reference)
+	switch {
+	case err == nil:
+		if existing.RequestHash != requestHash {
+			return nil, fmt.Errorf("%w: %s", ErrReferenceReused, req.Reference)
+		}
+		if existing.Status != repository.StatusFailed {
+			return splitFromClaim(result, existing, requestHash)
+		}
+	case errors.Is(err, repository.ErrNotFound):
+		existing = nil
+	default:
+		return nil, fmt.Errorf("failed to look up payment reference: %w", err)
+	}
+
+	if req.Signature == "" {
+		auth := &SplitAuthorization{
+			Customer:    sp.customer.Hex(),
+			Recipients:  make([]string, len(sp.recipients)),
+			Percentages: make([]uint16, len(sp.recipients)),
+			AmountWei:   sp.amount.String(),
+			ChainID:     s.config.ChainID,
+			Spender:     s.posAddress.Hex(),
+			Hash:        requestHash,
+		}
+		for n, recipient := range sp.recipients {
+			auth.Recipients[n] = recipient.Hex()
+			auth.Percentages[n] = req.Recipients[n].Percentage
+		}
+		result.Status = PaymentSignatureRequired
+		result.Authorization = auth
+		return result, nil
+	}
+
+	signer, err := chain.RecoverSigner(accounts.TextHash(hash), req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if signer != sp.customer {
+		return nil, fmt.Errorf("%w: signature is by %s, not the customer %s", ErrInvalidSignature, signer.Hex(), sp.customer.Hex())
+	}
+
+	if err := s.checkFunds(&bind.CallOpts{Context: ctx}, sp.customer, sp.amount); err != nil {
+		return nil, err
+	}
+
+	claim := &repository.PaymentReference{
+		CustomerAddress: sp.customer.Hex(),
+		Reference:       req.Reference,
+		RequestHash:     requestHash,
+	}
+	if existing != nil {
+		if err := s.store.RetryPaymentReference(ctx, existing.ID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				// Another request is already retrying it
+				return s.replaySplit(ctx, result, claim)
+			}
+			return nil, fmt.Errorf("failed to retry payment reference: %w", err)
+		}
+		claim = existing
+	} else {
+		claimed, err := s.store.ClaimPaymentReference(ctx, claim)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim payment reference: %w", err)
+		}
+		if !claimed {
+			return s.replaySplit(ctx, result, claim)
+		}
+	}
+
+	// The reference is claimed: finish the attempt and record its outcome
+	// even if the client goes away, so it is not left pending
+	ctx = context.WithoutCancel(ctx)
+	tx, err := s.relay(ctx, "processSplitPayment", sp.recipients, sp.percentages, sp.amount, sp.customer, common.FromHex(req.Signature))
+	if err != nil {
+		s.failClaim(ctx, claim.ID, err)
+		return nil, err
+	}
+	if err := s.store.SetPaymentReferenceSubmitted(ctx, claim.ID, tx.Hash().Hex()); err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Error("Failed to record split payment transaction")
+	}
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":     tx.Hash().Hex(),
+		"reference":  req.Reference,
+		"customer":   sp.customer.Hex(),
+		"amount":     result.Amount,
+		"recipients": len(sp.recipients),
+	}).Info("Split payment broadcast")
+
+	result.TxHash = tx.Hash().Hex()
+	payment, splits, err := s.recordSplitPayment(ctx, tx, sp, req.Reference)
+	if errors.Is(err, errReverted) {
+		// The customer was not charged, so the reference may be used again
+		s.failClaim(ctx, claim.ID, err)
+		return nil, err
+	}
+	if err != nil {
+		// The transaction is out and the reference stays claimed, so a
+		// retry cannot pay again; report the payment as pending
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Warn("Split payment not yet confirmed")
+		result.Status = repository.StatusPending
+		return result, nil
+	}
+	if err := s.store.ConfirmPaymentReference(ctx, claim.ID, payment.PaymentID); err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).Error("Failed to confirm payment reference")
+	}
+
+	for n := range result.Splits {
+		result.Splits[n].Amount = splits[n].Amount
+	}
+	result.Status = PaymentConfirmed
+	result.PaymentID = payment.PaymentID
+	return result, nil
+}
+
+// replaySplit reports the payment another request started under claim's
+// customer and reference.
+func (s *Service) replaySplit(ctx context.Context, result *SplitResult, claim *repository.PaymentReference) (*SplitResult, error) {
+	existing, err := s.store.GetPaymentReference(ctx, claim.CustomerAddress, claim.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up payment reference: %w", err)
+	}
+	return splitFromClaim(result, existing, claim.RequestHash)
+}
+
+// splitFromClaim reports the payment made or under way for a claimed
+// reference, provided it is the payment requested.
+func splitFromClaim(result *SplitResult, claim *repository.PaymentReference, requestHash string) (*SplitResult, error) {
+	if claim.RequestHash != requestHash {
+		return nil, fmt.Errorf("%w: %s", ErrReferenceReused, claim.Reference)
+	}
+	result.Status = claim.Status
+	if claim.TxHash != nil {
+		result.TxHash = *claim.TxHash
+	}
+	if claim.PaymentID != nil {
+		result.PaymentID = *claim.PaymentID
+	}
+	return result, nil
+}
+
+// failClaim marks a claimed reference failed, so it can be tried again.
+func (s *Service) failClaim(ctx context.Context, id string, reason error) {
+	if err := s.store.FailPaymentReference(ctx, id, reason.Error()); err != nil {
+		logging.FromContext(ctx).WithError(err).Error("Failed to mark payment reference failed")
+	}
+}
+
+func parseSplit(req SplitRequest) (*split, error) {
+	if strings.TrimSpace(req.Reference) == "" || len(req.Reference) > maxReferenceLength {
+		return nil, fmt.Errorf("%w: reference must be 1 to %d characters", ErrInvalidPayment, maxReferenceLength)
+	}
+	if !common.IsHexAddress(req.Customer) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPayment, chain.InvalidAddress("customer", req.Customer))
+	}
+
+	amount, err := chain.ParseUnits(req.Amount, chain.VYRDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayment, err)
+	}
+	if amount.Sign() == 0 {
+		return nil, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidPayment)
+	}
+
+	if len(req.Recipients) == 0 || len(req.Recipients) > maxSplitRecipients {
+		return nil, fmt.Errorf("%w: a split needs 1 to %d recipients", ErrInvalidPayment, maxSplitRecipients)
+	}
+
+	sp := &split{
+		customer: common.HexToAddress(req.Customer),
+		amount:   amount,
+	}
+	seen := make(map[common.Address]bool)
+	total := 0
+	for _, r := range req.Recipients {
+		if !common.IsHexAddress(r.Recipient) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPayment, chain.InvalidAddress("recipient", r.Recipient))
+		}
+		recipient := common.HexToAddress(r.Recipient)
+		if recipient == (common.Address{}) {
+			return nil, fmt.Errorf("%w: recipient cannot be the zero address", ErrInvalidPayment)
+		}
+		if seen[recipient] {
+			return nil, fmt.Errorf("%w: duplicate recipient %s", ErrInvalidPayment, recipient.Hex())
+		}
+		seen[recipient] = true
+		if r.Percentage == 0 {
+			return nil, fmt.Errorf("%w: percentage for %s must be greater than zero", ErrInvalidPayment, recipient.Hex())
+		}
+
+		total += int(r.Percentage)
+		sp.recipients = append(sp.recipients, recipient)
+		sp.percentages = append(sp.percentages, big.NewInt(int64(r.Percentage)))
+	}
+	if total != splitDenominator {
+		return nil, fmt.Errorf("%w: percentages sum to %d basis points, not %d", ErrInvalidPayment, total, splitDenominator)
+	}
+
+	return sp, nil
+}
+
+// recordSplitPayment waits for a processSplitPayment transaction and stores
+// the payment and per-recipient amounts its SplitPaymentProcessed event
+// reports.
+func (s *Service) recordSplitPayment(ctx context.Context, tx *types.Transaction, sp *split, reference string) (*repository.Payment, []repository.PaymentSplit, error) {
+	receipt, err := s.waitMined(ctx, tx, "processSplitPayment")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != s.posAddress {
+			continue
+		}
+		event, err := s.pos.ParseSplitPaymentProcessed(*log)
+		if err != nil {
+			continue
+		}
+		if len(event.Amounts) != len(sp.recipients) {
+			return nil, nil, fmt.Errorf("malformed split payment %s", common.Bytes2Hex(event.PaymentId[:]))
+		}
+
+		// The amount is what was transferred, as the indexer records it
+		total := new(big.Int)
+		splits := make([]repository.PaymentSplit, len(event.Recipients))
+		for n, recipient := range event.Recipients {
+			total.Add(total, event.Amounts[n])
+			splits[n] = repository.PaymentSplit{
+				RecipientAddress: recipient.Hex(),
+				Amount:           chain.FormatUnits(event.Amounts[n], chain.VYRDecimals),
+			}
+		}
+
+		// As the indexer does, the first recipient stands in for the merchant
+		payment := &repository.Payment{
+			PaymentID:       common.Bytes2Hex(event.PaymentId[:]),
+			CustomerAddress: sp.customer.Hex(),
+			MerchantAddress: event.Recipients[0].Hex(),
+			Amount:          chain.FormatUnits(total, chain.VYRDecimals),
+			MerchantFee:     "0",
+			PlatformFee:     "0",
+			Reference:       &reference,
+		}
+		if err := s.store.IndexPayment(ctx, eventRef(log), payment, "", splits); err != nil {
+			return nil, nil, fmt.Errorf("failed to store payment: %w", err)
+		}
+		return payment, splits, nil
+	}
+
+	return nil, nil, fmt.Errorf("processSplitPayment transaction %s emitted no SplitPaymentProcessed event", tx.Hash().Hex())
+}
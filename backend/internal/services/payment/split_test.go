@@ -0,0 +1,219 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/repository"
+)
+
+func TestSplitHash(t *testing.T) {
+	sp := &split{
+		customer: common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72"),
+		amount:   big.NewInt(10_000_000),
+		recipients: []common.Address{
+			common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6"),
+			common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		},
+		percentages: []*big.Int{big.NewInt(7000), big.NewInt(3000)},
+	}
+
+	// The customer is packed as 20 bytes, but the address[] and uint256[]
+	// elements take a full word each.
+	want := crypto.Keccak256(packed(t,
+		"8ba1f109551bd432803012645ac136ddd64dba72",
+		"000000000000000000000000742d35cc6634c0532925a3b8d4c9db96c4b4d8b6",
+		"00000000000000000000000000000000000000000000000000000000000000aa",
+		word(7000),
+		word(3000),
+		word(10_000_000),
+		word(31337),
+	))
+	if got := splitHash(sp, 31337); !bytes.Equal(got, want) {
+		t.Errorf("splitHash = %x, want %x", got, want)
+	}
+}
+
+// splitRequest splits 10 VYR 70/30 between two new recipients.
+func splitRequest(t *testing.T, customer common.Address, reference string) SplitRequest {
+	t.Helper()
+	_, seller := newAccount(t)
+	_, platform := newAccount(t)
+	return SplitRequest{
+		Reference: reference,
+		Customer:  customer.Hex(),
+		Amount:    "10",
+		Recipients: []SplitRecipient{
+			{Recipient: seller.Hex(), Percentage: 7000},
+			{Recipient: platform.Hex(), Percentage: 3000},
+		},
+	}
+}
+
+// signSplit asks for a split payment without a signature and signs the
+// authorization it returns as customerKey.
+func signSplit(t *testing.T, p *testPOS, req SplitRequest, customerKey *ecdsa.PrivateKey) SplitRequest {
+	t.Helper()
+	req.Signature = ""
+	result, err := p.ProcessSplitPayment(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != PaymentSignatureRequired || result.Authorization == nil {
+		t.Fatalf("unsigned split status = %q, want %q with an authorization", result.Status, PaymentSignatureRequired)
+	}
+	req.Signature = personalSign(t, customerKey, common.FromHex(result.Authorization.Hash))
+	return req
+}
+
+func TestProcessSplitPayment(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	customerKey, customer := newAccount(t)
+	p.fund(customer, posAddress, vyr(30), vyr(30))
+
+	req := signSplit(t, p, splitRequest(t, customer, "order-1"), customerKey)
+	if _, err := p.store.GetPaymentReference(ctx, customer.Hex(), req.Reference); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("reference claimed before the payment was signed: %v", err)
+	}
+
+	result, err := p.ProcessSplitPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != PaymentConfirmed || result.PaymentID == "" {
+		t.Fatalf("split payment = %+v, want confirmed", result)
+	}
+	for n, want := range []int64{7, 3} {
+		recipient := common.HexToAddress(req.Recipients[n].Recipient)
+		if got := p.backend.balance(recipient); got.Cmp(vyr(want)) != 0 {
+			t.Errorf("recipient %d balance = %s, want %s", n, got, vyr(want))
+		}
+		if result.Splits[n].Amount != chain.FormatUnits(vyr(want), chain.VYRDecimals) {
+			t.Errorf("split %d = %+v, want %d VYR", n, result.Splits[n], want)
+		}
+	}
+	claim, err := p.store.GetPaymentReference(ctx, customer.Hex(), req.Reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claim.Status != repository.StatusConfirmed || claim.PaymentID == nil || *claim.PaymentID != result.PaymentID {
+		t.Errorf("claim = %+v, want confirmed with payment %s", claim, result.PaymentID)
+	}
+
+	// Retrying the request returns the payment without paying again
+	replayed, err := p.ProcessSplitPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.PaymentID != result.PaymentID || replayed.TxHash != result.TxHash {
+		t.Errorf("replayed payment = %+v, want %+v", replayed, result)
+	}
+	if p.backend.mined["processSplitPayment"] != 1 {
+		t.Errorf("mined %v, want one processSplitPayment", p.backend.mined)
+	}
+
+	// The reference cannot be reused for another payment
+	other := req
+	other.Amount = "5"
+	if _, err := p.ProcessSplitPayment(ctx, other); !errors.Is(err, ErrReferenceReused) {
+		t.Errorf("reference reused for another amount error = %v, want ErrReferenceReused", err)
+	}
+	other = splitRequest(t, customer, req.Reference)
+	if _, err := p.ProcessSplitPayment(ctx, other); !errors.Is(err, ErrReferenceReused) {
+		t.Errorf("reference reused for other recipients error = %v, want ErrReferenceReused", err)
+	}
+
+	// Another customer has references of its own
+	otherKey, otherCustomer := newAccount(t)
+	p.fund(otherCustomer, posAddress, vyr(10), vyr(10))
+	other = signSplit(t, p, splitRequest(t, otherCustomer, req.Reference), otherKey)
+	if result, err := p.ProcessSplitPayment(ctx, other); err != nil || result.Status != PaymentConfirmed {
+		t.Errorf("another customer's payment = %+v, %v, want confirmed", result, err)
+	}
+}
+
+func TestSplitPaymentRetry(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	customerKey, customer := newAccount(t)
+	p.fund(customer, posAddress, vyr(10), vyr(10))
+	req := signSplit(t, p, splitRequest(t, customer, "order-1"), customerKey)
+
+	// A payment that reverts releases the reference, charging nothing
+	p.backend.revertNext["processSplitPayment"] = true
+	if _, err := p.ProcessSplitPayment(ctx, req); !errors.Is(err, errReverted) {
+		t.Fatalf("reverted payment error = %v, want errReverted", err)
+	}
+	failed, err := p.store.GetPaymentReference(ctx, customer.Hex(), req.Reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Status != repository.StatusFailed {
+		t.Fatalf("claim status = %q, want %q", failed.Status, repository.StatusFailed)
+	}
+	if got := p.backend.balance(customer); got.Cmp(vyr(10)) != 0 {
+		t.Errorf("customer balance = %s, want %s", got, vyr(10))
+	}
+
+	// Retried, the payment goes through under the same claim
+	result, err := p.ProcessSplitPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != PaymentConfirmed {
+		t.Fatalf("retried payment status = %q, want %q", result.Status, PaymentConfirmed)
+	}
+	claim, err := p.store.GetPaymentReference(ctx, customer.Hex(), req.Reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claim.ID != failed.ID || claim.Status != repository.StatusConfirmed {
+		t.Errorf("claim = %+v, want %s confirmed", claim, failed.ID)
+	}
+	if p.backend.mined["processSplitPayment"] != 2 {
+		t.Errorf("mined %v, want two processSplitPayment", p.backend.mined)
+	}
+}
+
+func TestSplitPaymentPending(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPOS(t, true)
+	customerKey, customer := newAccount(t)
+	p.fund(customer, posAddress, vyr(10), vyr(10))
+	req := signSplit(t, p, splitRequest(t, customer, "order-1"), customerKey)
+
+	// Another request has claimed the reference and is paying
+	sp, err := parseSplit(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claim := &repository.PaymentReference{
+		CustomerAddress: customer.Hex(),
+		Reference:       req.Reference,
+		RequestHash:     hexutil.Encode(splitHash(sp, testChainID)),
+	}
+	if claimed, err := p.store.ClaimPaymentReference(ctx, claim); err != nil || !claimed {
+		t.Fatalf("ClaimPaymentReference = %v, %v", claimed, err)
+	}
+
+	result, err := p.ProcessSplitPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Status != repository.StatusPending || result.PaymentID != "" {
+		t.Errorf("payment = %+v, want pending", result)
+	}
+	if len(p.backend.mined) != 0 {
+		t.Errorf("mined %v, want nothing while the reference is claimed", p.backend.mined)
+	}
+}
@@ -0,0 +1,359 @@
+// Package rpc shares Ethereum RPC connections between the services. A
+// Manager spreads calls round-robin over one or more endpoints, dials each
+// on first use rather than at startup, and when an endpoint cannot be
+// reached drops its connection, rests it with a growing back-off and
+// retries the call on the next one. A node that answers with an error, such
+// as a reverted call, has still answered, so that error is returned as is.
+// Pinned gives a view that keeps to one endpoint instead, for callers whose
+// calls must see the same pending pool.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vyra-backend/internal/chain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// minBackoff and maxBackoff bound how long a failed endpoint is rested
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+// Manager is a chain.Backend over a set of RPC endpoints.
+type Manager struct {
+	timeout   time.Duration
+	endpoints []*endpoint
+	next      atomic.Uint64
+
+	// pinned managers start every call at the endpoint that last answered
+	// rather than taking turns
+	pinned bool
+}
+
+var (
+	_ chain.Backend       = (*Manager)(nil)
+	_ chain.ChainIDReader = (*Manager)(nil)
+)
+
+// endpoint is one RPC URL and its connection, dialled on first use.
+type endpoint struct {
+	index int
+	url   string
+	name  string
+
+	mu        sync.Mutex
+	client    *ethclient.Client
+	failures  int
+	downUntil time.Time
+}
+
+// New creates a manager for urls, giving each attempt at a call at most
+// timeout. It does not connect.
+func New(urls []string, timeout time.Duration) (*Manager, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no RPC URL configured")
+	}
+
+	m := &Manager{timeout: timeout}
+	for _, raw := range urls {
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid RPC URL %q", raw)
+		}
+		// Only the host is logged; paths and queries often carry API keys
+		m.endpoints = append(m.endpoints, &endpoint{index: len(m.endpoints), url: raw, name: u.Host})
+	}
+	return m, nil
+}
+
+// Pinned returns a manager over the same endpoints and connections that
+// sends every call to one endpoint, moving on only when it cannot be
+// reached. The relayer reads its nonce and sends through it, so the
+// transactions it sends build on the pending pool the nonce came from, and
+// the indexer reads through its own, so the logs it fetches up to a head
+// come from the node that reported that head.
+func (m *Manager) Pinned() *Manager {
+	return &Manager{timeout: m.timeout, endpoints: m.endpoints, pinned: true}
+}
+
+// Close closes every open connection.
+func (m *Manager) Close() error {
+	for _, e := range m.endpoints {
+		e.mu.Lock()
+		if e.client != nil {
+			e.client.Close()
+			e.client = nil
+		}
+		e.mu.Unlock()
+	}
+	return nil
+}
+
+// order returns the endpoints to try for one call: the available ones
+// starting from the next in turn, or the pinned one, then the resting ones
+// as a last resort.
+func (m *Manager) order() []*endpoint {
+	start := int(m.next.Load())
+	if !m.pinned {
+		start = int(m.next.Add(1) - 1)
+	}
+	now := time.Now()
+
+	available := make([]*endpoint, 0, len(m.endpoints))
+	var resting []*endpoint
+	for n := range m.endpoints {
+		e := m.endpoints[(start+n)%len(m.endpoints)]
+		if e.available(now) {
+			available = append(available, e)
+		} else {
+			resting = append(resting, e)
+		}
+	}
+	return append(available, resting...)
+}
+
+// do runs call against each endpoint in turn until one answers. Each
+// attempt is bounded by the manager's timeout and ctx. If none answers, the
+// last endpoint's error is returned wrapped in chain.ErrRPCUnavailable.
+func (m *Manager) do(ctx context.Context, call func(ctx context.Context, client *ethclient.Client) error) error {
+	var err error
+	for _, e := range m.order() {
+		err = m.attempt(ctx, e, call)
+		if err == nil || answered(err) {
+			e.succeeded()
+			if m.pinned {
+				m.next.Store(uint64(e.index))
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			// The caller gave up; the endpoint is not to blame
+			return err
+		}
+		e.failed(err)
+	}
+	return fmt.Errorf("%w: %w", chain.ErrRPCUnavailable, err)
+}
+
+func (m *Manager) attempt(ctx context.Context, e *endpoint, call func(ctx context.Context, client *ethclient.Client) error) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	client, err := e.dial(ctx)
+	if err != nil {
+		return err
+	}
+	return call(ctx, client)
+}
+
+// answered reports whether err came from a node that handled the request,
+// so another endpoint would answer the same.
+func answered(err error) bool {
+	if errors.Is(err, ethereum.NotFound) {
+		return true
+	}
+	var httpErr gethrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode < 500 && httpErr.StatusCode != 429
+	}
+	var rpcErr gethrpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+func (e *endpoint) available(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !now.Before(e.downUntil)
+}
+
+func (e *endpoint) dial(ctx context.Context) (*ethclient.Client, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client == nil {
+		client, err := ethclient.DialContext(ctx, e.url)
+		if err != nil {
+			return nil, err
+		}
+		e.client = client
+	}
+	return e.client, nil
+}
+
+func (e *endpoint) succeeded() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.failures > 0 {
+		logrus.WithField("endpoint", e.name).Info("RPC endpoint recovered")
+	}
+	e.failures = 0
+	e.downUntil = time.Time{}
+}
+
+// failed drops the connection, to be dialled again when the endpoint is
+// next tried, and rests the endpoint for twice as long as last time.
+func (e *endpoint) failed(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client != nil {
+		e.client.Close()
+		e.client = nil
+	}
+
+	backoff := minBackoff << e.failures
+	if backoff > maxBackoff || backoff <= 0 {
+		backoff = maxBackoff
+	}
+	e.failures++
+	e.downUntil = time.Now().Add(backoff)
+
+	logrus.WithError(err).WithFields(logrus.Fields{
+		"endpoint": e.name,
+		"failures": e.failures,
+		"backoff":  backoff,
+	}).Warn("RPC endpoint failed")
+}
+
+func (m *Manager) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		code, err = c.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (m *Manager) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		result, err = c.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (m *Manager) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		header, err = c.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (m *Manager) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		code, err = c.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (m *Manager) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		nonce, err = c.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (m *Manager) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		price, err = c.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (m *Manager) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		tip, err = c.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+func (m *Manager) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		gas, err = c.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction submits tx, failing over like any other call. Resending a
+// signed transaction is safe; if an attempt that seemed to fail reached the
+// pool, the node's "already known" answer to the retry counts as success.
+func (m *Manager) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	retry := false
+	return m.do(ctx, func(ctx context.Context, c *ethclient.Client) error {
+		err := c.SendTransaction(ctx, tx)
+		if retry && err != nil && strings.Contains(err.Error(), "already known") {
+			return nil
+		}
+		retry = true
+		return err
+	})
+}
+
+func (m *Manager) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		logs, err = c.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes through the first endpoint that accepts.
+// The subscription does not fail over once made; it needs a WebSocket URL.
+func (m *Manager) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		sub, err = c.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
+
+func (m *Manager) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		receipt, err = c.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (m *Manager) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		balance, err = c.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (m *Manager) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		tx, isPending, err = c.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, isPending, err
+}
+
+func (m *Manager) ChainID(ctx context.Context) (id *big.Int, err error) {
+	err = m.do(ctx, func(ctx context.Context, c *ethclient.Client) (err error) {
+		id, err = c.ChainID(ctx)
+		return err
+	})
+	return id, err
+}
@@ -0,0 +1,432 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/chain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const testChainID = 1337
+
+// fakeNode is a JSON-RPC endpoint that answers eth_chainId,
+// eth_sendRawTransaction and eth_getTransactionReceipt, or fails every
+// request with an HTTP status while status is set.
+type fakeNode struct {
+	server *httptest.Server
+
+	mu     sync.Mutex
+	status int
+	errors map[string]string
+	calls  map[string]int
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	t.Helper()
+	n := &fakeNode{errors: map[string]string{}, calls: map[string]int{}}
+	n.server = httptest.NewServer(http.HandlerFunc(n.serve))
+	t.Cleanup(n.server.Close)
+	return n
+}
+
+func (n *fakeNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	n.calls[req.Method]++
+	status, message := n.status, n.errors[req.Method]
+	n.mu.Unlock()
+
+	if status != 0 {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	switch {
+	case message != "":
+		resp["error"] = map[string]interface{}{"code": -32000, "message": message}
+	case req.Method == "eth_chainId":
+		resp["result"] = fmt.Sprintf("0x%x", testChainID)
+	case req.Method == "eth_sendRawTransaction":
+		resp["result"] = common.Hash{1}
+	default:
+		resp["result"] = nil
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// fail makes the node answer every request with status, or serve again
+// when status is 0.
+func (n *fakeNode) fail(status int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status = status
+}
+
+func (n *fakeNode) reject(method, message string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.errors[method] = message
+}
+
+func (n *fakeNode) called(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls[method]
+}
+
+func newTestManager(t *testing.T, nodes ...*fakeNode) *Manager {
+	t.Helper()
+	urls := make([]string, len(nodes))
+	for i, n := range nodes {
+		urls[i] = n.server.URL
+	}
+	m, err := New(urls, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+// rest marks every endpoint available again, as if their back-off ran out.
+func rest(m *Manager) {
+	for _, e := range m.endpoints {
+		e.mu.Lock()
+		e.downUntil = time.Time{}
+		e.mu.Unlock()
+	}
+}
+
+func dialled(e *endpoint) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.client != nil
+}
+
+func chainID(t *testing.T, m *Manager) {
+	t.Helper()
+	id, err := m.ChainID(context.Background())
+	if err != nil {
+		t.Fatalf("ChainID: %v", err)
+	}
+	if id.Int64() != testChainID {
+		t.Fatalf("ChainID = %v, want %d", id, testChainID)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(nil, time.Second); err == nil {
+		t.Error("New with no URL succeeded")
+	}
+	if _, err := New([]string{"http://localhost:8545", "localhost"}, time.Second); err == nil {
+		t.Error("New with a URL without a host succeeded")
+	}
+
+	// Nothing listens on the second URL; New must not notice
+	m, err := New([]string{"http://localhost:8545/key", "http://127.0.0.1:1"}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range m.endpoints {
+		if dialled(e) {
+			t.Errorf("%s dialled by New", e.name)
+		}
+	}
+	if m.endpoints[0].name != "localhost:8545" {
+		t.Errorf("name = %q, want the host without the path", m.endpoints[0].name)
+	}
+}
+
+func TestLazyDial(t *testing.T) {
+	a, b := newFakeNode(t), newFakeNode(t)
+	m := newTestManager(t, a, b)
+
+	chainID(t, m)
+	if !dialled(m.endpoints[0]) || dialled(m.endpoints[1]) {
+		t.Errorf("after one call dialled = %v, %v; want only the endpoint used", dialled(m.endpoints[0]), dialled(m.endpoints[1]))
+	}
+	chainID(t, m)
+	if !dialled(m.endpoints[1]) {
+		t.Error("second endpoint not dialled on its turn")
+	}
+	if a.called("eth_chainId") != 1 || b.called("eth_chainId") != 1 {
+		t.Errorf("calls = %d, %d; want round-robin", a.called("eth_chainId"), b.called("eth_chainId"))
+	}
+}
+
+func TestFailover(t *testing.T) {
+	a, b := newFakeNode(t), newFakeNode(t)
+	a.fail(http.StatusBadGateway)
+	m := newTestManager(t, a, b)
+
+	chainID(t, m)
+	if a.called("eth_chainId") != 1 || b.called("eth_chainId") != 1 {
+		t.Fatalf("calls = %d, %d; want the first to fail over to the second", a.called("eth_chainId"), b.called("eth_chainId"))
+	}
+	down := m.endpoints[0]
+	if down.failures != 1 || down.available(time.Now()) || dialled(down) {
+		t.Errorf("failed endpoint: failures %d, available %v, dialled %v; want resting with its connection dropped",
+			down.failures, down.available(time.Now()), dialled(down))
+	}
+
+	// A resting endpoint is skipped while another is available, whoever's
+	// turn it is
+	for n := 0; n < 2; n++ {
+		chainID(t, m)
+	}
+	if a.called("eth_chainId") != 1 {
+		t.Errorf("resting endpoint called %d times, want 1", a.called("eth_chainId"))
+	}
+
+	// Once rested and back, it takes its turn and its failures are forgotten
+	a.fail(0)
+	rest(m)
+	for n := 0; n < 2; n++ {
+		chainID(t, m)
+	}
+	if a.called("eth_chainId") != 2 {
+		t.Errorf("recovered endpoint called %d times, want 2", a.called("eth_chainId"))
+	}
+	if down.failures != 0 {
+		t.Errorf("failures = %d after recovering, want 0", down.failures)
+	}
+}
+
+func TestAllEndpointsDown(t *testing.T) {
+	a, b := newFakeNode(t), newFakeNode(t)
+	a.fail(http.StatusServiceUnavailable)
+	b.fail(http.StatusTooManyRequests)
+	m := newTestManager(t, a, b)
+
+	_, err := m.ChainID(context.Background())
+	if !errors.Is(err, chain.ErrRPCUnavailable) {
+		t.Fatalf("err = %v, want ErrRPCUnavailable", err)
+	}
+
+	// Resting endpoints are still tried when nothing else is left
+	a.fail(0)
+	chainID(t, m)
+	if a.called("eth_chainId") != 2 || b.called("eth_chainId") != 2 {
+		t.Errorf("calls = %d, %d; want both resting endpoints tried", a.called("eth_chainId"), b.called("eth_chainId"))
+	}
+}
+
+func TestNodeErrorsAreAnswers(t *testing.T) {
+	a, b := newFakeNode(t), newFakeNode(t)
+	a.reject("eth_chainId", "internal error")
+	b.reject("eth_chainId", "internal error")
+	m := newTestManager(t, a, b)
+
+	_, err := m.ChainID(context.Background())
+	var rpcErr gethrpc.Error
+	if !errors.As(err, &rpcErr) || errors.Is(err, chain.ErrRPCUnavailable) {
+		t.Fatalf("err = %v, want the node's error as is", err)
+	}
+	if _, err := m.TransactionReceipt(context.Background(), common.Hash{2}); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("TransactionReceipt err = %v, want NotFound", err)
+	}
+	if a.called("eth_chainId")+b.called("eth_chainId") != 1 || a.called("eth_getTransactionReceipt")+b.called("eth_getTransactionReceipt") != 1 {
+		t.Error("an answered call was retried on another endpoint")
+	}
+	for _, e := range m.endpoints {
+		if e.failures != 0 {
+			t.Errorf("%s failures = %d, want 0", e.name, e.failures)
+		}
+	}
+}
+
+func TestCancelledCallsDoNotRestEndpoints(t *testing.T) {
+	m := newTestManager(t, newFakeNode(t), newFakeNode(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := m.ChainID(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	for _, e := range m.endpoints {
+		if e.failures != 0 || !e.available(time.Now()) {
+			t.Errorf("%s rested after the caller gave up", e.name)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	e := &endpoint{name: "node"}
+	want := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second,
+		16 * time.Second, 32 * time.Second, time.Minute, time.Minute,
+	}
+	for n, backoff := range want {
+		before := time.Now()
+		e.failed(errors.New("connection refused"))
+		if e.failures != n+1 {
+			t.Fatalf("failures = %d, want %d", e.failures, n+1)
+		}
+		if got := e.downUntil.Sub(before); got < backoff || got > backoff+time.Second {
+			t.Errorf("failure %d: rested for %v, want %v", n+1, got, backoff)
+		}
+		if e.available(time.Now()) || !e.available(before.Add(backoff+time.Second)) {
+			t.Errorf("failure %d: available before or not after the back-off", n+1)
+		}
+	}
+
+	// The shift overflows long after the cap; it must stay capped
+	e.failures = 100
+	e.failed(errors.New("connection refused"))
+	if got := time.Until(e.downUntil); got > time.Minute {
+		t.Errorf("rested for %v, want at most a minute", got)
+	}
+
+	e.succeeded()
+	if e.failures != 0 || !e.available(time.Now()) {
+		t.Error("endpoint still resting after it answered")
+	}
+}
+
+func TestOrder(t *testing.T) {
+	m, err := New([]string{"http://a", "http://b", "http://c"}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := func(endpoints []*endpoint) string {
+		var s string
+		for _, e := range endpoints {
+			s += e.name
+		}
+		return s
+	}
+
+	for _, want := range []string{"abc", "bca", "cab", "abc"} {
+		if got := names(m.order()); got != want {
+			t.Errorf("order = %s, want %s", got, want)
+		}
+	}
+
+	m.endpoints[1].failed(errors.New("connection refused"))
+	for _, want := range []string{"cab", "cab", "acb"} {
+		if got := names(m.order()); got != want {
+			t.Errorf("with b resting, order = %s, want %s", got, want)
+		}
+	}
+
+	// Pinned views keep to one endpoint and do not move the shared turn
+	pinned := m.Pinned()
+	for n := 0; n < 2; n++ {
+		if got := names(pinned.order()); got != "acb" {
+			t.Errorf("pinned order = %s, want acb", got)
+		}
+	}
+	if got := names(m.order()); got != "cab" {
+		t.Errorf("order after pinned calls = %s, want cab", got)
+	}
+}
+
+func TestPinned(t *testing.T) {
+	a, b := newFakeNode(t), newFakeNode(t)
+	m := newTestManager(t, a, b)
+	pinned := m.Pinned()
+
+	for n := 0; n < 2; n++ {
+		chainID(t, pinned)
+	}
+	if a.called("eth_chainId") != 2 || b.called("eth_chainId") != 0 {
+		t.Fatalf("calls = %d, %d; want every call on the first endpoint", a.called("eth_chainId"), b.called("eth_chainId"))
+	}
+
+	// After failing over it stays on the endpoint that answered, even once
+	// the first is back
+	a.fail(http.StatusBadGateway)
+	chainID(t, pinned)
+	a.fail(0)
+	rest(m)
+	for n := 0; n < 2; n++ {
+		chainID(t, pinned)
+	}
+	if a.called("eth_chainId") != 3 || b.called("eth_chainId") != 3 {
+		t.Errorf("calls = %d, %d; want the pinned view to stay on the second endpoint", a.called("eth_chainId"), b.called("eth_chainId"))
+	}
+	if !dialled(m.endpoints[1]) {
+		t.Error("pinned view does not share the manager's connections")
+	}
+}
+
+func TestSendTransactionAlreadyKnown(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1), To: &common.Address{1}})
+
+	// The first node fails after the transaction reached the pool the second
+	// node shares, so the retry is told the transaction is already known
+	a, b := newFakeNode(t), newFakeNode(t)
+	a.fail(http.StatusBadGateway)
+	b.reject("eth_sendRawTransaction", "already known")
+	m := newTestManager(t, a, b)
+	if err := m.SendTransaction(context.Background(), tx); err != nil {
+		t.Errorf("retried send: %v, want success", err)
+	}
+	if a.called("eth_sendRawTransaction") != 1 || b.called("eth_sendRawTransaction") != 1 {
+		t.Errorf("sends = %d, %d; want one on each", a.called("eth_sendRawTransaction"), b.called("eth_sendRawTransaction"))
+	}
+
+	// On a first attempt the transaction was sent by someone else, which the
+	// caller must hear about
+	c := newFakeNode(t)
+	c.reject("eth_sendRawTransaction", "already known")
+	if err := newTestManager(t, c).SendTransaction(context.Background(), tx); err == nil {
+		t.Error("first send answered already known: got success, want the node's error")
+	}
+
+	// Other errors on a retry are still errors
+	d, e := newFakeNode(t), newFakeNode(t)
+	d.fail(http.StatusBadGateway)
+	e.reject("eth_sendRawTransaction", "nonce too low")
+	if err := newTestManager(t, d, e).SendTransaction(context.Background(), tx); err == nil {
+		t.Error("retried send answered nonce too low: got success, want the node's error")
+	}
+}
+
+// codeError is an error a node returned in a JSON-RPC response.
+type codeError struct{}
+
+func (codeError) Error() string  { return "execution reverted" }
+func (codeError) ErrorCode() int { return 3 }
+
+func TestAnswered(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{fmt.Errorf("receipt: %w", ethereum.NotFound), true},
+		{codeError{}, true},
+		{gethrpc.HTTPError{StatusCode: http.StatusUnauthorized}, true},
+		{gethrpc.HTTPError{StatusCode: http.StatusTooManyRequests}, false},
+		{gethrpc.HTTPError{StatusCode: http.StatusBadGateway}, false},
+		{errors.New("dial tcp 127.0.0.1:8545: connect: connection refused"), false},
+		{context.DeadlineExceeded, false},
+	}
+	for _, tt := range tests {
+		if got := answered(tt.err); got != tt.want {
+			t.Errorf("answered(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -1,25 +1,132 @@
 package services
 
 import (
+	"fmt"
+
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/health"
+	"vyra-backend/internal/indexer"
+	"vyra-backend/internal/invoices"
+	"vyra-backend/internal/metrics"
+	"vyra-backend/internal/migrations"
+	"vyra-backend/internal/ratelimit"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/services/apikey"
+	"vyra-backend/internal/services/auth"
 	"vyra-backend/internal/services/bridge"
-	"vyra-backend/internal/services/payment"
+	"vyra-backend/internal/services/history"
+	"vyra-backend/internal/services/merchant"
 	"vyra-backend/internal/services/paymaster"
+	"vyra-backend/internal/services/payment"
+	"vyra-backend/internal/services/rpc"
 	"vyra-backend/internal/services/wallet"
+	"vyra-backend/internal/services/webhook"
+	"vyra-backend/internal/webhooks"
 )
 
 type Services struct {
+	Store     *repository.Store
+	Migrator  *migrations.Migrator
+	RPC       *rpc.Manager
+	Auth      *auth.Service
+	APIKey    *apikey.Service
 	Wallet    *wallet.Service
 	Payment   *payment.Service
 	Bridge    *bridge.Service
 	Paymaster *paymaster.Service
+	History   *history.Service
+	Merchant  *merchant.Service
+	Webhook   *webhook.Service
+
+	// Invoices expires overdue invoices and publishes invoice transitions
+	Invoices *invoices.Worker
+
+	// Indexer is nil when INDEXER_ENABLED is false
+	Indexer *indexer.Indexer
+
+	// Webhooks is nil when WEBHOOKS_ENABLED is false
+	Webhooks *webhooks.Dispatcher
+
+	// RateLimiter is nil when RATE_LIMIT_ENABLED is false
+	RateLimiter ratelimit.Store
+
+	// Health checks the dependencies for the readiness probe
+	Health *health.Checker
 }
 
 func New(cfg *config.Config) *Services {
-	return &Services{
-		Wallet:    wallet.New(cfg),
-		Payment:   payment.New(cfg),
-		Bridge:    bridge.New(cfg),
-		Paymaster: paymaster.New(cfg),
+	store, err := repository.Open(cfg.DatabaseURL)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to open database: %v", err))
+	}
+
+	migrator, err := migrations.New(store.DB())
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load migrations: %v", err))
+	}
+
+	// The RPC nodes are dialled on first use, so a node that is down at
+	// startup only fails the calls made before it recovers
+	manager, err := rpc.New(cfg.RPCURLs, cfg.RPCTimeout)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to configure RPC client: %v", err))
 	}
+	client := metrics.InstrumentBackend(manager)
+	// Every relayer send shares one nonce sequence, read from and sent
+	// through one node
+	relayer := chain.NewRelayer(cfg.RelayerKey, cfg.ChainID, metrics.InstrumentBackend(manager.Pinned()))
+
+	services := &Services{
+		Store:     store,
+		Migrator:  migrator,
+		Auth:      auth.New(cfg, store),
+		APIKey:    apikey.New(cfg, store),
+		RPC:       manager,
+		Wallet:    wallet.New(cfg, store, client, relayer),
+		Payment:   payment.New(cfg, store, client, relayer),
+		Bridge:    bridge.New(cfg, store),
+		Paymaster: paymaster.New(cfg, store, client, relayer),
+		History:   history.New(cfg, store),
+		Merchant:  merchant.New(cfg, store, client),
+		Webhook:   webhook.New(cfg, store),
+		Invoices:  invoices.New(cfg, store),
+	}
+	services.Invoices.Subscribe(services.Webhook.InvoiceTransition)
+	services.Invoices.Subscribe(metrics.InvoiceTransition)
+
+	if cfg.MetricsEnabled {
+		metrics.RegisterStore(store)
+	}
+
+	if cfg.IndexerEnabled {
+		// A poll reads the head, the logs up to it and their blocks' headers;
+		// nodes that are a block or two apart would disagree on those, so it
+		// keeps to one node as well
+		if services.Indexer, err = indexer.New(cfg, metrics.InstrumentBackend(manager.Pinned()), store); err != nil {
+			panic(fmt.Sprintf("Failed to create indexer: %v", err))
+		}
+	}
+
+	if cfg.WebhooksEnabled {
+		services.Webhooks = webhooks.New(cfg, store)
+	}
+
+	if cfg.RateLimitEnabled {
+		if cfg.RateLimitStore == config.RateLimitRedis {
+			limiter, err := ratelimit.NewRedisStore(cfg.RedisURL)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create rate limiter: %v", err))
+			}
+			services.RateLimiter = limiter
+		} else {
+			services.RateLimiter = ratelimit.NewMemoryStore()
+		}
+	}
+
+	// Redis backs only the rate limiter
+	redis, _ := services.RateLimiter.(health.Pinger)
+	services.Health = health.New(cfg, client, store, migrator, redis)
+
+	return services
 }
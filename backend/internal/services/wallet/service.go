@@ -1,38 +1,70 @@
 package wallet
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 	"strings"
 
+	"vyra-backend/internal/chain"
 	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/hdwallet"
+	"vyra-backend/internal/repository"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 type Service struct {
-	config     *config.Config
-	client     *ethclient.Client
-	vyraToken  common.Address
+	config    *config.Config
+	store     *repository.Store
+	client    chain.Backend
+	relayer   *chain.Relayer
+	vyraToken common.Address
+	token     *contracts.VyraToken
+	tokenABI  *abi.ABI
 }
 
-func New(cfg *config.Config) *Service {
-	client, err := ethclient.Dial(cfg.RPCURL)
+// VyraBalance is the on-chain VYR position of an address together with the
+// token's current fee parameters.
+type VyraBalance struct {
+	Balance         string `json:"balance"`
+	Decimals        uint8  `json:"decimals"`
+	TransferFee     string `json:"transferFee"`
+	TransferFeeRate string `json:"transferFeeRate"`
+	TreasuryFees    string `json:"treasuryFees"`
+}
+
+// New creates a wallet service on top of backend: the shared RPC client in
+// production, or go-ethereum's simulated backend in tests. store records
+// the transfer authorizations relayer has used.
+func New(cfg *config.Config, store *repository.Store, backend chain.Backend, relayer *chain.Relayer) *Service {
+	vyraToken := common.HexToAddress(cfg.VyraToken)
+	token, err := contracts.NewVyraToken(vyraToken, backend)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to bind VyraToken contract: %v", err))
+	}
+	tokenABI, err := contracts.VyraTokenMetaData.GetAbi()
 	if err != nil {
-		panic(fmt.Sprintf("Failed to connect to Ethereum client: %v", err))
+		panic(fmt.Sprintf("Failed to parse VyraToken ABI: %v", err))
 	}
 
 	return &Service{
 		config:    cfg,
-		client:    client,
-		vyraToken: common.HexToAddress(cfg.VyraToken),
+		store:     store,
+		client:    backend,
+		relayer:   relayer,
+		vyraToken: vyraToken,
+		token:     token,
+		tokenABI:  tokenABI,
 	}
 }
 
-func (s *Service) Connect(connectionType, privateKey, mnemonic string, derivationIndex int) (string, error) {
+func (s *Service) Connect(connectionType, privateKey, mnemonic, passphrase string, derivationIndex int) (string, error) {
 	var privateKeyECDSA *ecdsa.PrivateKey
 	var err error
 
@@ -46,9 +78,18 @@ func (s *Service) Connect(connectionType, privateKey, mnemonic string, derivatio
 			return "", fmt.Errorf("invalid private key: %v", err)
 		}
 	case "mnemonic":
-		// This would typically use a proper mnemonic derivation library
-		// For now, we'll just return an error
-		return "", fmt.Errorf("mnemonic connection not implemented yet")
+		seed, err := hdwallet.NewSeed(mnemonic, passphrase)
+		if err != nil {
+			return "", err
+		}
+		path, err := hdwallet.AccountPath(derivationIndex)
+		if err != nil {
+			return "", err
+		}
+		privateKeyECDSA, err = hdwallet.DeriveKey(seed, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to derive key at %s: %w", path, err)
+		}
 	default:
 		return "", fmt.Errorf("unsupported connection type: %s", connectionType)
 	}
@@ -63,9 +104,18 @@ func (s *Service) Connect(connectionType, privateKey, mnemonic string, derivatio
 	return address.Hex(), nil
 }
 
-func (s *Service) GetBalance(address string) (string, error) {
+// DiscoverAccounts derives count BIP-44 addresses from a mnemonic starting
+// at index start, so clients can find which accounts hold funds.
+func (s *Service) DiscoverAccounts(mnemonic, passphrase string, start, count int) ([]hdwallet.Account, error) {
+	return hdwallet.DeriveAccounts(mnemonic, passphrase, start, count)
+}
+
+func (s *Service) GetBalance(ctx context.Context, address string) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", chain.InvalidAddress("", address)
+	}
 	account := common.HexToAddress(address)
-	balance, err := s.client.BalanceAt(nil, account, nil)
+	balance, err := s.client.BalanceAt(ctx, account, nil)
 	if err != nil {
 		return "", err
 	}
@@ -73,18 +123,48 @@ func (s *Service) GetBalance(address string) (string, error) {
 	// Convert wei to ether
 	ethBalance := new(big.Float).SetInt(balance)
 	ethBalance = ethBalance.Quo(ethBalance, big.NewFloat(1e18))
-	
+
 	return ethBalance.Text('f', 18), nil
 }
 
-func (s *Service) GetVyraBalance(address string) (string, error) {
-	// This would typically call the VYR token contract
-	// For now, return a placeholder
-	return "0.0", nil
-}
+func (s *Service) GetVyraBalance(ctx context.Context, address string) (*VyraBalance, error) {
+	if !common.IsHexAddress(address) {
+		return nil, chain.InvalidAddress("", address)
+	}
+	account := common.HexToAddress(address)
+	opts := &bind.CallOpts{Context: ctx}
+
+	balance, err := s.token.BalanceOf(opts, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR balance: %w", err)
+	}
+
+	decimals, err := s.token.Decimals(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR decimals: %w", err)
+	}
+
+	// Fee the holder would pay to move the whole balance
+	transferFee, err := s.token.GetTransferFee(opts, balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR transfer fee: %w", err)
+	}
+
+	transferFeeRate, err := s.token.TransferFeeRate(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR transfer fee rate: %w", err)
+	}
+
+	treasuryFees, err := s.token.GetTreasuryFees(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR treasury fees: %w", err)
+	}
 
-func (s *Service) SendPayment(from, to, amount, description string) (string, error) {
-	// This would typically create and send a transaction
-	// For now, return a placeholder
-	return "0xplaceholder_tx_hash", nil
+	return &VyraBalance{
+		Balance:         chain.FormatUnits(balance, decimals),
+		Decimals:        decimals,
+		TransferFee:     chain.FormatUnits(transferFee, decimals),
+		TransferFeeRate: transferFeeRate.String(),
+		TreasuryFees:    chain.FormatUnits(treasuryFees, decimals),
+	}, nil
 }
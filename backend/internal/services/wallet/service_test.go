@@ -0,0 +1,200 @@
+package wallet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/contracts"
+	"vyra-backend/internal/testdb"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// The simulated backend's chain ID
+const testChainID = 1337
+
+func TestMain(m *testing.M) { testdb.Main(m) }
+
+var (
+	tokenAddress    = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	treasuryAddress = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	recipient       = common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6")
+)
+
+// vyr returns n whole VYR in base units.
+func vyr(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.Ether))
+}
+
+// tokenBackend is go-ethereum's simulated backend with VyraToken's view
+// functions answered from memory at tokenAddress. The bindings carry no
+// bytecode to deploy, and the service only reads from the token and sends
+// transactions to it, which the simulated chain accepts and mines.
+type tokenBackend struct {
+	*backends.SimulatedBackend
+	abi        *abi.ABI
+	feeRate    *big.Int
+	balances   map[common.Address]*big.Int
+	allowances map[[2]common.Address]*big.Int
+}
+
+func (b *tokenBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != tokenAddress {
+		return b.SimulatedBackend.CallContract(ctx, call, blockNumber)
+	}
+	method, err := b.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		return method.Outputs.Pack(b.balance(args[0].(common.Address)))
+	case "allowance":
+		allowance := b.allowances[[2]common.Address{args[0].(common.Address), args[1].(common.Address)}]
+		if allowance == nil {
+			allowance = new(big.Int)
+		}
+		return method.Outputs.Pack(allowance)
+	case "decimals":
+		return method.Outputs.Pack(uint8(chain.VYRDecimals))
+	case "transferFeeRate":
+		return method.Outputs.Pack(b.feeRate)
+	case "treasury":
+		return method.Outputs.Pack(treasuryAddress)
+	case "getTransferFee":
+		fee := new(big.Int).Mul(args[0].(*big.Int), b.feeRate)
+		return method.Outputs.Pack(fee.Div(fee, big.NewInt(10000)))
+	case "getTreasuryFees":
+		return method.Outputs.Pack(b.balance(treasuryAddress))
+	}
+	return nil, fmt.Errorf("test token does not implement %s", method.Name)
+}
+
+func (b *tokenBackend) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if account == tokenAddress {
+		return []byte{0x00}, nil
+	}
+	return b.SimulatedBackend.CodeAt(ctx, account, blockNumber)
+}
+
+func (b *tokenBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	if account == tokenAddress {
+		return []byte{0x00}, nil
+	}
+	return b.SimulatedBackend.PendingCodeAt(ctx, account)
+}
+
+// EstimateGas succeeds for token calls, standing in for a transfer that
+// would execute.
+func (b *tokenBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if call.To != nil && *call.To == tokenAddress {
+		return 60000, nil
+	}
+	return b.SimulatedBackend.EstimateGas(ctx, call)
+}
+
+func (b *tokenBackend) balance(account common.Address) *big.Int {
+	if balance := b.balances[account]; balance != nil {
+		return balance
+	}
+	return new(big.Int)
+}
+
+type testWallet struct {
+	*Service
+	backend        *tokenBackend
+	sender         *ecdsa.PrivateKey
+	from           common.Address
+	relayerAddress common.Address
+}
+
+func newTestWallet(t *testing.T, withStore bool) *testWallet {
+	t.Helper()
+
+	sender, _ := crypto.GenerateKey()
+	relayKey, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(sender.PublicKey)
+	relayer := crypto.PubkeyToAddress(relayKey.PublicKey)
+
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from:    {Balance: big.NewInt(params.Ether)},
+		relayer: {Balance: big.NewInt(params.Ether)},
+	}, 30_000_000)
+	t.Cleanup(func() { sim.Close() })
+
+	tokenABI, err := contracts.VyraTokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &tokenBackend{
+		SimulatedBackend: sim,
+		abi:              tokenABI,
+		feeRate:          big.NewInt(10),
+		balances: map[common.Address]*big.Int{
+			from:            vyr(1000),
+			treasuryAddress: vyr(5),
+		},
+		allowances: map[[2]common.Address]*big.Int{
+			{from, relayer}: vyr(100),
+		},
+	}
+
+	cfg := &config.Config{
+		ChainID:    testChainID,
+		VyraToken:  tokenAddress.Hex(),
+		RelayerKey: hexutil.Encode(crypto.FromECDSA(relayKey)),
+	}
+	svc := New(cfg, nil, backend, chain.NewRelayer(cfg.RelayerKey, cfg.ChainID, backend))
+	if withStore {
+		svc.store = testdb.Open(t)
+	}
+
+	return &testWallet{
+		Service:        svc,
+		backend:        backend,
+		sender:         sender,
+		from:           from,
+		relayerAddress: relayer,
+	}
+}
+
+func TestGetVyraBalance(t *testing.T) {
+	w := newTestWallet(t, false)
+
+	got, err := w.GetVyraBalance(context.Background(), w.from.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &VyraBalance{
+		Balance:         "1000.0",
+		Decimals:        18,
+		TransferFee:     "1.0",
+		TransferFeeRate: "10",
+		TreasuryFees:    "5.0",
+	}
+	if *got != *want {
+		t.Errorf("GetVyraBalance = %+v, want %+v", got, want)
+	}
+
+	if _, err := w.GetVyraBalance(context.Background(), "0x1234"); !errors.Is(err, chain.ErrInvalidAddress) {
+		t.Errorf("GetVyraBalance(bad address) error = %v, want ErrInvalidAddress", err)
+	}
+}
@@ -0,0 +1,394 @@
+package wallet
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/logging"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidTransfer marks a send request that failed validation. Handlers
+// report it to the client rather than as an internal error.
+var ErrInvalidTransfer = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid transfer")
+
+// ErrInvalidSignature marks a transfer authorization that is malformed or
+// not signed by the sender.
+var ErrInvalidSignature = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidSignature, "invalid signature")
+
+// authorizationTTL is how long a quoted transfer authorization stays valid.
+const authorizationTTL = 15 * time.Minute
+
+// TransferQuote describes what a VYR transfer costs and what the recipient
+// ends up with after the token's transfer fee.
+type TransferQuote struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Amount    string `json:"amount"`
+	Fee       string `json:"fee"`
+	FeeRate   string `json:"feeRate"`
+	NetAmount string `json:"netAmount"`
+}
+
+// TransferAuthorization is an EIP-712 signature by the sender allowing the
+// relayer to move VYR on their behalf with transferFrom. The sender must
+// have approved the relayer for at least the transfer amount.
+type TransferAuthorization struct {
+	Nonce     string `json:"nonce"`
+	Deadline  int64  `json:"deadline"`
+	Signature string `json:"signature"`
+}
+
+// SendRequest carries a transfer and exactly one form of sender consent:
+// a client-signed raw transaction or a TransferAuthorization.
+type SendRequest struct {
+	From          string
+	To            string
+	Amount        string
+	Description   string
+	SignedTx      string
+	Authorization *TransferAuthorization
+}
+
+// SendResult is the outcome of a broadcast transfer.
+type SendResult struct {
+	TxHash string         `json:"txHash"`
+	Quote  *TransferQuote `json:"quote"`
+}
+
+type transfer struct {
+	from     common.Address
+	to       common.Address
+	amount   *big.Int
+	decimals uint8
+}
+
+// QuoteTransfer returns the fee and net amount for sending amount VYR from
+// one address to another.
+func (s *Service) QuoteTransfer(ctx context.Context, from, to, amount string) (*TransferQuote, error) {
+	t, err := s.parseTransfer(ctx, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quote(ctx, t)
+}
+
+// AuthorizationTypedData returns the EIP-712 payload a sender signs to
+// authorize a relayed transfer, with a fresh nonce and deadline.
+func (s *Service) AuthorizationTypedData(ctx context.Context, from, to, amount string) (*apitypes.TypedData, error) {
+	t, err := s.parseTransfer(ctx, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceBytes := make([]byte, 32)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	nonce := new(big.Int).SetBytes(nonceBytes)
+	deadline := time.Now().Add(authorizationTTL).Unix()
+
+	typedData := s.transferTypedData(t, nonce, deadline)
+	return &typedData, nil
+}
+
+// SendPayment validates the sender's consent, checks that the transfer will
+// execute and broadcasts it, returning the transaction hash and fee quote.
+func (s *Service) SendPayment(ctx context.Context, req SendRequest) (*SendResult, error) {
+	t, err := s.parseTransfer(ctx, req.From, req.To, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.quote(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx *types.Transaction
+	switch {
+	case req.SignedTx != "" && req.Authorization != nil:
+		return nil, fmt.Errorf("%w: provide either signedTx or authorization, not both", ErrInvalidTransfer)
+	case req.SignedTx != "":
+		tx, err = s.sendSignedTransaction(ctx, t, req.SignedTx)
+	case req.Authorization != nil:
+		tx, err = s.relayAuthorizedTransfer(ctx, t, req.Authorization)
+	default:
+		return nil, fmt.Errorf("%w: signedTx or authorization is required", ErrInvalidTransfer)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"txHash":      tx.Hash().Hex(),
+		"from":        t.from.Hex(),
+		"to":          t.to.Hex(),
+		"amount":      quote.Amount,
+		"description": req.Description,
+	}).Info("VYR transfer broadcast")
+
+	return &SendResult{
+		TxHash: tx.Hash().Hex(),
+		Quote:  quote,
+	}, nil
+}
+
+func (s *Service) parseTransfer(ctx context.Context, from, to, amount string) (*transfer, error) {
+	if !common.IsHexAddress(from) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTransfer, chain.InvalidAddress("sender", from))
+	}
+	if !common.IsHexAddress(to) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTransfer, chain.InvalidAddress("recipient", to))
+	}
+
+	decimals, err := s.token.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR decimals: %w", err)
+	}
+
+	value, err := chain.ParseUnits(amount, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer, err)
+	}
+	if value.Sign() == 0 {
+		return nil, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidTransfer)
+	}
+
+	return &transfer{
+		from:     common.HexToAddress(from),
+		to:       common.HexToAddress(to),
+		amount:   value,
+		decimals: decimals,
+	}, nil
+}
+
+func (s *Service) quote(ctx context.Context, t *transfer) (*TransferQuote, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	feeRate, err := s.token.TransferFeeRate(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR transfer fee rate: %w", err)
+	}
+
+	// VyraToken exempts transfers to and from the treasury
+	treasury, err := s.token.Treasury(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR treasury: %w", err)
+	}
+
+	fee := new(big.Int)
+	if t.from != treasury && t.to != treasury {
+		fee, err = s.token.GetTransferFee(opts, t.amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get VYR transfer fee: %w", err)
+		}
+	}
+
+	return &TransferQuote{
+		From:      t.from.Hex(),
+		To:        t.to.Hex(),
+		Amount:    chain.FormatUnits(t.amount, t.decimals),
+		Fee:       chain.FormatUnits(fee, t.decimals),
+		FeeRate:   feeRate.String(),
+		NetAmount: chain.FormatUnits(new(big.Int).Sub(t.amount, fee), t.decimals),
+	}, nil
+}
+
+// sendSignedTransaction checks that a client-signed transaction is exactly
+// the requested VYR transfer from the sender, then broadcasts it.
+func (s *Service) sendSignedTransaction(ctx context.Context, t *transfer, signedTx string) (*types.Transaction, error) {
+	tx, sender, err := chain.DecodeSignedTransaction(signedTx, s.config.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer, err)
+	}
+	if sender != t.from {
+		return nil, fmt.Errorf("%w: transaction is signed by %s, not %s", ErrInvalidTransfer, sender.Hex(), t.from.Hex())
+	}
+
+	if tx.To() == nil || *tx.To() != s.vyraToken {
+		return nil, fmt.Errorf("%w: transaction is not sent to the VYR token", ErrInvalidTransfer)
+	}
+	if tx.Value().Sign() != 0 {
+		return nil, fmt.Errorf("%w: transaction must not carry ETH", ErrInvalidTransfer)
+	}
+
+	to, amount, err := s.decodeTransferCall(tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	if to != t.to || amount.Cmp(t.amount) != 0 {
+		return nil, fmt.Errorf("%w: transaction does not match the requested recipient and amount", ErrInvalidTransfer)
+	}
+
+	gas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: sender,
+		To:   tx.To(),
+		Data: tx.Data(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: transfer would fail: %w", ErrInvalidTransfer, err)
+	}
+	if tx.Gas() < gas {
+		return nil, fmt.Errorf("%w: gas limit %d is below the estimated %d", ErrInvalidTransfer, tx.Gas(), gas)
+	}
+
+	if err := s.client.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+
+	return tx, nil
+}
+
+func (s *Service) decodeTransferCall(data []byte) (common.Address, *big.Int, error) {
+	if len(data) < 4 {
+		return common.Address{}, nil, fmt.Errorf("%w: transaction has no calldata", ErrInvalidTransfer)
+	}
+
+	method, err := s.tokenABI.MethodById(data[:4])
+	if err != nil || method.Name != "transfer" {
+		return common.Address{}, nil, fmt.Errorf("%w: transaction is not a VYR transfer", ErrInvalidTransfer)
+	}
+
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("%w: malformed transfer calldata: %v", ErrInvalidTransfer, err)
+	}
+
+	return args[0].(common.Address), args[1].(*big.Int), nil
+}
+
+// relayAuthorizedTransfer verifies an EIP-712 authorization and submits
+// transferFrom from the relayer account.
+func (s *Service) relayAuthorizedTransfer(ctx context.Context, t *transfer, auth *TransferAuthorization) (*types.Transaction, error) {
+	relayer, err := s.relayer.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	if auth.Deadline <= time.Now().Unix() {
+		return nil, fmt.Errorf("%w: authorization has expired", ErrInvalidTransfer)
+	}
+
+	nonce, ok := math.ParseBig256(auth.Nonce)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid authorization nonce", ErrInvalidTransfer)
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(s.transferTypedData(t, nonce, auth.Deadline))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash authorization: %w", err)
+	}
+
+	signer, err := recoverSigner(hash, auth.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != t.from {
+		return nil, fmt.Errorf("%w: authorization is signed by %s, not %s", ErrInvalidSignature, signer.Hex(), t.from.Hex())
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+	allowance, err := s.token.Allowance(callOpts, t.from, relayer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR allowance: %w", err)
+	}
+	if allowance.Cmp(t.amount) < 0 {
+		return nil, fmt.Errorf("%w: sender has not approved the relayer %s for this amount", ErrInvalidTransfer, relayer.Hex())
+	}
+
+	balance, err := s.token.BalanceOf(callOpts, t.from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VYR balance: %w", err)
+	}
+	if balance.Cmp(t.amount) < 0 {
+		return nil, fmt.Errorf("%w: insufficient VYR balance", ErrInvalidTransfer)
+	}
+
+	from, nonceKey := t.from.Hex(), nonce.String()
+	claimed, err := s.store.ClaimTransferAuthorization(ctx, from, nonceKey, time.Unix(auth.Deadline, 0).UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim authorization: %w", err)
+	}
+	if !claimed {
+		return nil, fmt.Errorf("%w: authorization has already been used", ErrInvalidTransfer)
+	}
+
+	// The claim is held now; finish recording the outcome even if the
+	// client goes away
+	ctx = context.WithoutCancel(ctx)
+	tx, err := s.relayer.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.token.TransferFrom(opts, t.from, t.to, t.amount)
+	})
+	if err != nil {
+		if releaseErr := s.store.ReleaseTransferAuthorization(ctx, from, nonceKey); releaseErr != nil {
+			logging.FromContext(ctx).WithError(releaseErr).Error("Failed to release transfer authorization")
+		}
+		return nil, fmt.Errorf("failed to submit transferFrom: %w", err)
+	}
+
+	if err := s.store.SetTransferAuthorizationTx(ctx, from, nonceKey, tx.Hash().Hex()); err != nil {
+		logging.FromContext(ctx).WithError(err).WithField("txHash", tx.Hash().Hex()).
+			Error("Failed to record transfer authorization transaction")
+	}
+
+	return tx, nil
+}
+
+func (s *Service) transferTypedData(t *transfer, nonce *big.Int, deadline int64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Transfer": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "amount", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Transfer",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Vyra Relayer",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(s.config.ChainID),
+			VerifyingContract: s.vyraToken.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     t.from.Hex(),
+			"to":       t.to.Hex(),
+			"amount":   t.amount.String(),
+			"nonce":    nonce.String(),
+			"deadline": big.NewInt(deadline).String(),
+		},
+	}
+}
+
+// recoverSigner returns the address that produced a 65-byte signature over
+// hash, accepting both 0/1 and 27/28 recovery ids.
+func recoverSigner(hash []byte, signature string) (common.Address, error) {
+	signer, err := chain.RecoverSigner(hash, signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return signer, nil
+}
@@ -0,0 +1,274 @@
+package wallet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// signTransfer signs a call to VyraToken.transfer from the sender.
+func (w *testWallet) signTransfer(t *testing.T, key *ecdsa.PrivateKey, to common.Address, amount *big.Int) string {
+	t.Helper()
+	data, err := w.tokenABI.Pack("transfer", to, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := w.backend.PendingNonceAt(context.Background(), crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(testChainID)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(testChainID),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		Gas:       100000,
+		To:        &tokenAddress,
+		Data:      data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(raw)
+}
+
+// authorize signs the transfer authorization the quote endpoint returns.
+func (w *testWallet) authorize(t *testing.T, key *ecdsa.PrivateKey, amount string) *TransferAuthorization {
+	t.Helper()
+	typedData, err := w.AuthorizationTypedData(context.Background(), w.from.Hex(), recipient.Hex(), amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, _, err := apitypes.TypedDataAndHash(*typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	deadline, ok := new(big.Int).SetString(typedData.Message["deadline"].(string), 10)
+	if !ok {
+		t.Fatal("deadline is not a number")
+	}
+	return &TransferAuthorization{
+		Nonce:     typedData.Message["nonce"].(string),
+		Deadline:  deadline.Int64(),
+		Signature: hexutil.Encode(signature),
+	}
+}
+
+func TestQuoteTransfer(t *testing.T) {
+	w := newTestWallet(t, false)
+
+	tests := []struct {
+		name    string
+		to      common.Address
+		amount  string
+		fee     string
+		net     string
+		invalid bool
+	}{
+		{name: "fee charged", to: recipient, amount: "100", fee: "0.1", net: "99.9"},
+		{name: "fraction", to: recipient, amount: "0.5", fee: "0.0005", net: "0.4995"},
+		{name: "treasury exempt", to: treasuryAddress, amount: "100", fee: "0.0", net: "100.0"},
+		{name: "zero", to: recipient, amount: "0", invalid: true},
+		{name: "negative", to: recipient, amount: "-1", invalid: true},
+		{name: "too precise", to: recipient, amount: "0.0000000000000000001", invalid: true},
+	}
+	for _, tt := range tests {
+		quote, err := w.QuoteTransfer(context.Background(), w.from.Hex(), tt.to.Hex(), tt.amount)
+		if tt.invalid {
+			if !errors.Is(err, ErrInvalidTransfer) {
+				t.Errorf("%s: error = %v, want ErrInvalidTransfer", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if quote.Fee != tt.fee || quote.NetAmount != tt.net {
+			t.Errorf("%s: fee %s, net %s; want %s, %s", tt.name, quote.Fee, quote.NetAmount, tt.fee, tt.net)
+		}
+	}
+}
+
+// TestTransferTypedDataHash checks the EIP-712 digest against one built by
+// hand as Solidity would: keccak256("\x19\x01" || domainSeparator ||
+// keccak256(abi.encode(TRANSFER_TYPEHASH, from, to, amount, nonce, deadline))).
+func TestTransferTypedDataHash(t *testing.T) {
+	w := newTestWallet(t, false)
+
+	from := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	tr := &transfer{from: from, to: recipient, amount: vyr(10), decimals: 18}
+	nonce, deadline := big.NewInt(7), int64(1700000900)
+
+	got, _, err := apitypes.TypedDataAndHash(w.transferTypedData(tr, nonce, deadline))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	word := func(v *big.Int) []byte { return common.LeftPadBytes(v.Bytes(), 32) }
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("Vyra Relayer")),
+		crypto.Keccak256([]byte("1")),
+		word(big.NewInt(testChainID)),
+		common.LeftPadBytes(tokenAddress.Bytes(), 32),
+	)
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Transfer(address from,address to,uint256 amount,uint256 nonce,uint256 deadline)")),
+		common.LeftPadBytes(from.Bytes(), 32),
+		common.LeftPadBytes(recipient.Bytes(), 32),
+		word(vyr(10)),
+		word(nonce),
+		word(big.NewInt(deadline)),
+	)
+	want := crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+
+	if hexutil.Encode(got) != hexutil.Encode(want) {
+		t.Errorf("digest = %x, want %x", got, want)
+	}
+}
+
+func TestSendPaymentSignedTransaction(t *testing.T) {
+	w := newTestWallet(t, false)
+	ctx := context.Background()
+	other, _ := crypto.GenerateKey()
+
+	tests := []struct {
+		name     string
+		signedTx func() string
+		invalid  bool
+	}{
+		{name: "wrong amount", signedTx: func() string { return w.signTransfer(t, w.sender, recipient, vyr(11)) }, invalid: true},
+		{name: "wrong recipient", signedTx: func() string { return w.signTransfer(t, w.sender, treasuryAddress, vyr(10)) }, invalid: true},
+		{name: "wrong signer", signedTx: func() string { return w.signTransfer(t, other, recipient, vyr(10)) }, invalid: true},
+		{name: "not hex", signedTx: func() string { return "0xzz" }, invalid: true},
+		{name: "matching", signedTx: func() string { return w.signTransfer(t, w.sender, recipient, vyr(10)) }},
+	}
+	for _, tt := range tests {
+		result, err := w.SendPayment(ctx, SendRequest{
+			From:     w.from.Hex(),
+			To:       recipient.Hex(),
+			Amount:   "10",
+			SignedTx: tt.signedTx(),
+		})
+		if tt.invalid {
+			if !errors.Is(err, ErrInvalidTransfer) {
+				t.Errorf("%s: error = %v, want ErrInvalidTransfer", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		w.backend.Commit()
+		receipt, err := w.backend.TransactionReceipt(ctx, common.HexToHash(result.TxHash))
+		if err != nil {
+			t.Fatalf("%s: transaction was not mined: %v", tt.name, err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("%s: receipt status = %d", tt.name, receipt.Status)
+		}
+		if result.Quote.NetAmount != "9.99" {
+			t.Errorf("%s: net amount = %s, want 9.99", tt.name, result.Quote.NetAmount)
+		}
+	}
+}
+
+func TestSendPaymentNeedsOneConsent(t *testing.T) {
+	w := newTestWallet(t, false)
+
+	for _, req := range []SendRequest{
+		{From: w.from.Hex(), To: recipient.Hex(), Amount: "1"},
+		{From: w.from.Hex(), To: recipient.Hex(), Amount: "1", SignedTx: "0x01", Authorization: &TransferAuthorization{}},
+	} {
+		if _, err := w.SendPayment(context.Background(), req); !errors.Is(err, ErrInvalidTransfer) {
+			t.Errorf("SendPayment(%+v) error = %v, want ErrInvalidTransfer", req, err)
+		}
+	}
+}
+
+func TestSendPaymentAuthorizationChecks(t *testing.T) {
+	w := newTestWallet(t, false)
+	ctx := context.Background()
+	other, _ := crypto.GenerateKey()
+
+	expired := w.authorize(t, w.sender, "10")
+	expired.Deadline = time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name   string
+		amount string
+		auth   *TransferAuthorization
+		want   error
+	}{
+		{"signed by someone else", "10", w.authorize(t, other, "10"), ErrInvalidSignature},
+		{"signed for another amount", "10", w.authorize(t, w.sender, "20"), ErrInvalidSignature},
+		{"malformed signature", "10", &TransferAuthorization{Nonce: "1", Deadline: time.Now().Add(time.Minute).Unix(), Signature: "0x1234"}, ErrInvalidSignature},
+		{"expired", "10", expired, ErrInvalidTransfer},
+		{"over the allowance", "200", w.authorize(t, w.sender, "200"), ErrInvalidTransfer},
+	}
+	for _, tt := range tests {
+		_, err := w.SendPayment(ctx, SendRequest{
+			From:          w.from.Hex(),
+			To:            recipient.Hex(),
+			Amount:        tt.amount,
+			Authorization: tt.auth,
+		})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+// TestSendPaymentAuthorizationReplay relays an authorization and checks it
+// cannot be used again, which needs the database the claims are kept in.
+func TestSendPaymentAuthorizationReplay(t *testing.T) {
+	w := newTestWallet(t, true)
+	ctx := context.Background()
+
+	req := SendRequest{
+		From:          w.from.Hex(),
+		To:            recipient.Hex(),
+		Amount:        "10",
+		Authorization: w.authorize(t, w.sender, "10"),
+	}
+	result, err := w.SendPayment(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.backend.Commit()
+
+	tx, _, err := w.backend.TransactionByHash(ctx, common.HexToHash(result.TxHash))
+	if err != nil {
+		t.Fatalf("relayed transaction not found: %v", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(testChainID)), tx)
+	if err != nil || sender != w.relayerAddress {
+		t.Errorf("relayed transaction sent by %s, %v; want the relayer %s", sender.Hex(), err, w.relayerAddress.Hex())
+	}
+
+	if _, err := w.SendPayment(ctx, req); !errors.Is(err, ErrInvalidTransfer) {
+		t.Errorf("replayed authorization: error = %v, want ErrInvalidTransfer", err)
+	}
+}
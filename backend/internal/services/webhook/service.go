@@ -0,0 +1,262 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"vyra-backend/internal/apierror"
+	"vyra-backend/internal/chain"
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+	"vyra-backend/internal/webhooks"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultDeliveryLimit = 50
+	MaxDeliveryLimit     = 200
+
+	// secretPrefix marks endpoint secrets so they are recognisable if leaked
+	secretPrefix = "whsec_"
+)
+
+// ErrInvalidWebhook is returned for a malformed address, URL, event type,
+// status or ID.
+var ErrInvalidWebhook = apierror.Define(http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid webhook request")
+
+type Service struct {
+	config *config.Config
+	store  *repository.Store
+}
+
+// DeliveryLog is a delivery with every attempt made to send it.
+type DeliveryLog struct {
+	*repository.WebhookDelivery
+	AttemptLog []*repository.WebhookAttempt `json:"attemptLog"`
+}
+
+func New(cfg *config.Config, store *repository.Store) *Service {
+	return &Service{
+		config: cfg,
+		store:  store,
+	}
+}
+
+// Register adds an endpoint receiving owner's events of the given types, or
+// of every type if none are given. The returned endpoint carries the
+// signing secret, which is not shown again.
+func (s *Service) Register(ctx context.Context, owner, endpointURL string, eventTypes []string) (*repository.WebhookEndpoint, error) {
+	if !common.IsHexAddress(owner) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhook, chain.InvalidAddress("owner", owner))
+	}
+	u, err := url.Parse(endpointURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+	if err := webhooks.CheckURL(ctx, endpointURL); err != nil {
+		return nil, fmt.Errorf("%w: url must resolve to a public address: %w", ErrInvalidWebhook, err)
+	}
+
+	types := []string{}
+	seen := make(map[string]bool)
+	for _, t := range eventTypes {
+		if !isEventType(t) {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	endpoint := &repository.WebhookEndpoint{
+		OwnerAddress: common.HexToAddress(owner).Hex(),
+		URL:          u.String(),
+		Secret:       secretPrefix + hex.EncodeToString(secret),
+		EventTypes:   types,
+	}
+	if err := s.store.CreateWebhookEndpoint(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+// List returns owner's active endpoints.
+func (s *Service) List(ctx context.Context, owner string) ([]*repository.WebhookEndpoint, error) {
+	if !common.IsHexAddress(owner) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhook, chain.InvalidAddress("owner", owner))
+	}
+	endpoints, err := s.store.ListWebhookEndpoints(ctx, common.HexToAddress(owner).Hex())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	if endpoints == nil {
+		endpoints = []*repository.WebhookEndpoint{}
+	}
+	return endpoints, nil
+}
+
+// Delete deactivates one of owner's endpoints. Its pending deliveries are
+// not sent, and its delivery log is kept.
+func (s *Service) Delete(ctx context.Context, owner, id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	if _, err := s.endpoint(ctx, owner, id); err != nil {
+		return err
+	}
+	return s.store.DeactivateWebhookEndpoint(ctx, id)
+}
+
+// Deliveries returns the deliveries to one of owner's endpoints, newest
+// first, optionally only those with the given status.
+func (s *Service) Deliveries(ctx context.Context, owner, endpointID, status string, limit int) ([]*repository.WebhookDelivery, error) {
+	if err := validateID(endpointID); err != nil {
+		return nil, err
+	}
+	if err := validateStatus(status); err != nil {
+		return nil, err
+	}
+	if _, err := s.endpoint(ctx, owner, endpointID); err != nil {
+		return nil, err
+	}
+	return s.deliveries(ctx, repository.DeliveryFilter{EndpointID: endpointID, Status: status, Limit: limit})
+}
+
+// DeadLetters returns the deliveries to owner's endpoints that ran out of
+// attempts, newest first.
+func (s *Service) DeadLetters(ctx context.Context, owner string, limit int) ([]*repository.WebhookDelivery, error) {
+	if !common.IsHexAddress(owner) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhook, chain.InvalidAddress("owner", owner))
+	}
+	return s.deliveries(ctx, repository.DeliveryFilter{
+		Owner:  common.HexToAddress(owner).Hex(),
+		Status: repository.DeliveryDead,
+		Limit:  limit,
+	})
+}
+
+// Delivery returns a delivery to one of owner's endpoints and its attempt
+// log.
+func (s *Service) Delivery(ctx context.Context, owner, id string) (*DeliveryLog, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+	delivery, err := s.delivery(ctx, owner, id)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := s.store.ListWebhookAttempts(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook attempts: %w", err)
+	}
+	if attempts == nil {
+		attempts = []*repository.WebhookAttempt{}
+	}
+	return &DeliveryLog{WebhookDelivery: delivery, AttemptLog: attempts}, nil
+}
+
+// Replay queues a delivery to one of owner's endpoints to be sent again
+// now, with a fresh set of attempts. Dead and already delivered events can
+// both be replayed.
+func (s *Service) Replay(ctx context.Context, owner, id string) (*repository.WebhookDelivery, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+	if _, err := s.delivery(ctx, owner, id); err != nil {
+		return nil, err
+	}
+	if err := s.store.ReplayWebhookDelivery(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.store.GetWebhookDelivery(ctx, id)
+}
+
+// InvoiceTransition queues invoice.updated deliveries for an invoice
+// transition. It is subscribed to the invoice worker.
+func (s *Service) InvoiceTransition(ctx context.Context, t *repository.InvoiceTransition) error {
+	if err := s.store.RecordInvoiceEvent(ctx, t); err != nil {
+		return fmt.Errorf("failed to record invoice event: %w", err)
+	}
+	return nil
+}
+
+// endpoint returns the endpoint with the given ID, or repository.ErrNotFound
+// if owner does not own it.
+func (s *Service) endpoint(ctx context.Context, owner, id string) (*repository.WebhookEndpoint, error) {
+	endpoint, err := s.store.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(endpoint.OwnerAddress, owner) {
+		return nil, repository.ErrNotFound
+	}
+	return endpoint, nil
+}
+
+// delivery returns the delivery with the given ID, or repository.ErrNotFound
+// if owner does not own its endpoint.
+func (s *Service) delivery(ctx context.Context, owner, id string) (*repository.WebhookDelivery, error) {
+	delivery, err := s.store.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.endpoint(ctx, owner, delivery.EndpointID); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (s *Service) deliveries(ctx context.Context, filter repository.DeliveryFilter) ([]*repository.WebhookDelivery, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultDeliveryLimit
+	}
+	if filter.Limit > MaxDeliveryLimit {
+		filter.Limit = MaxDeliveryLimit
+	}
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	if deliveries == nil {
+		deliveries = []*repository.WebhookDelivery{}
+	}
+	return deliveries, nil
+}
+
+func isEventType(t string) bool {
+	for _, known := range repository.EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStatus(status string) error {
+	switch status {
+	case "", repository.StatusPending, repository.DeliverySucceeded, repository.DeliveryDead:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown status %q", ErrInvalidWebhook, status)
+}
+
+// validateID rejects IDs that are not UUIDs before they reach a UUID column.
+func validateID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: invalid ID %s", ErrInvalidWebhook, id)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// Package testdb gives tests a Postgres database with its schema brought up
+// to date. Tests run against the database named by TEST_DATABASE_URL when it
+// is set, and otherwise against an embedded Postgres server that the test
+// binary starts on first use and Main stops once the tests finish. Tests
+// share the database, so each should use its own addresses and IDs rather
+// than expect empty tables; tests that change rows they did not write, such
+// as reorg rollbacks, take a database of their own from Fresh.
+package testdb
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"vyra-backend/internal/migrations"
+	"vyra-backend/internal/repository"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+)
+
+// EnvVar names an existing database to run tests against instead of the
+// embedded server.
+const EnvVar = "TEST_DATABASE_URL"
+
+// startTimeout allows for the first run downloading the Postgres binaries.
+const startTimeout = 2 * time.Minute
+
+var (
+	running bool
+
+	startOnce sync.Once
+	server    *embeddedpostgres.EmbeddedPostgres
+	serverDir string
+	serverURL string
+	startErr  error
+)
+
+// Main runs a package's tests and then stops the embedded server if one of
+// them started it. Packages that call Open run their tests through it:
+//
+//	func TestMain(m *testing.M) { testdb.Main(m) }
+func Main(m *testing.M) {
+	running = true
+	code := m.Run()
+	if server != nil {
+		if err := server.Stop(); err != nil {
+			fmt.Fprintf(os.Stderr, "testdb: failed to stop embedded Postgres: %v\n", err)
+		}
+		os.RemoveAll(serverDir)
+	}
+	os.Exit(code)
+}
+
+// Open returns a store on the test database with every migration applied,
+// closed when the test ends.
+func Open(t testing.TB) *repository.Store {
+	t.Helper()
+
+	url, err := databaseURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return open(t, url)
+}
+
+// Fresh returns a store on a new, empty database with every migration
+// applied, dropped when the test ends.
+func Fresh(t testing.TB) *repository.Store {
+	t.Helper()
+
+	base, err := databaseURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		t.Fatal(err)
+	}
+	name := "vyra_test_" + hex.EncodeToString(suffix)
+
+	admin, err := repository.Open(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+	if _, err := admin.DB().Exec(`CREATE DATABASE ` + name); err != nil {
+		t.Fatalf("failed to create test database: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.DB().Exec(`DROP DATABASE IF EXISTS ` + name); err != nil {
+			t.Errorf("failed to drop test database: %v", err)
+		}
+	})
+
+	u.Path = "/" + name
+	return open(t, u.String())
+}
+
+func open(t testing.TB, url string) *repository.Store {
+	t.Helper()
+
+	store, err := repository.Open(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	migrator, err := migrations.New(store.DB())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return store
+}
+
+func databaseURL() (string, error) {
+	if url := os.Getenv(EnvVar); url != "" {
+		return url, nil
+	}
+	if !running {
+		return "", errors.New("testdb: the package's TestMain must call testdb.Main to start an embedded database")
+	}
+	startOnce.Do(func() { serverURL, startErr = start() })
+	return serverURL, startErr
+}
+
+// start runs an embedded server on a free port with its files in a fresh
+// directory, so test binaries for several packages can run at once.
+func start() (string, error) {
+	port, err := freePort()
+	if err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp("", "vyra-testdb-")
+	if err != nil {
+		return "", err
+	}
+
+	var log bytes.Buffer
+	config := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V16).
+		Port(port).
+		Database("vyra_test").
+		RuntimePath(dir).
+		StartTimeout(startTimeout).
+		Logger(&log)
+
+	pg := embeddedpostgres.NewDatabase(config)
+	if err := pg.Start(); err != nil {
+		os.RemoveAll(dir)
+		err = fmt.Errorf("testdb: failed to start embedded Postgres (set %s to use an existing database): %w", EnvVar, err)
+		if log.Len() > 0 {
+			err = fmt.Errorf("%w\n%s", err, log.String())
+		}
+		return "", err
+	}
+	server, serverDir = pg, dir
+	return config.GetConnectionURL() + "?sslmode=disable", nil
+}
+
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
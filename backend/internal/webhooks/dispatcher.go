@@ -0,0 +1,279 @@
+// Package webhooks sends recorded events to merchants' webhook endpoints.
+// The repository records an event, and a delivery per subscribed endpoint,
+// in the same transaction as the change it describes; the Dispatcher polls
+// for due deliveries and POSTs each as signed JSON. A failed delivery is
+// retried with exponential backoff until it succeeds or runs out of
+// attempts, when it is dead-lettered until replayed.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync"
+	"time"
+
+	"vyra-backend/internal/config"
+	"vyra-backend/internal/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Request headers set on every delivery.
+	HeaderEvent     = "X-Vyra-Event"
+	HeaderDelivery  = "X-Vyra-Delivery"
+	HeaderSignature = "X-Vyra-Signature"
+
+	// batchSize is how many due deliveries one poll claims
+	batchSize = 50
+
+	// concurrency is how many deliveries are sent at once
+	concurrency = 8
+
+	// requestTimeout bounds one delivery attempt
+	requestTimeout = 10 * time.Second
+
+	// claimLease keeps a claimed delivery from being claimed again while it
+	// is being sent
+	claimLease = time.Minute
+
+	// maxResponseBody is how much of an endpoint's response is logged
+	maxResponseBody = 1024
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type Dispatcher struct {
+	config *config.Config
+	store  *repository.Store
+	client *http.Client
+}
+
+func New(cfg *config.Config, store *repository.Store) *Dispatcher {
+	return &Dispatcher{
+		config: cfg,
+		store:  store,
+		client: &http.Client{
+			Timeout: requestTimeout,
+			// A redirect would send the signed event somewhere the merchant
+			// did not register
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+			// Endpoints are dialled directly, never through a proxy, so the
+			// dialer sees, and can refuse, the address actually connected to
+			Transport: &http.Transport{
+				DialContext: (&net.Dialer{
+					Timeout:   requestTimeout,
+					KeepAlive: 30 * time.Second,
+					Control:   dialControl,
+				}).DialContext,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   requestTimeout,
+				ExpectContinueTimeout: time.Second,
+			},
+		},
+	}
+}
+
+// Run delivers events until ctx is cancelled, polling every
+// WebhookPollInterval.
+func (d *Dispatcher) Run(ctx context.Context) {
+	logrus.Info("Webhook dispatcher started")
+
+	ticker := time.NewTicker(d.config.WebhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.poll(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Webhook dispatch failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			logrus.Info("Webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll sends due deliveries until none are left.
+func (d *Dispatcher) poll(ctx context.Context) error {
+	for ctx.Err() == nil {
+		due, err := d.store.ClaimWebhookDeliveries(ctx, batchSize, claimLease)
+		if err != nil {
+			return fmt.Errorf("failed to claim deliveries: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		slots := make(chan struct{}, concurrency)
+		for _, delivery := range due {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(delivery *repository.DueDelivery) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				d.deliver(ctx, delivery)
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(due) < batchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// deliver makes one attempt at a delivery and records the outcome.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *repository.DueDelivery) {
+	attempt := &repository.WebhookAttempt{DeliveryID: delivery.ID}
+	started := time.Now()
+	statusCode, body, err := d.send(ctx, delivery)
+	attempt.DurationMs = time.Since(started).Milliseconds()
+
+	if statusCode != 0 {
+		attempt.StatusCode = &statusCode
+	}
+	if body != "" {
+		attempt.ResponseBody = &body
+	}
+	if err == nil && (statusCode < 200 || statusCode > 299) {
+		err = fmt.Errorf("endpoint responded %d", statusCode)
+	}
+
+	status, next := repository.DeliverySucceeded, time.Now()
+	if err != nil {
+		message := err.Error()
+		attempt.Error = &message
+
+		status, next = repository.StatusPending, time.Now().Add(Backoff(delivery.Attempts+1))
+		if delivery.Attempts+1 >= d.config.WebhookMaxAttempts {
+			status = repository.DeliveryDead
+		}
+	}
+
+	// Record the outcome even if the dispatcher is stopping; the request was
+	// already sent
+	recordCtx := context.WithoutCancel(ctx)
+	err = d.store.WithTx(recordCtx, func(tx *repository.Tx) error {
+		return tx.RecordWebhookAttempt(recordCtx, attempt, status, next)
+	})
+	if err != nil {
+		logrus.WithError(err).WithField("delivery", delivery.ID).Error("Failed to record webhook attempt")
+		return
+	}
+
+	entry := logrus.WithFields(logrus.Fields{
+		"delivery": delivery.ID,
+		"event":    delivery.EventType,
+		"attempt":  delivery.Attempts + 1,
+	})
+	switch status {
+	case repository.DeliveryDead:
+		entry.WithError(errorOf(attempt)).Warn("Webhook delivery dead-lettered")
+	case repository.StatusPending:
+		entry.WithError(errorOf(attempt)).Info("Webhook delivery failed, will retry")
+	}
+}
+
+// send POSTs the delivery and returns the response status and the start of
+// its body. The body is only returned from a public address, so the
+// delivery log cannot be used to read responses from inside the network.
+func (d *Dispatcher) send(ctx context.Context, delivery *repository.DueDelivery) (int, string, error) {
+	body, err := json.Marshal(Payload{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.EventCreatedAt.UTC(),
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Vyra-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, time.Now(), body))
+
+	public := false
+	req = req.WithContext(httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if addr, ok := info.Conn.RemoteAddr().(*net.TCPAddr); ok {
+				public = IsPublicIP(addr.IP)
+			}
+		},
+	}))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	if !public {
+		return resp.StatusCode, "", nil
+	}
+	response, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	return resp.StatusCode, string(response), nil
+}
+
+// Sign returns the X-Vyra-Signature header for body sent at t:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">". The
+// timestamp is signed too so receivers can reject replayed requests.
+func Sign(secret string, t time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns how long to wait after the given failed attempt: 30s
+// doubling each time up to 6h, with up to 20% jitter so endpoints that
+// recover are not hit by every retry at once.
+func Backoff(attempt int) time.Duration {
+	delay := maxBackoff
+	if attempt < 20 {
+		if d := baseBackoff << (attempt - 1); d < maxBackoff {
+			delay = d
+		}
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
+
+func errorOf(a *repository.WebhookAttempt) error {
+	if a.Error == nil {
+		return nil
+	}
+	return fmt.Errorf("%s", *a.Error)
+}
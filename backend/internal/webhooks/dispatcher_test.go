@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"evt_1"}`)
+	at := time.Unix(1700000000, 0)
+
+	// HMAC-SHA256 of `1700000000.{"id":"evt_1"}` keyed with "whsec_test"
+	want := "t=1700000000,v1=c89214b5b5da833daed6f0b8c5bb6bd58cea9022bd80ccc78230f3942d632925"
+	if got := Sign("whsec_test", at, body); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("other", at, body) == want {
+		t.Error("Sign ignores the secret")
+	}
+	if Sign("whsec_test", at.Add(time.Second), body) == want {
+		t.Error("Sign ignores the timestamp")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		base    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{9, 128 * time.Minute},
+		{10, 256 * time.Minute},
+		{11, 6 * time.Hour},
+		{19, 6 * time.Hour},
+		{20, 6 * time.Hour},
+		{1000, 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			got := Backoff(tt.attempt)
+			if got < tt.base || got > tt.base+tt.base/5 {
+				t.Fatalf("Backoff(%d) = %v, want within 20%% above %v", tt.attempt, got, tt.base)
+			}
+		}
+	}
+}